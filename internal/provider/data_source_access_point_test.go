@@ -66,6 +66,7 @@ func TestAccDataSourceAccessPointAwsEgressPrivateLinkEndpoint(t *testing.T) {
 					resource.TestCheckResourceAttr(fullAccessPointResourceName, "aws_egress_private_link_endpoint.0.vpc_endpoint_service_name", "com.amazonaws.vpce.us-west-2.vpce-svc-00000000000000000"),
 					resource.TestCheckResourceAttr(fullAccessPointResourceName, "aws_egress_private_link_endpoint.0.vpc_endpoint_id", "vpce-00000000000000000"),
 					resource.TestCheckResourceAttr(fullAccessPointResourceName, "aws_egress_private_link_endpoint.0.vpc_endpoint_dns_name", "*.vpce-00000000000000000-abcd1234.s3.us-west-2.vpce.amazonaws.com"),
+					resource.TestCheckResourceAttr(fullAccessPointResourceName, "aws_egress_private_link_endpoint.0.enable_high_availability", "false"),
 				),
 			},
 		},
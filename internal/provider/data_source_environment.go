@@ -102,31 +102,16 @@ func environmentDataSourceReadUsingDisplayName(ctx context.Context, d *schema.Re
 func loadEnvironments(ctx context.Context, c *Client) ([]v2.OrgV2Environment, error) {
 	environments := make([]v2.OrgV2Environment, 0)
 
-	allEnvironmentsAreCollected := false
-	pageToken := ""
-	for !allEnvironmentsAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		environmentPageList, _, err := executeListEnvironments(ctx, c, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Environments: %s", createDescriptiveError(err))
+			return "", err
 		}
 		environments = append(environments, environmentPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := environmentPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allEnvironmentsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Environments: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allEnvironmentsAreCollected = true
-		}
+		return environmentPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Environments: %s", createDescriptiveError(err))
 	}
 	return environments, nil
 }
@@ -143,7 +128,7 @@ func environmentDataSourceReadUsingId(ctx context.Context, d *schema.ResourceDat
 	tflog.Debug(ctx, fmt.Sprintf("Reading Environment %q=%q", paramId, environmentId), map[string]interface{}{environmentLoggingKey: environmentId})
 
 	c := meta.(*Client)
-	environment, _, err := executeEnvironmentRead(c.orgApiContext(ctx), c, environmentId)
+	environment, err := executeCachedEnvironmentRead(c.orgApiContext(ctx), c, environmentId)
 	if err != nil {
 		return diag.Errorf("error reading Environment %q: %s", environmentId, createDescriptiveError(err))
 	}
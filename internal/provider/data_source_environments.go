@@ -16,18 +16,32 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramDisplayNameContains     = "display_name_contains"
+	paramStreamGovernancePackage = "stream_governance_package"
 )
 
 func environmentsDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: environmentsDataSourceRead,
 		Schema: map[string]*schema.Schema{
+			paramDisplayNameContains: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A substring that the display names of the returned environments must contain.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 			paramIds: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -36,6 +50,30 @@ func environmentsDataSource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			paramEnvironments: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of environments with their details.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the Environment (e.g., `env-abc123`).",
+						},
+						paramDisplayName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A human-readable name for the Environment.",
+						},
+						paramStreamGovernancePackage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Stream Governance package of the Environment.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -49,12 +87,26 @@ func environmentsDataSourceRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("error reading Environments: %s", createDescriptiveError(err))
 	}
 
-	result := make([]string, len(environments))
-	for i, environment := range environments {
-		result[i] = environment.GetId()
+	displayNameContains := d.Get(paramDisplayNameContains).(string)
+	ids := make([]string, 0)
+	result := make([]map[string]interface{}, 0)
+	for _, environment := range environments {
+		if !strings.Contains(environment.GetDisplayName(), displayNameContains) {
+			continue
+		}
+		ids = append(ids, environment.GetId())
+		result = append(result, map[string]interface{}{
+			paramId:                      environment.GetId(),
+			paramDisplayName:             environment.GetDisplayName(),
+			paramStreamGovernancePackage: environment.StreamGovernanceConfig.GetPackage(),
+		})
 	}
+	tflog.Debug(ctx, fmt.Sprintf("Found %d Environments out of %d matching %q=%q", len(ids), len(environments), paramDisplayNameContains, displayNameContains))
 
-	if err := d.Set(paramIds, result); err != nil {
+	if err := d.Set(paramIds, ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(paramEnvironments, result); err != nil {
 		return diag.FromErr(err)
 	}
 
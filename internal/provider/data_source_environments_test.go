@@ -30,6 +30,7 @@ import (
 const (
 	environmentsDataSourceScenarioName = "confluent_environments Data Source Lifecycle"
 	envResourceLabel                   = "test_env_resource_label"
+	environmentsLastPagePageToken      = "UvmDWOB1iwfAIBPj6EYb"
 )
 
 var environmentIds = []string{"env-1jnw8z", "env-7n1r31", "env-prp21o"}
@@ -109,3 +110,95 @@ func testAccCheckEnvironmentsExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccDataSourceEnvironmentsWithPaginationAndFilter(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	listEnvironmentsPageOneResponse, _ := ioutil.ReadFile("../testdata/environment/list_envs_page_1.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listEnvironmentsPageSize))).
+		InScenario(environmentsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listEnvironmentsPageOneResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listEnvironmentsPageTwoResponse, _ := ioutil.ReadFile("../testdata/environment/list_envs_page_2.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listEnvironmentsPageSize))).
+		WithQueryParam("page_token", wiremock.EqualTo(environmentsLastPagePageToken)).
+		InScenario(environmentsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listEnvironmentsPageTwoResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	fullEnvironmentDataSourceLabel := fmt.Sprintf("data.confluent_environments.%s", envResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceEnvironments(mockServerUrl, envResourceLabel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentsExists(fullEnvironmentDataSourceLabel),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, fmt.Sprintf("%s.#", paramIds), "3"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, fmt.Sprintf("%s.#", paramEnvironments), "3"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.0.id", environmentIds[0]),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.0.display_name", "nls"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.0.stream_governance_package", "ESSENTIALS"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.1.id", environmentIds[1]),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.1.display_name", "destination"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.1.stream_governance_package", "ADVANCED"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.2.id", environmentIds[2]),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.2.display_name", "ada"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.2.stream_governance_package", "ESSENTIALS"),
+				),
+			},
+			{
+				// "a" matches environments on both pages
+				Config: testAccCheckDataSourceEnvironmentsWithFilter(mockServerUrl, envResourceLabel, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentsExists(fullEnvironmentDataSourceLabel),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, paramDisplayNameContains, "a"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, fmt.Sprintf("%s.#", paramIds), "2"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, fmt.Sprintf("%s.0", paramIds), environmentIds[1]),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, fmt.Sprintf("%s.1", paramIds), environmentIds[2]),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, fmt.Sprintf("%s.#", paramEnvironments), "2"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.0.display_name", "destination"),
+					resource.TestCheckResourceAttr(fullEnvironmentDataSourceLabel, "environments.1.display_name", "ada"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceEnvironmentsWithFilter(mockServerUrl, envResourceLabel, displayNameContains string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_environments" "%s" {
+		display_name_contains = "%s"
+	}
+	`, mockServerUrl, envResourceLabel, displayNameContains)
+}
@@ -55,10 +55,18 @@ func computePoolDataSource() *schema.Resource {
 			paramCloud: {
 				Type:     schema.TypeString,
 				Computed: true,
+				Optional: true,
+				// Narrows down the lookup by "display_name"
+				ConflictsWith: []string{paramId},
+				Description:   "The cloud service provider that runs the Flink Compute Pool.",
 			},
 			paramRegion: {
 				Type:     schema.TypeString,
 				Computed: true,
+				Optional: true,
+				// Narrows down the lookup by "display_name"
+				ConflictsWith: []string{paramId},
+				Description:   "The cloud service provider region that hosts the Flink Compute Pool.",
 			},
 			paramMaxCfu: {
 				Type:     schema.TypeInt,
@@ -101,24 +109,30 @@ func computePoolDataSourceReadUsingDisplayName(ctx context.Context, d *schema.Re
 	tflog.Debug(ctx, fmt.Sprintf("Reading Flink Compute Pool %q=%q", paramDisplayName, displayName))
 
 	c := meta.(*Client)
-	computePools, err := loadComputePools(ctx, c, environmentId)
+	cloud := d.Get(paramCloud).(string)
+	region := d.Get(paramRegion).(string)
+	computePools, err := loadComputePools(ctx, c, environmentId, region)
 	if err != nil {
 		return diag.Errorf("error reading Flink Compute Pool %q: %s", displayName, createDescriptiveError(err))
 	}
-	if orgHasMultipleComputePoolsWithTargetDisplayName(computePools, displayName) {
-		return diag.Errorf("error reading Flink Compute Pool: there are multiple ComputePools with %q=%q", paramDisplayName, displayName)
-	}
 
-	for _, computePool := range computePools {
-		if computePool.Spec.GetDisplayName() == displayName {
-			if _, err := setComputePoolAttributes(d, computePool); err != nil {
-				return diag.FromErr(createDescriptiveError(err))
-			}
-			return nil
+	matchingComputePools := filterComputePools(computePools, displayName, cloud, region)
+	if len(matchingComputePools) == 0 {
+		return diag.Errorf("error reading Flink Compute Pool: Flink Compute Pool with %q=%q was not found", paramDisplayName, displayName)
+	}
+	if len(matchingComputePools) > 1 {
+		matchingComputePoolIds := make([]string, len(matchingComputePools))
+		for i, computePool := range matchingComputePools {
+			matchingComputePoolIds[i] = computePool.GetId()
 		}
+		return diag.Errorf("error reading Flink Compute Pool: there are multiple Flink Compute Pools with %q=%q: %v, "+
+			"specify %q and %q attributes to narrow down the search or use %q attribute instead", paramDisplayName, displayName, matchingComputePoolIds, paramCloud, paramRegion, paramId)
 	}
 
-	return diag.Errorf("error reading Flink Compute Pool: Flink Compute Pool with %q=%q was not found", paramDisplayName, displayName)
+	if _, err := setComputePoolAttributes(d, matchingComputePools[0]); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	return nil
 }
 
 func computePoolDataSourceReadUsingId(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentId, computePoolId string) diag.Diagnostics {
@@ -141,54 +155,50 @@ func computePoolDataSourceReadUsingId(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func orgHasMultipleComputePoolsWithTargetDisplayName(clusters []fcpm.FcpmV2ComputePool, displayName string) bool {
-	var numberOfComputePoolsWithTargetDisplayName = 0
-	for _, cluster := range clusters {
-		if cluster.Spec.GetDisplayName() == displayName {
-			numberOfComputePoolsWithTargetDisplayName += 1
+// filterComputePools returns the Flink Compute Pools with the target display name. Empty cloud or region matches any value.
+func filterComputePools(computePools []fcpm.FcpmV2ComputePool, displayName, cloud, region string) []fcpm.FcpmV2ComputePool {
+	var matchingComputePools []fcpm.FcpmV2ComputePool
+	for _, computePool := range computePools {
+		if computePool.Spec.GetDisplayName() != displayName {
+			continue
+		}
+		if cloud != "" && computePool.Spec.GetCloud() != cloud {
+			continue
 		}
+		if region != "" && computePool.Spec.GetRegion() != region {
+			continue
+		}
+		matchingComputePools = append(matchingComputePools, computePool)
 	}
-	return numberOfComputePoolsWithTargetDisplayName > 1
+	return matchingComputePools
 }
 
-func loadComputePools(ctx context.Context, c *Client, environmentId string) ([]fcpm.FcpmV2ComputePool, error) {
+func loadComputePools(ctx context.Context, c *Client, environmentId, region string) ([]fcpm.FcpmV2ComputePool, error) {
 	computePools := make([]fcpm.FcpmV2ComputePool, 0)
 
-	allComputePoolsAreCollected := false
-	pageToken := ""
-	for !allComputePoolsAreCollected {
-		computePoolsPageList, _, err := executeListComputePools(ctx, c, environmentId, pageToken)
+	err := paginate(func(pageToken string) (string, error) {
+		computePoolsPageList, _, err := executeListComputePools(ctx, c, environmentId, region, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading ComputePools: %s", createDescriptiveError(err))
+			return "", err
 		}
 		computePools = append(computePools, computePoolsPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := computePoolsPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allComputePoolsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading ComputePools: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allComputePoolsAreCollected = true
-		}
+		return computePoolsPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading ComputePools: %s", createDescriptiveError(err))
 	}
 	return computePools, nil
 }
 
-func executeListComputePools(ctx context.Context, c *Client, environmentId, pageToken string) (fcpm.FcpmV2ComputePoolList, *http.Response, error) {
+func executeListComputePools(ctx context.Context, c *Client, environmentId, region, pageToken string) (fcpm.FcpmV2ComputePoolList, *http.Response, error) {
+	req := c.fcpmClient.ComputePoolsFcpmV2Api.ListFcpmV2ComputePools(c.fcpmApiContext(ctx)).Environment(environmentId).PageSize(listComputePoolsPageSize)
+	if region != "" {
+		req = req.SpecRegion(region)
+	}
 	if pageToken != "" {
-		return c.fcpmClient.ComputePoolsFcpmV2Api.ListFcpmV2ComputePools(c.fcpmApiContext(ctx)).Environment(environmentId).PageSize(listComputePoolsPageSize).PageToken(pageToken).Execute()
-	} else {
-		return c.fcpmClient.ComputePoolsFcpmV2Api.ListFcpmV2ComputePools(c.fcpmApiContext(ctx)).Environment(environmentId).PageSize(listComputePoolsPageSize).Execute()
+		req = req.PageToken(pageToken)
 	}
+	return req.Execute()
 }
 
 func standardComputePoolDataSourceSchema() *schema.Schema {
@@ -17,9 +17,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	fcpm "github.com/confluentinc/ccloud-sdk-go-v2/flink/v2"
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -28,6 +31,8 @@ import (
 
 const (
 	dataSourceComputePoolScenarioName = "confluent_flink_compute_pool Data Source Lifecycle"
+	// All Flink Compute Pools in read_compute_pools_with_same_display_name.json share this display name
+	sharedComputePoolDisplayName = "flink_compute_pool_0"
 )
 
 var fullComputePoolDataSourceLabel = fmt.Sprintf("data.confluent_flink_compute_pool.%s", networkDataSourceLabel)
@@ -113,6 +118,122 @@ func TestAccDataSourceComputePool(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceComputePoolWithSameDisplayName(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	// The stub ignores "spec.region" query parameter, the data source filters by region on the client side too
+	readComputePoolsResponse, _ := ioutil.ReadFile("../testdata/compute_pool/read_compute_pools_with_same_display_name.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/fcpm/v2/compute-pools")).
+		WithQueryParam("environment", wiremock.EqualTo(flinkComputePoolEnvironmentId)).
+		WillReturn(
+			string(readComputePoolsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDataSourceComputePoolConfigWithFilters(mockServerUrl, sharedComputePoolDisplayName, ""),
+				ExpectError: regexp.MustCompile(`there are multiple Flink Compute Pools with "display_name"="flink_compute_pool_0"`),
+			},
+			{
+				Config:      testAccCheckDataSourceComputePoolConfigWithFilters(mockServerUrl, "flink_compute_pool_404", ""),
+				ExpectError: regexp.MustCompile(`Flink Compute Pool with "display_name"="flink_compute_pool_404" was not found`),
+			},
+			{
+				Config: testAccCheckDataSourceComputePoolConfigWithFilters(mockServerUrl, sharedComputePoolDisplayName, `region = "us-west-2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullComputePoolDataSourceLabel, paramId, "lfcp-def456"),
+					resource.TestCheckResourceAttr(fullComputePoolDataSourceLabel, paramCloud, "AWS"),
+					resource.TestCheckResourceAttr(fullComputePoolDataSourceLabel, paramRegion, "us-west-2"),
+				),
+			},
+			{
+				Config: testAccCheckDataSourceComputePoolConfigWithFilters(mockServerUrl, sharedComputePoolDisplayName, `cloud = "GCP"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullComputePoolDataSourceLabel, paramId, "lfcp-ghi789"),
+					resource.TestCheckResourceAttr(fullComputePoolDataSourceLabel, paramCloud, "GCP"),
+					resource.TestCheckResourceAttr(fullComputePoolDataSourceLabel, paramRegion, "us-east4"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterComputePools(t *testing.T) {
+	newComputePool := func(id, displayName, cloud, region string) fcpm.FcpmV2ComputePool {
+		return fcpm.FcpmV2ComputePool{
+			Id:   fcpm.PtrString(id),
+			Spec: &fcpm.FcpmV2ComputePoolSpec{DisplayName: fcpm.PtrString(displayName), Cloud: fcpm.PtrString(cloud), Region: fcpm.PtrString(region)},
+		}
+	}
+	computePools := []fcpm.FcpmV2ComputePool{
+		newComputePool("lfcp-abc123", "prod", "AWS", "us-east-2"),
+		newComputePool("lfcp-def456", "prod", "AWS", "us-west-2"),
+		newComputePool("lfcp-ghi789", "prod", "GCP", "us-east4"),
+		newComputePool("lfcp-jkl012", "dev", "AWS", "us-east-2"),
+	}
+
+	tests := []struct {
+		name        string
+		displayName string
+		cloud       string
+		region      string
+		expectedIds []string
+	}{
+		{"match", "dev", "", "", []string{"lfcp-jkl012"}},
+		{"no match", "staging", "", "", nil},
+		{"multiple matches", "prod", "", "", []string{"lfcp-abc123", "lfcp-def456", "lfcp-ghi789"}},
+		{"multiple matches narrowed down by cloud", "prod", "AWS", "", []string{"lfcp-abc123", "lfcp-def456"}},
+		{"match narrowed down by region", "prod", "", "us-west-2", []string{"lfcp-def456"}},
+		{"match narrowed down by cloud and region", "prod", "GCP", "us-east4", []string{"lfcp-ghi789"}},
+		{"no match with cloud and region", "prod", "GCP", "us-west-2", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actualIds []string
+			for _, computePool := range filterComputePools(computePools, tt.displayName, tt.cloud, tt.region) {
+				actualIds = append(actualIds, computePool.GetId())
+			}
+			if !reflect.DeepEqual(actualIds, tt.expectedIds) {
+				t.Fatalf("expected %v, got %v", tt.expectedIds, actualIds)
+			}
+		})
+	}
+}
+
+func testAccCheckDataSourceComputePoolConfigWithFilters(mockServerUrl, displayName, filter string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	data "confluent_flink_compute_pool" "%s" {
+		display_name = "%s"
+		%s
+	  	environment {
+			id = "%s"
+	  	}
+	}
+	`, mockServerUrl, networkDataSourceLabel, displayName, filter, flinkComputePoolEnvironmentId)
+}
+
 func testAccCheckDataSourceAzureComputePoolConfigWithDisplayNameSet(mockServerUrl string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
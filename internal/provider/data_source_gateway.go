@@ -152,29 +152,33 @@ func setGatewayAttributes(d *schema.ResourceData, gateway net.NetworkingV1Gatewa
 		return nil, err
 	}
 
-	if gateway.Spec.Config.NetworkingV1AwsEgressPrivateLinkGatewaySpec != nil && gateway.Status.CloudGateway.NetworkingV1AwsEgressPrivateLinkGatewayStatus != nil {
+	// The cloud-specific status is missing while the Gateway is being provisioned, so the corresponding
+	// attributes (for example, principal_arn) are left empty instead of skipping the whole block
+	config := gateway.Spec.GetConfig()
+	cloudGateway := gateway.Status.GetCloudGateway()
+	if config.NetworkingV1AwsEgressPrivateLinkGatewaySpec != nil {
 		if err := d.Set(paramAwsEgressPrivateLinkGateway, []interface{}{map[string]interface{}{
-			paramRegion:       gateway.Spec.Config.NetworkingV1AwsEgressPrivateLinkGatewaySpec.GetRegion(),
-			paramPrincipalArn: gateway.Status.CloudGateway.NetworkingV1AwsEgressPrivateLinkGatewayStatus.GetPrincipalArn(),
+			paramRegion:       config.NetworkingV1AwsEgressPrivateLinkGatewaySpec.GetRegion(),
+			paramPrincipalArn: cloudGateway.NetworkingV1AwsEgressPrivateLinkGatewayStatus.GetPrincipalArn(),
 		}}); err != nil {
 			return nil, err
 		}
-	} else if gateway.Spec.Config.NetworkingV1AwsPeeringGatewaySpec != nil {
+	} else if config.NetworkingV1AwsPeeringGatewaySpec != nil {
 		if err := d.Set(paramAwsPeeringGateway, []interface{}{map[string]interface{}{
-			paramRegion: gateway.Spec.Config.NetworkingV1AwsPeeringGatewaySpec.GetRegion(),
+			paramRegion: config.NetworkingV1AwsPeeringGatewaySpec.GetRegion(),
 		}}); err != nil {
 			return nil, err
 		}
-	} else if gateway.Spec.Config.NetworkingV1AzureEgressPrivateLinkGatewaySpec != nil && gateway.Status.CloudGateway.NetworkingV1AzureEgressPrivateLinkGatewayStatus != nil {
+	} else if config.NetworkingV1AzureEgressPrivateLinkGatewaySpec != nil {
 		if err := d.Set(paramAzureEgressPrivateLinkGateway, []interface{}{map[string]interface{}{
-			paramRegion:       gateway.Spec.Config.NetworkingV1AzureEgressPrivateLinkGatewaySpec.GetRegion(),
-			paramSubscription: gateway.Status.CloudGateway.NetworkingV1AzureEgressPrivateLinkGatewayStatus.GetSubscription(),
+			paramRegion:       config.NetworkingV1AzureEgressPrivateLinkGatewaySpec.GetRegion(),
+			paramSubscription: cloudGateway.NetworkingV1AzureEgressPrivateLinkGatewayStatus.GetSubscription(),
 		}}); err != nil {
 			return nil, err
 		}
-	} else if gateway.Spec.Config.NetworkingV1AzurePeeringGatewaySpec != nil {
+	} else if config.NetworkingV1AzurePeeringGatewaySpec != nil {
 		if err := d.Set(paramAzurePeeringGateway, []interface{}{map[string]interface{}{
-			paramRegion: gateway.Spec.Config.NetworkingV1AzurePeeringGatewaySpec.GetRegion(),
+			paramRegion: config.NetworkingV1AzurePeeringGatewaySpec.GetRegion(),
 		}}); err != nil {
 			return nil, err
 		}
@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"testing"
 
+	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/walkerus/go-wiremock"
 )
@@ -233,6 +236,54 @@ func TestAccDataSourceGatewayAzureEgressPrivateLinkGatewaySpec(t *testing.T) {
 	})
 }
 
+func TestSetGatewayAttributesAwsEgressPrivateLinkGateway(t *testing.T) {
+	tests := []struct {
+		name                 string
+		fixture              string
+		expectedPrincipalArn string
+	}{
+		{"ready", "../testdata/gateway/read_aws_egress_private_link_gateway.json", "arn:aws:iam::123456789012:role"},
+		// The cloud-specific status isn't populated until the Gateway is provisioned
+		{"provisioning", "../testdata/gateway/read_provisioning_aws_egress_private_link_gateway.json", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := os.ReadFile(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gateway net.NetworkingV1Gateway
+			if err := json.Unmarshal(response, &gateway); err != nil {
+				t.Fatal(err)
+			}
+
+			d := schema.TestResourceDataRaw(t, gatewayDataSource().Schema, map[string]interface{}{})
+			if _, err := setGatewayAttributes(d, gateway); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if d.Id() != "gw-def456" {
+				t.Fatalf("expected ID %q, got %q", "gw-def456", d.Id())
+			}
+			if got := d.Get(paramAwsEgressPrivateLinkGateway + ".#").(int); got != 1 {
+				t.Fatalf("expected 1 %q block, got %d", paramAwsEgressPrivateLinkGateway, got)
+			}
+			if got := d.Get(paramAwsEgressPrivateLinkGateway + ".0." + paramRegion).(string); got != "us-east-2" {
+				t.Fatalf("expected region %q, got %q", "us-east-2", got)
+			}
+			if got := d.Get(paramAwsEgressPrivateLinkGateway + ".0." + paramPrincipalArn).(string); got != tt.expectedPrincipalArn {
+				t.Fatalf("expected principal_arn %q, got %q", tt.expectedPrincipalArn, got)
+			}
+			for _, block := range []string{paramAwsPeeringGateway, paramAzureEgressPrivateLinkGateway, paramAzurePeeringGateway} {
+				if got := d.Get(block + ".#").(int); got != 0 {
+					t.Fatalf("expected no %q blocks, got %d", block, got)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckDataSourceGateway(mockServerUrl, resourceId, resourceName string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
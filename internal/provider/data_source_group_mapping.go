@@ -57,6 +57,14 @@ func groupMappingDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			paramPrincipal: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			paramState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -103,31 +111,16 @@ func groupMappingDataSourceReadUsingDisplayName(ctx context.Context, d *schema.R
 func loadGroupMappings(ctx context.Context, c *Client) ([]v2.IamV2SsoGroupMapping, error) {
 	groupMappings := make([]v2.IamV2SsoGroupMapping, 0)
 
-	allGroupMappingsAreCollected := false
-	pageToken := ""
-	for !allGroupMappingsAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		groupMappingPageList, _, err := executeListGroupMappings(ctx, c, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Group Mappings: %s", createDescriptiveError(err))
+			return "", err
 		}
 		groupMappings = append(groupMappings, groupMappingPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := groupMappingPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allGroupMappingsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Group Mappings: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allGroupMappingsAreCollected = true
-		}
+		return groupMappingPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Group Mappings: %s", createDescriptiveError(err))
 	}
 	return groupMappings, nil
 }
@@ -96,6 +96,8 @@ func TestAccDataSourceGroupMapping(t *testing.T) {
 					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramDisplayName, groupMappingDisplayName),
 					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramFilter, groupMappingFilter),
 					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramDescription, groupMappingDescription),
+					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramPrincipal, groupMappingPrincipal),
+					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramState, groupMappingState),
 				),
 			},
 			{
@@ -106,6 +108,8 @@ func TestAccDataSourceGroupMapping(t *testing.T) {
 					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramDisplayName, groupMappingDisplayName),
 					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramFilter, groupMappingFilter),
 					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramDescription, groupMappingDescription),
+					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramPrincipal, groupMappingPrincipal),
+					resource.TestCheckResourceAttr(fullGroupMappingDataSourceLabel, paramState, groupMappingState),
 				),
 			},
 		},
@@ -140,31 +140,16 @@ func orgHasMultipleIdentityPoolsWithTargetDisplayName(identityPools []v2.IamV2Id
 func loadIdentityPools(ctx context.Context, c *Client, identityProviderId string) ([]v2.IamV2IdentityPool, error) {
 	identityPools := make([]v2.IamV2IdentityPool, 0)
 
-	allIdentityPoolsAreCollected := false
-	pageToken := ""
-	for !allIdentityPoolsAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		identityPoolsPageList, _, err := executeListIdentityPools(ctx, c, identityProviderId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Identity Pools: %s", createDescriptiveError(err))
+			return "", err
 		}
 		identityPools = append(identityPools, identityPoolsPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := identityPoolsPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allIdentityPoolsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Identity Pools: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allIdentityPoolsAreCollected = true
-		}
+		return identityPoolsPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Identity Pools: %s", createDescriptiveError(err))
 	}
 	return identityPools, nil
 }
@@ -111,31 +111,16 @@ func identityProviderDataSourceReadUsingDisplayName(ctx context.Context, d *sche
 func loadIdentityProviders(ctx context.Context, c *Client) ([]v2.IamV2IdentityProvider, error) {
 	identityProviders := make([]v2.IamV2IdentityProvider, 0)
 
-	allIdentityProvidersAreCollected := false
-	pageToken := ""
-	for !allIdentityProvidersAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		identityProviderPageList, _, err := executeListIdentityProviders(ctx, c, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Identity Providers: %s", createDescriptiveError(err))
+			return "", err
 		}
 		identityProviders = append(identityProviders, identityProviderPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := identityProviderPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allIdentityProvidersAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Identity Providers: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allIdentityProvidersAreCollected = true
-		}
+		return identityProviderPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Identity Providers: %s", createDescriptiveError(err))
 	}
 	return identityProviders, nil
 }
@@ -173,31 +173,16 @@ func ipAddressesDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 func loadIPAddresses(ctx context.Context, c *Client, clouds, regions, services, addressTypes []string) ([]net.NetworkingV1IpAddress, error) {
 	ipAddresses := make([]net.NetworkingV1IpAddress, 0)
 
-	allIPAddressesAreCollected := false
-	pageToken := ""
-	for !allIPAddressesAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		ipAddressesPageList, _, err := executeListIpAddresses(c.netIPApiContext(ctx), c, clouds, regions, services, addressTypes, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading IP Addresses: %s", createDescriptiveError(err))
+			return "", err
 		}
 		ipAddresses = append(ipAddresses, ipAddressesPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := ipAddressesPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allIPAddressesAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading IP Addresses: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allIPAddressesAreCollected = true
-		}
+		return ipAddressesPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading IP Addresses: %s", createDescriptiveError(err))
 	}
 	return ipAddresses, nil
 }
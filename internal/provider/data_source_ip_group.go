@@ -0,0 +1,153 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// The maximum allowable page size - 1 (to avoid off-by-one errors) when listing IP Groups using IAM V2 API
+	listIpGroupsPageSize = 99
+)
+
+type iamIpGroupList struct {
+	Data     []iamIpGroup `json:"data"`
+	Metadata struct {
+		Next string `json:"next"`
+	} `json:"metadata"`
+}
+
+func ipGroupDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ipGroupDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramId: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				// A user should provide a value for either "id" or "group_name" attribute, not both
+				ExactlyOneOf: []string{paramId, paramGroupName},
+				Description:  "The ID of the IP Group (e.g., `ipg-abc123`).",
+			},
+			paramGroupName: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				// A user should provide a value for either "id" or "group_name" attribute, not both
+				ExactlyOneOf: []string{paramId, paramGroupName},
+				Description:  "A human-readable name for the IP Group.",
+			},
+			paramCidrBlocks: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of CIDRs.",
+			},
+		},
+	}
+}
+
+func ipGroupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// ExactlyOneOf specified in the schema ensures one of paramId or paramGroupName is specified.
+	// The next step is to figure out which one exactly is set.
+
+	ipGroupId := d.Get(paramId).(string)
+	groupName := d.Get(paramGroupName).(string)
+
+	if ipGroupId != "" {
+		return ipGroupDataSourceReadUsingId(ctx, d, meta, ipGroupId)
+	} else if groupName != "" {
+		return ipGroupDataSourceReadUsingGroupName(ctx, d, meta, groupName)
+	} else {
+		return diag.Errorf("error reading IP Group: exactly one of %q or %q must be specified but they're both empty", paramId, paramGroupName)
+	}
+}
+
+func ipGroupDataSourceReadUsingId(ctx context.Context, d *schema.ResourceData, meta interface{}, ipGroupId string) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading IP Group %q=%q", paramId, ipGroupId), map[string]interface{}{ipGroupLoggingKey: ipGroupId})
+
+	c := meta.(*Client)
+	ipGroup, _, err := executeIpGroupRead(ctx, c, ipGroupId)
+	if err != nil {
+		return diag.Errorf("error reading IP Group %q: %s", ipGroupId, createDescriptiveError(err))
+	}
+	ipGroupJson, err := json.Marshal(ipGroup)
+	if err != nil {
+		return diag.Errorf("error reading IP Group %q: error marshaling %#v to json: %s", ipGroupId, ipGroup, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched IP Group %q: %s", ipGroupId, ipGroupJson), map[string]interface{}{ipGroupLoggingKey: ipGroupId})
+
+	if _, err := setIpGroupAttributes(d, ipGroup); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	return nil
+}
+
+func ipGroupDataSourceReadUsingGroupName(ctx context.Context, d *schema.ResourceData, meta interface{}, groupName string) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading IP Group %q=%q", paramGroupName, groupName))
+
+	ipGroups, err := loadIpGroups(ctx, meta.(*Client))
+	if err != nil {
+		return diag.Errorf("error reading IP Group %q: %s", groupName, createDescriptiveError(err))
+	}
+	var matchingIpGroups []iamIpGroup
+	for _, ipGroup := range ipGroups {
+		if ipGroup.GroupName == groupName {
+			matchingIpGroups = append(matchingIpGroups, ipGroup)
+		}
+	}
+	if len(matchingIpGroups) > 1 {
+		return diag.Errorf("error reading IP Group: there are multiple IP Groups with %q=%q", paramGroupName, groupName)
+	}
+	if len(matchingIpGroups) == 0 {
+		return diag.Errorf("error reading IP Group: IP Group with %q=%q was not found", paramGroupName, groupName)
+	}
+	if _, err := setIpGroupAttributes(d, matchingIpGroups[0]); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	return nil
+}
+
+func loadIpGroups(ctx context.Context, c *Client) ([]iamIpGroup, error) {
+	ipGroups := make([]iamIpGroup, 0)
+
+	err := paginate(func(pageToken string) (string, error) {
+		query := url.Values{}
+		query.Set("page_size", strconv.Itoa(listIpGroupsPageSize))
+		if pageToken != "" {
+			query.Set(pageTokenQueryParameter, pageToken)
+		}
+		var ipGroupPageList iamIpGroupList
+		if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodGet, fmt.Sprintf("%s?%s", ipGroupsPath, query.Encode()), nil, &ipGroupPageList); err != nil {
+			return "", err
+		}
+		ipGroups = append(ipGroups, ipGroupPageList.Data...)
+		return ipGroupPageList.Metadata.Next, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading IP Groups: %s", createDescriptiveError(err))
+	}
+	return ipGroups, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ipGroupDataSourceScenarioName = "confluent_ip_group Data Source Lifecycle"
+	ipGroupMissingName            = "missing_ip_group_name"
+	// The IP Group with ipGroupName is on the last page
+	ipGroupsLastPagePageToken = "eyJpZCI6ImlwZy1naGk3ODkifQ"
+)
+
+func TestAccDataSourceIpGroup(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readCreatedIpGroupResponse, _ := ioutil.ReadFile("../testdata/ip_group/read_created_ip_group.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipGroupUrlPath)).
+		InScenario(ipGroupDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readCreatedIpGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readIpGroupsPageOneResponse, _ := ioutil.ReadFile("../testdata/ip_group/read_ip_groups_page_1.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/ip-groups")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listIpGroupsPageSize))).
+		InScenario(ipGroupDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readIpGroupsPageOneResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readIpGroupsPageTwoResponse, _ := ioutil.ReadFile("../testdata/ip_group/read_ip_groups_page_2.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/ip-groups")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listIpGroupsPageSize))).
+		WithQueryParam("page_token", wiremock.EqualTo(ipGroupsLastPagePageToken)).
+		InScenario(ipGroupDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readIpGroupsPageTwoResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	fullIpGroupDataSourceLabel := fmt.Sprintf("data.confluent_ip_group.%s", ipGroupResourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceIpGroupConfig(mockServerUrl, paramId, ipGroupId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullIpGroupDataSourceLabel, paramId, ipGroupId),
+					resource.TestCheckResourceAttr(fullIpGroupDataSourceLabel, paramGroupName, ipGroupName),
+					resource.TestCheckResourceAttr(fullIpGroupDataSourceLabel, "cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupDataSourceLabel, "cidr_blocks.*", ipGroupFirstCidr),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupDataSourceLabel, "cidr_blocks.*", ipGroupSecondCidr),
+				),
+			},
+			{
+				Config: testAccCheckDataSourceIpGroupConfig(mockServerUrl, paramGroupName, ipGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullIpGroupDataSourceLabel, paramId, ipGroupId),
+					resource.TestCheckResourceAttr(fullIpGroupDataSourceLabel, paramGroupName, ipGroupName),
+					resource.TestCheckResourceAttr(fullIpGroupDataSourceLabel, "cidr_blocks.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckDataSourceIpGroupConfig(mockServerUrl, paramGroupName, ipGroupMissingName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("IP Group with \"group_name\"=\"%s\" was not found", ipGroupMissingName)),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceIpGroupConfig(mockServerUrl, attributeName, attributeValue string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_ip_group" "%s" {
+		%s = "%s"
+	}
+	`, mockServerUrl, ipGroupResourceName, attributeName, attributeValue)
+}
@@ -0,0 +1,218 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramAcls = "acls"
+)
+
+func kafkaAclDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaAclDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The REST endpoint of the Kafka cluster, for example, `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+			},
+			paramCredentials: credentialsSchema(),
+			// Omitted filters match any value, just like the Kafka REST API does
+			paramResourceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The type of the resource to filter Kafka ACLs by.",
+				ValidateFunc: validation.StringInSlice(acceptedResourceTypes, false),
+			},
+			paramResourceName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The resource name to filter Kafka ACLs by.",
+			},
+			paramPatternType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The pattern type to filter Kafka ACLs by.",
+				ValidateFunc: validation.StringInSlice(acceptedPatternTypes, false),
+			},
+			paramPrincipal: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The principal to filter Kafka ACLs by.",
+				ValidateDiagFunc: principalValidate,
+			},
+			paramHost: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The host to filter Kafka ACLs by.",
+			},
+			paramOperation: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The operation type to filter Kafka ACLs by.",
+				ValidateFunc: validation.StringInSlice(acceptedOperations, false),
+			},
+			paramPermission: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The permission to filter Kafka ACLs by.",
+				ValidateFunc: validation.StringInSlice(acceptedPermissions, false),
+			},
+			paramAcls: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of Kafka ACLs that match the filter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramResourceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPatternType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPrincipal: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramHost: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramOperation: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPermission: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kafkaAclDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka ACLs for Kafka Cluster %q", clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	request := kafkaRestClient.apiClient.ACLV3Api.GetKafkaAcls(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId)
+	if resourceType := d.Get(paramResourceType).(string); resourceType != "" {
+		aclResourceType, err := stringToAclResourceType(resourceType)
+		if err != nil {
+			return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+		}
+		request = request.ResourceType(aclResourceType)
+	}
+	if resourceName := d.Get(paramResourceName).(string); resourceName != "" {
+		request = request.ResourceName(resourceName)
+	}
+	if patternType := d.Get(paramPatternType).(string); patternType != "" {
+		request = request.PatternType(patternType)
+	}
+	if principal := d.Get(paramPrincipal).(string); principal != "" {
+		normalizedPrincipal, err := normalizePrincipal(principal)
+		if err != nil {
+			return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+		}
+		request = request.Principal(normalizedPrincipal)
+	}
+	if host := d.Get(paramHost).(string); host != "" {
+		request = request.Host(host)
+	}
+	if operation := d.Get(paramOperation).(string); operation != "" {
+		request = request.Operation(operation)
+	}
+	if permission := d.Get(paramPermission).(string); permission != "" {
+		request = request.Permission(permission)
+	}
+
+	acls, _, err := request.Execute()
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs for Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	aclsJson, err := json.Marshal(acls)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs for Kafka Cluster %q: error marshaling %#v to json: %s", clusterId, acls, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka ACLs for Kafka Cluster %q: %s", clusterId, aclsJson), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	result := make([]map[string]interface{}, len(acls.GetData()))
+	for i, aclData := range acls.GetData() {
+		result[i] = buildTfAcl(Acl{
+			ResourceType: aclData.GetResourceType(),
+			ResourceName: aclData.GetResourceName(),
+			PatternType:  aclData.GetPatternType(),
+			Principal:    aclData.GetPrincipal(),
+			Host:         aclData.GetHost(),
+			Operation:    aclData.GetOperation(),
+			Permission:   aclData.GetPermission(),
+		})
+	}
+
+	if err := d.Set(paramAcls, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	if !kafkaRestClient.isClusterIdSetInProviderBlock {
+		if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, kafkaRestClient.clusterId, d); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+	if !kafkaRestClient.isMetadataSetInProviderBlock {
+		if err := setKafkaCredentials(kafkaRestClient.clusterApiKey, kafkaRestClient.clusterApiSecret, d); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		if err := d.Set(paramRestEndpoint, kafkaRestClient.restEndpoint); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+
+	d.SetId(kafkaRestClient.clusterId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka ACLs for Kafka Cluster %q", clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	aclDataSourceScenarioName      = "confluent_kafka_acl Data Source Lifecycle"
+	aclDataSourceLabel             = "test_acl_data_source_label"
+	aclDataSourceResourceName      = "orders"
+	aclDataSourcePrefixedName      = "ord"
+	aclDataSourcePatternType       = "MATCH"
+	aclDataSourceOperation         = "ANY"
+	aclDataSourceBarePrincipal     = "sa-abc123"
+	aclDataSourceFirstOperation    = "READ"
+	aclDataSourceSecondOperation   = "WRITE"
+	aclDataSourceSecondPatternType = "PREFIXED"
+)
+
+var fullAclDataSourceLabel = fmt.Sprintf("data.confluent_kafka_acl.%s", aclDataSourceLabel)
+
+func TestAccDataSourceKafkaAcl(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockAclTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockAclTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readAclsResponse, _ := os.ReadFile("../testdata/kafka_acl/search_matching_kafka_topic_acls.json")
+	// The bare service account ID is expected to be sent as a principal
+	readAclsStub := wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		WithQueryParam("resource_type", wiremock.EqualTo(aclsTopicResourceType)).
+		WithQueryParam("resource_name", wiremock.EqualTo(aclDataSourceResourceName)).
+		WithQueryParam("pattern_type", wiremock.EqualTo(aclDataSourcePatternType)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithResourceId)).
+		WithQueryParam("operation", wiremock.EqualTo(aclDataSourceOperation)).
+		InScenario(aclDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readAclsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readAclsStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceKafkaAclConfig(confluentCloudBaseUrl, mockAclTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "id", clusterId),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "rest_endpoint", mockAclTestServerUrl),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.#", "2"),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.resource_type", aclsTopicResourceType),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.resource_name", aclDataSourceResourceName),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.pattern_type", aclPatternType),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.principal", aclPrincipalWithResourceId),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.host", aclHost),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.operation", aclDataSourceFirstOperation),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.0.permission", aclPermission),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.resource_type", aclsTopicResourceType),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.resource_name", aclDataSourcePrefixedName),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.pattern_type", aclDataSourceSecondPatternType),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.principal", aclPrincipalWithResourceId),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.host", aclHost),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.operation", aclDataSourceSecondOperation),
+					resource.TestCheckResourceAttr(fullAclDataSourceLabel, "acls.1.permission", aclPermission),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, readAclsStub, fmt.Sprintf("GET %s", createKafkaAclPath), expectedCountOne)
+}
+
+func testAccCheckDataSourceKafkaAclConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_kafka_acl" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+
+	  resource_type = "%s"
+	  resource_name = "%s"
+	  pattern_type = "%s"
+	  principal = "%s"
+	  operation = "%s"
+
+	  rest_endpoint = "%s"
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, aclDataSourceLabel, clusterId, aclsTopicResourceType, aclDataSourceResourceName, aclDataSourcePatternType,
+		aclDataSourceBarePrincipal, aclDataSourceOperation, mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
@@ -140,7 +140,7 @@ func kafkaDataSourceReadUsingId(ctx context.Context, d *schema.ResourceData, met
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster %q=%q", paramId, clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
 
 	c := meta.(*Client)
-	cluster, _, err := executeKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
+	cluster, err := executeCachedKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
 	if err != nil {
 		return diag.Errorf("error reading Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
 	}
@@ -169,31 +169,16 @@ func orgHasMultipleKafkaClustersWithTargetDisplayName(clusters []v2.CmkV2Cluster
 func loadKafkaClusters(ctx context.Context, c *Client, environmentId string) ([]v2.CmkV2Cluster, error) {
 	clusters := make([]v2.CmkV2Cluster, 0)
 
-	allClustersAreCollected := false
-	pageToken := ""
-	for !allClustersAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		clustersPageList, _, err := executeListKafkaClusters(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
+			return "", err
 		}
 		clusters = append(clusters, clustersPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := clustersPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allClustersAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allClustersAreCollected = true
-		}
+		return clustersPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
 	}
 	return clusters, nil
 }
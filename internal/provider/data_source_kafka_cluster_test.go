@@ -20,6 +20,7 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -27,6 +28,10 @@ import (
 
 const (
 	dataSourceKafkaScenarioName = "confluent_kafka Data Source Lifecycle"
+
+	// Environment with 2 Kafka Clusters that share the same display name
+	kafkaDuplicateDisplayNamesEnvironmentId = "env-2kszvk"
+	kafkaMissingDisplayName                 = "MissingCluster"
 )
 
 var fullKafkaDataSourceLabel = fmt.Sprintf("data.confluent_kafka_cluster.%s", kafkaResourceLabel)
@@ -70,6 +75,17 @@ func TestAccDataSourceCluster(t *testing.T) {
 			http.StatusOK,
 		))
 
+	readClustersWithDuplicateDisplayNamesResponse, _ := ioutil.ReadFile("../testdata/kafka/read_kafkas_with_duplicate_display_names.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/cmk/v2/clusters")).
+		InScenario(dataSourceKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(kafkaDuplicateDisplayNamesEnvironmentId)).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readClustersWithDuplicateDisplayNamesResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -118,6 +134,14 @@ func TestAccDataSourceCluster(t *testing.T) {
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "rbac_crn", kafkaRbacCrn),
 				),
 			},
+			{
+				Config:      testAccCheckDataSourceClusterConfigWithDisplayNameAndEnvironmentSet(mockServerUrl, kafkaMissingDisplayName, testEnvironmentId),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Kafka Cluster with \"display_name\"=\"%s\" was not found", kafkaMissingDisplayName)),
+			},
+			{
+				Config:      testAccCheckDataSourceClusterConfigWithDisplayNameAndEnvironmentSet(mockServerUrl, kafkaDisplayName, kafkaDuplicateDisplayNamesEnvironmentId),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("there are multiple Kafka Clusters with \"display_name\"=\"%s\"", kafkaDisplayName)),
+			},
 		},
 	})
 }
@@ -137,6 +161,10 @@ func testAccCheckDataSourceClusterConfigWithIdSet(mockServerUrl string) string {
 }
 
 func testAccCheckDataSourceClusterConfigWithDisplayNameSet(mockServerUrl string) string {
+	return testAccCheckDataSourceClusterConfigWithDisplayNameAndEnvironmentSet(mockServerUrl, kafkaDisplayName, testEnvironmentId)
+}
+
+func testAccCheckDataSourceClusterConfigWithDisplayNameAndEnvironmentSet(mockServerUrl, displayName, environmentId string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
  		endpoint = "%s"
@@ -147,5 +175,5 @@ func testAccCheckDataSourceClusterConfigWithDisplayNameSet(mockServerUrl string)
 			id = "%s"
 	  	}
 	}
-	`, mockServerUrl, kafkaDisplayName, testEnvironmentId)
+	`, mockServerUrl, displayName, environmentId)
 }
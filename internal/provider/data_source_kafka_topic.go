@@ -17,11 +17,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	paramConfigDetails = "config_details"
+	paramSource        = "source"
+	paramIsDefault     = "is_default"
+	paramIsReadOnly    = "is_read_only"
+	paramIsSensitive   = "is_sensitive"
+)
+
 func kafkaTopicDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: kafkaTopicDataSourceRead,
@@ -47,6 +58,44 @@ func kafkaTopicDataSource() *schema.Resource {
 				},
 				Computed: true,
 			},
+			paramReplicaPlacement: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			paramConfigDetails: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of all settings of the Kafka Topic, including the default ones, along with their sources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramSource: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The source of the setting, for example, `DEFAULT_CONFIG`, `STATIC_BROKER_CONFIG` or `DYNAMIC_TOPIC_CONFIG`.",
+						},
+						paramIsDefault: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						paramIsReadOnly: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						paramIsSensitive: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -75,11 +124,44 @@ func kafkaTopicDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error reading Kafka Topic %q: %s", topicName, createDescriptiveError(err))
 	}
 
+	// 'config' attribute only contains the settings that were overridden for the topic
+	topicConfigList, _, err := kafkaRestClient.apiClient.ConfigsV3Api.ListKafkaTopicConfigs(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, topicName).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic %q: could not load configs %s", topicName, createDescriptiveError(err))
+	}
+	if err := d.Set(paramConfigDetails, buildTopicConfigDetails(topicConfigList.Data)); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Topic %q", topicName))
 
 	return nil
 }
 
+// buildTopicConfigDetails returns the settings sorted by name. The values of sensitive settings are never returned
+// by Kafka REST API, so they're set to an empty string.
+func buildTopicConfigDetails(topicConfigs []kafkarestv3.TopicConfigData) []interface{} {
+	sort.Slice(topicConfigs, func(i, j int) bool {
+		return topicConfigs[i].Name < topicConfigs[j].Name
+	})
+	configDetails := make([]interface{}, len(topicConfigs))
+	for i, topicConfig := range topicConfigs {
+		value := ""
+		if topicConfig.Value.IsSet() && topicConfig.Value.Get() != nil {
+			value = *topicConfig.Value.Get()
+		}
+		configDetails[i] = map[string]interface{}{
+			paramName:        topicConfig.Name,
+			paramValue:       value,
+			paramSource:      topicConfig.Source,
+			paramIsDefault:   topicConfig.IsDefault,
+			paramIsReadOnly:  topicConfig.IsReadOnly,
+			paramIsSensitive: topicConfig.IsSensitive,
+		}
+	}
+	return configDetails
+}
+
 func optionalKafkaClusterBlockDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type: schema.TypeList,
@@ -17,9 +17,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -27,7 +29,9 @@ import (
 )
 
 const (
-	topicDataSourceScenarioName = "confluent_kafka_topic Data Source Lifecycle"
+	topicDataSourceScenarioName               = "confluent_kafka_topic Data Source Lifecycle"
+	numberOfKafkaTopicDataSourceAttributes    = "9"
+	numberOfKafkaTopicDataSourceConfigDetails = "26"
 )
 
 var fullTopicDataSourceLabel = fmt.Sprintf("data.confluent_kafka_topic.%s", topicResourceLabel)
@@ -83,13 +87,24 @@ func TestAccDataSourceTopic(t *testing.T) {
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
-					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "%", numberOfResourceAttributes),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "%", numberOfKafkaTopicDataSourceAttributes),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "topic_name", topicName),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "partitions_count", strconv.Itoa(partitionCount)),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "rest_endpoint", mockTopicTestServerUrl),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config.%", "2"),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config.max.message.bytes", "12345"),
 					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config.retention.ms", "6789"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.#", numberOfKafkaTopicDataSourceConfigDetails),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.0.name", "cleanup.policy"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.0.value", "delete"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.0.source", "DEFAULT_CONFIG"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.0.is_default", "true"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.10.name", "max.message.bytes"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.10.value", "12345"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.10.source", "DYNAMIC_TOPIC_CONFIG"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.10.is_default", "false"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.17.name", "min.insync.replicas"),
+					resource.TestCheckResourceAttr(fullTopicDataSourceLabel, "config_details.17.source", "STATIC_BROKER_CONFIG"),
 				),
 			},
 		},
@@ -116,3 +131,21 @@ func testAccCheckDataSourceTopicConfig(confluentCloudBaseUrl, mockServerUrl stri
 	}
 	`, confluentCloudBaseUrl, topicResourceLabel, clusterId, topicName, mockServerUrl, kafkaApiKey, kafkaApiSecret)
 }
+
+func TestBuildTopicConfigDetails(t *testing.T) {
+	retentionMs := "6789"
+	topicConfigs := []kafkarestv3.TopicConfigData{
+		{Name: "retention.ms", Value: *kafkarestv3.NewNullableString(&retentionMs), Source: dynamicTopicConfig},
+		{Name: "sasl.jaas.config", Value: *kafkarestv3.NewNullableString(nil), Source: "STATIC_BROKER_CONFIG", IsSensitive: true, IsReadOnly: true},
+		{Name: "cleanup.policy", Value: *kafkarestv3.NewNullableString(kafkarestv3.PtrString("delete")), Source: "DEFAULT_CONFIG", IsDefault: true},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{paramName: "cleanup.policy", paramValue: "delete", paramSource: "DEFAULT_CONFIG", paramIsDefault: true, paramIsReadOnly: false, paramIsSensitive: false},
+		map[string]interface{}{paramName: "retention.ms", paramValue: "6789", paramSource: dynamicTopicConfig, paramIsDefault: false, paramIsReadOnly: false, paramIsSensitive: false},
+		map[string]interface{}{paramName: "sasl.jaas.config", paramValue: "", paramSource: "STATIC_BROKER_CONFIG", paramIsDefault: false, paramIsReadOnly: true, paramIsSensitive: true},
+	}
+	if actual := buildTopicConfigDetails(topicConfigs); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramTopics = "topics"
+)
+
+func kafkaTopicsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaTopicsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The REST endpoint of the Kafka cluster, for example, `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+			},
+			paramCredentials: credentialsSchema(),
+			paramTopics: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of Kafka Topics in the Kafka cluster.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramTopicName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the topic.",
+						},
+						paramPartitionsCount: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of partitions in the topic.",
+						},
+						paramConfigs: {
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed:    true,
+							Description: "The custom topic settings.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kafkaTopicsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topics: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topics: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topics: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Topics for Kafka Cluster %q", clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	// Kafka REST API returns all topics of a cluster in a single response
+	topics, _, err := kafkaRestClient.apiClient.TopicV3Api.ListKafkaTopics(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topics for Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	topicsJson, err := json.Marshal(topics)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topics for Kafka Cluster %q: error marshaling %#v to json: %s", clusterId, topics, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka Topics for Kafka Cluster %q: %s", clusterId, topicsJson), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	result := make([]map[string]interface{}, len(topics.GetData()))
+	for i, topic := range topics.GetData() {
+		configs, err := loadTopicConfigs(ctx, d, kafkaRestClient, topic.GetTopicName(), nil)
+		if err != nil {
+			return diag.Errorf("error reading Kafka Topics for Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+		}
+		result[i] = map[string]interface{}{
+			paramTopicName:       topic.GetTopicName(),
+			paramPartitionsCount: topic.GetPartitionsCount(),
+			paramConfigs:         configs,
+		}
+	}
+
+	if err := d.Set(paramTopics, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	if !kafkaRestClient.isClusterIdSetInProviderBlock {
+		if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, kafkaRestClient.clusterId, d); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+	if !kafkaRestClient.isMetadataSetInProviderBlock {
+		if err := setKafkaCredentials(kafkaRestClient.clusterApiKey, kafkaRestClient.clusterApiSecret, d); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		if err := d.Set(paramRestEndpoint, kafkaRestClient.restEndpoint); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+
+	d.SetId(kafkaRestClient.clusterId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Topics for Kafka Cluster %q", clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	return nil
+}
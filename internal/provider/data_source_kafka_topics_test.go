@@ -0,0 +1,134 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	topicsDataSourceScenarioName = "confluent_kafka_topics Data Source Lifecycle"
+	topicsDataSourceLabel        = "test_topics_data_source_label"
+	secondTopicName              = "test_topic_name_2"
+	secondTopicPartitionCount    = 6
+)
+
+var fullTopicsDataSourceLabel = fmt.Sprintf("data.confluent_kafka_topics.%s", topicsDataSourceLabel)
+var readSecondKafkaTopicConfigPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/configs", clusterId, secondTopicName)
+
+func TestAccDataSourceTopics(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockTopicTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockTopicTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readTopicsResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_kafka_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaTopicPath)).
+		InScenario(topicsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic_config.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(topicsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readCreatedTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readSecondTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_second_kafka_topic_config.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSecondKafkaTopicConfigPath)).
+		InScenario(topicsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readSecondTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceTopicsConfig(confluentCloudBaseUrl, mockTopicTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "id", clusterId),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "rest_endpoint", mockTopicTestServerUrl),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.#", "2"),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.0.topic_name", topicName),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.0.partitions_count", strconv.Itoa(partitionCount)),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.0.config.%", "2"),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.0.config.max.message.bytes", "12345"),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.0.config.retention.ms", "6789"),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.1.topic_name", secondTopicName),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.1.partitions_count", strconv.Itoa(secondTopicPartitionCount)),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.1.config.%", "1"),
+					resource.TestCheckResourceAttr(fullTopicsDataSourceLabel, "topics.1.config.cleanup.policy", "compact"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceTopicsConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_kafka_topics" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+
+	  rest_endpoint = "%s"
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, topicsDataSourceLabel, clusterId, mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
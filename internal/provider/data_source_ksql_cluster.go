@@ -150,31 +150,16 @@ func orgHasMultipleKsqlClustersWithTargetDisplayName(clusters []ksql.KsqldbcmV2C
 func loadKsqlClusters(ctx context.Context, c *Client, environmentId string) ([]ksql.KsqldbcmV2Cluster, error) {
 	clusters := make([]ksql.KsqldbcmV2Cluster, 0)
 
-	allClustersAreCollected := false
-	pageToken := ""
-	for !allClustersAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		clustersPageList, _, err := executeListKsqlClusters(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading ksqlDB Clusters: %s", createDescriptiveError(err))
+			return "", err
 		}
 		clusters = append(clusters, clustersPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := clustersPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allClustersAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading ksqlDB Clusters: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allClustersAreCollected = true
-		}
+		return clustersPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading ksqlDB Clusters: %s", createDescriptiveError(err))
 	}
 	return clusters, nil
 }
@@ -171,31 +171,16 @@ func orgHasMultipleNetworksWithTargetDisplayName(clusters []net.NetworkingV1Netw
 func loadNetworks(ctx context.Context, c *Client, environmentId string) ([]net.NetworkingV1Network, error) {
 	networks := make([]net.NetworkingV1Network, 0)
 
-	allNetworksAreCollected := false
-	pageToken := ""
-	for !allNetworksAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		networksPageList, _, err := executeListNetworks(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Networks: %s", createDescriptiveError(err))
+			return "", err
 		}
 		networks = append(networks, networksPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := networksPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allNetworksAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Networks: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allNetworksAreCollected = true
-		}
+		return networksPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Networks: %s", createDescriptiveError(err))
 	}
 	return networks, nil
 }
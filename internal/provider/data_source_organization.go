@@ -25,6 +25,7 @@ import (
 
 const crnEnvironmentSuffix = "/environment="
 const crnOrgSuffix = "/organization="
+const paramJitEnabled = "jit_enabled"
 
 func organizationDataSource() *schema.Resource {
 	return &schema.Resource{
@@ -40,6 +41,11 @@ func organizationDataSource() *schema.Resource {
 				Description: "The Confluent Resource Name of the Organization.",
 				Computed:    true,
 			},
+			paramJitEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether Just-In-Time user provisioning is enabled for the Organization.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -71,6 +77,14 @@ func organizationDataSourceRead(ctx context.Context, d *schema.ResourceData, met
 	if err := d.Set(paramResourceName, organizationResourceName); err != nil {
 		return diag.FromErr(createDescriptiveError(err))
 	}
+
+	organization, _, err := c.orgClient.OrganizationsOrgV2Api.GetOrgV2Organization(c.orgApiContext(ctx), organizationId).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Organization %q: %s", organizationId, createDescriptiveError(err))
+	}
+	if err := d.Set(paramJitEnabled, organization.GetJitEnabled()); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
 	d.SetId(organizationId)
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Organization %q", organizationId))
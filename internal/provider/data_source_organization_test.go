@@ -63,6 +63,16 @@ func TestAccDataSourceOrganization(t *testing.T) {
 			http.StatusOK,
 		))
 
+	readOrganizationResponse, _ := ioutil.ReadFile("../testdata/organization/read_organization.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("/org/v2/organizations/%s", expectedOrgId))).
+		InScenario(organizationDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readOrganizationResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -75,6 +85,7 @@ func TestAccDataSourceOrganization(t *testing.T) {
 					testAccCheckOrganizationExists(fullOrganizationDataSourceLabel),
 					resource.TestCheckResourceAttr(fullOrganizationDataSourceLabel, "id", expectedOrgId),
 					resource.TestCheckResourceAttr(fullOrganizationDataSourceLabel, "resource_name", expectedOrgResourceName),
+					resource.TestCheckResourceAttr(fullOrganizationDataSourceLabel, "jit_enabled", "true"),
 				),
 			},
 		},
@@ -134,31 +134,16 @@ func orgHasMultiplePeeringsWithTargetDisplayName(peerings []net.NetworkingV1Peer
 func loadPeerings(ctx context.Context, c *Client, environmentId string) ([]net.NetworkingV1Peering, error) {
 	peerings := make([]net.NetworkingV1Peering, 0)
 
-	allPeeringsAreCollected := false
-	pageToken := ""
-	for !allPeeringsAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		peeringsPageList, _, err := executeListPeerings(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Peerings: %s", createDescriptiveError(err))
+			return "", err
 		}
 		peerings = append(peerings, peeringsPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := peeringsPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allPeeringsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Peerings: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allPeeringsAreCollected = true
-		}
+		return peeringsPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Peerings: %s", createDescriptiveError(err))
 	}
 	return peerings, nil
 }
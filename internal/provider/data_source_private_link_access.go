@@ -134,31 +134,16 @@ func orgHasMultiplePrivateLinkAccessesWithTargetDisplayName(privateLinkAccesses
 func loadPrivateLinkAccesses(ctx context.Context, c *Client, environmentId string) ([]net.NetworkingV1PrivateLinkAccess, error) {
 	privateLinkAccesses := make([]net.NetworkingV1PrivateLinkAccess, 0)
 
-	allPrivateLinkAccessesAreCollected := false
-	pageToken := ""
-	for !allPrivateLinkAccessesAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		privateLinkAccessesPageList, _, err := executeListPrivateLinkAccesses(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading PrivateLinkAccesses: %s", createDescriptiveError(err))
+			return "", err
 		}
 		privateLinkAccesses = append(privateLinkAccesses, privateLinkAccessesPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := privateLinkAccessesPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allPrivateLinkAccessesAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading PrivateLinkAccesses: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allPrivateLinkAccessesAreCollected = true
-		}
+		return privateLinkAccessesPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading PrivateLinkAccesses: %s", createDescriptiveError(err))
 	}
 	return privateLinkAccesses, nil
 }
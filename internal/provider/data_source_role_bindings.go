@@ -0,0 +1,137 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	mds "github.com/confluentinc/ccloud-sdk-go-v2/mds/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramRoleBindings = "role_bindings"
+
+	// The maximum allowable page size - 1 (to avoid off-by-one errors) when listing role bindings using IAM V2 API
+	// https://docs.confluent.io/cloud/current/api.html#tag/Role-Bindings-(iamv2)/operation/listIamV2RoleBindings
+	listRoleBindingsPageSize = 99
+)
+
+func roleBindingsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: roleBindingsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The principal User to list the role bindings for.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^User:"), "the Principal must be of the form 'User:'"),
+			},
+			paramCrnPattern: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A CRN that specifies the scope to list the role bindings in.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^crn://"), "the CRN must be of the form 'crn://'"),
+			},
+			paramRoleBindings: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of Role Bindings of the principal.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the Role Binding (e.g., `rb-abc123`).",
+						},
+						paramRoleName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the role bound to the principal.",
+						},
+						paramCrnPattern: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A CRN that specifies the scope and resource patterns of the role binding.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func roleBindingsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	principal := d.Get(paramPrincipal).(string)
+	crnPattern := d.Get(paramCrnPattern).(string)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Role Bindings for %q=%q", paramPrincipal, principal))
+
+	c := meta.(*Client)
+	roleBindings, err := loadRoleBindings(ctx, c, principal, crnPattern)
+	if err != nil {
+		return diag.Errorf("error reading Role Bindings for %q=%q: %s", paramPrincipal, principal, createDescriptiveError(err))
+	}
+
+	result := make([]map[string]interface{}, len(roleBindings))
+	for i, roleBinding := range roleBindings {
+		result[i] = map[string]interface{}{
+			paramId:         roleBinding.GetId(),
+			paramRoleName:   roleBinding.GetRoleName(),
+			paramCrnPattern: roleBinding.GetCrnPattern(),
+		}
+	}
+	if err := d.Set(paramRoleBindings, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(principal)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Role Bindings for %q=%q", len(roleBindings), paramPrincipal, principal))
+
+	return nil
+}
+
+func loadRoleBindings(ctx context.Context, c *Client, principal, crnPattern string) ([]mds.IamV2RoleBinding, error) {
+	roleBindings := make([]mds.IamV2RoleBinding, 0)
+
+	err := paginate(func(pageToken string) (string, error) {
+		roleBindingPageList, _, err := executeListRoleBindings(ctx, c, principal, crnPattern, pageToken)
+		if err != nil {
+			return "", err
+		}
+		roleBindings = append(roleBindings, roleBindingPageList.GetData()...)
+		return roleBindingPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Role Bindings: %s", createDescriptiveError(err))
+	}
+	return roleBindings, nil
+}
+
+func executeListRoleBindings(ctx context.Context, c *Client, principal, crnPattern, pageToken string) (mds.IamV2RoleBindingList, *http.Response, error) {
+	req := c.mdsClient.RoleBindingsIamV2Api.ListIamV2RoleBindings(c.mdsApiContext(ctx)).Principal(principal).PageSize(listRoleBindingsPageSize)
+	if crnPattern != "" {
+		req = req.CrnPattern(crnPattern)
+	}
+	if pageToken != "" {
+		req = req.PageToken(pageToken)
+	}
+	return req.Execute()
+}
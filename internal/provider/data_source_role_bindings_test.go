@@ -0,0 +1,116 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	roleBindingsDataSourceScenarioName = "confluent_role_bindings Data Source Lifecycle"
+	roleBindingsLastPagePageToken      = "dyJpZCI6InJiLTdQUHpOIn0"
+	roleBindingsUrlPath                = "/iam/v2/role-bindings"
+
+	secondRoleBindingId       = "rb-7PPzN"
+	secondRoleBindingRoleName = "EnvironmentAdmin"
+	secondRoleBindingCrn      = "crn://confluent.cloud/organization=0d9c5d94-e4fe-44ec-9cf1-bd99761fca75/environment=env-ym2y0k"
+)
+
+func TestAccDataSourceRoleBindings(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	listRoleBindingsPageOneResponse, _ := ioutil.ReadFile("../testdata/role_binding/list_role_bindings_page_1.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(roleBindingsUrlPath)).
+		WithQueryParam("principal", wiremock.EqualTo(rbPrincipal)).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listRoleBindingsPageSize))).
+		InScenario(roleBindingsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listRoleBindingsPageOneResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listRoleBindingsPageTwoResponse, _ := ioutil.ReadFile("../testdata/role_binding/list_role_bindings_page_2.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(roleBindingsUrlPath)).
+		WithQueryParam("principal", wiremock.EqualTo(rbPrincipal)).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listRoleBindingsPageSize))).
+		WithQueryParam("page_token", wiremock.EqualTo(roleBindingsLastPagePageToken)).
+		InScenario(roleBindingsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listRoleBindingsPageTwoResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	fullRoleBindingsDataSourceLabel := fmt.Sprintf("data.confluent_role_bindings.%s", rbResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckRoleBindingsDataSourceConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "id", rbPrincipal),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "principal", rbPrincipal),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.#", "2"),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.0.id", roleBindingId),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.0.role_name", rbRolename),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.0.crn_pattern", rbCrn),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.1.id", secondRoleBindingId),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.1.role_name", secondRoleBindingRoleName),
+					resource.TestCheckResourceAttr(fullRoleBindingsDataSourceLabel, "role_bindings.1.crn_pattern", secondRoleBindingCrn),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoleBindingsDataSourceConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_role_bindings" "%s" {
+		principal = "%s"
+	}
+	`, mockServerUrl, rbResourceLabel, rbPrincipal)
+}
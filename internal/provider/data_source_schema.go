@@ -17,6 +17,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,9 +61,19 @@ func schemaDataSource() *schema.Resource {
 				Description: "The version number of the Schema.",
 			},
 			paramSchemaIdentifier: {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Globally unique identifier of the Schema returned for a creation request. It should be used to retrieve this schema from the schemas resource and is different from the schema’s version which is associated with the subject.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Globally unique identifier of the Schema returned for a creation request. It should be used to retrieve this schema from the schemas resource and is different from the schema’s version which is associated with the subject.",
+				ExactlyOneOf: []string{paramSchemaIdentifier, paramLatest},
+			},
+			paramLatest: {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				Description:      "Controls whether the latest version of the Schema registered under the subject should be read instead of the Schema with the given `schema_identifier`.",
+				ExactlyOneOf:     []string{paramSchemaIdentifier, paramLatest},
+				ValidateDiagFunc: latestSchemaValidate,
 			},
 			paramSchemaReference: {
 				Description: "The list of references to other Schemas.",
@@ -105,10 +116,24 @@ func schemaDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "Controls whether a schema validation should be skipped during terraform plan.",
 			},
+			paramNormalize: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Controls whether the schema should be normalized by Schema Registry when it's registered.",
+			},
 		},
 	}
 }
 
+// latestSchemaValidate rejects `latest = false` since ExactlyOneOf treats it as set,
+// so it would pass validation without `schema_identifier` and fail with a 404 on read.
+func latestSchemaValidate(v interface{}, path cty.Path) diag.Diagnostics {
+	if !v.(bool) {
+		return diag.Errorf("%q must be set to true to read the latest Schema, use %q to read a specific Schema instead", paramLatest, paramSchemaIdentifier)
+	}
+	return nil
+}
+
 func schemaDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Schema %q", d.Id()), map[string]interface{}{schemaLoggingKey: d.Id()})
 
@@ -126,12 +151,24 @@ func schemaDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet)
 	subjectName := d.Get(paramSubjectName).(string)
-	schemaIdentifier := d.Get(paramSchemaIdentifier).(int)
+	schemaIdentifier := strconv.Itoa(d.Get(paramSchemaIdentifier).(int))
+
+	if d.Get(paramLatest).(bool) {
+		latestSchema, resp, err := schemaRegistryRestClient.apiClient.SubjectsV1Api.GetSchemaByVersion(schemaRegistryRestClient.apiContext(ctx), subjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier).Execute()
+		if err != nil {
+			if isNonKafkaRestApiResourceNotFound(resp) {
+				// Schema Registry returns 404 for subjects whose versions have all been (soft) deleted too
+				return diag.Errorf("error reading Schema: Subject %q could not be found on the server: it either doesn't exist or all of its versions have been deleted", subjectName)
+			}
+			return diag.Errorf("error reading Schema: error loading the latest Schema for Subject %q: %s", subjectName, createDescriptiveError(err))
+		}
+		schemaIdentifier = strconv.Itoa(int(latestSchema.GetId()))
+	}
 
 	// Mark resource as new to avoid d.Set("") when getting 404
 	d.MarkNewResource()
 
-	if _, err := readSchemaRegistryConfigAndSetAttributes(ctx, d, schemaRegistryRestClient, subjectName, strconv.Itoa(schemaIdentifier)); err != nil {
+	if _, err := readSchemaRegistryConfigAndSetAttributes(ctx, d, schemaRegistryRestClient, subjectName, schemaIdentifier); err != nil {
 		return diag.Errorf("error reading Schema: %s", createDescriptiveError(err))
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Schema %q", d.Id()), map[string]interface{}{schemaLoggingKey: d.Id()})
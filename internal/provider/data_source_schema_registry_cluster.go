@@ -234,31 +234,16 @@ func orgHasMultipleSchemaRegistryClustersWithTargetDisplayName(clusters []v3.Src
 func loadSchemaRegistryClusters(ctx context.Context, c *Client, environmentId string) ([]v3.SrcmV3Cluster, error) {
 	clusters := make([]v3.SrcmV3Cluster, 0)
 
-	allClustersAreCollected := false
-	pageToken := ""
-	for !allClustersAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		clustersPageList, _, err := executeListSchemaRegistryClusters(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Schema Registry Clusters: %s", createDescriptiveError(err))
+			return "", err
 		}
 		clusters = append(clusters, clustersPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := clustersPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allClustersAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Schema Registry Clusters: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allClustersAreCollected = true
-		}
+		return clustersPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Schema Registry Clusters: %s", createDescriptiveError(err))
 	}
 	return clusters, nil
 }
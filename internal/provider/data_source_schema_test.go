@@ -20,14 +20,18 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const (
 	schemaDataSourceScenarioName = "confluent_schema Data Source Lifecycle"
+
+	testNumberOfSchemaRegistrySchemaDataSourceAttributes = 16
 )
 
 var fullSchemaDataSourceLabel = fmt.Sprintf("data.confluent_schema.%s", testSchemaResourceLabel)
@@ -96,7 +100,7 @@ func TestAccDataSourceSchema(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.1.name", testSecondSchemaReferenceDisplayName),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.1.subject_name", testSecondSchemaReferenceSubject),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.1.version", strconv.Itoa(testSecondSchemaReferenceVersion)),
-					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "%", strconv.Itoa(testNumberOfSchemaRegistrySchemaResourceAttributes)),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "%", strconv.Itoa(testNumberOfSchemaRegistrySchemaDataSourceAttributes)),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "ruleset.#", "1"),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "ruleset.0.%", "2"),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "ruleset.0.domain_rules.#", "2"),
@@ -168,3 +172,153 @@ func testAccCheckSchemaDataSourceConfig(confluentCloudBaseUrl, mockServerUrl str
 	}
 	`, confluentCloudBaseUrl, testSchemaResourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, testSubjectName, testSchemaIdentifier)
 }
+
+func TestAccDataSourceLatestSchema(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readLatestSchemaResponse, _ := ioutil.ReadFile("../testdata/schema_registry_schema/read_latest_schema.json")
+	readLatestSchemaStub := wiremock.Get(wiremock.URLPathEqualTo(readLatestSchemaPath)).
+		InScenario(schemaDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readLatestSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readLatestSchemaStub)
+
+	readCreatedSchemasResponse, _ := ioutil.ReadFile("../testdata/schema_registry_schema/read_schemas.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).
+		InScenario(schemaDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readCreatedSchemasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLatestSchemaDataSourceConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(fullSchemaDataSourceLabel),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "id", fmt.Sprintf("%s/%s/%d", testStreamGovernanceClusterId, testSubjectName, testSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "latest", "true"),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "format", testFormat),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema", testSchemaContent),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "version", strconv.Itoa(testSchemaVersion)),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_identifier", strconv.Itoa(testSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.#", "2"),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "%", strconv.Itoa(testNumberOfSchemaRegistrySchemaDataSourceAttributes)),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, readLatestSchemaStub, fmt.Sprintf("GET %s", readLatestSchemaPath), expectedCountOne)
+}
+
+func TestAccDataSourceLatestSchemaSubjectNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	// Schema Registry returns 404 for a subject whose versions have all been soft deleted
+	readLatestSchemaResponse, _ := ioutil.ReadFile("../testdata/schema_registry_schema/read_latest_schema_subject_not_found.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readLatestSchemaPath)).
+		InScenario(schemaDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readLatestSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLatestSchemaDataSourceConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Subject %q could not be found on the server", testSubjectName)),
+			},
+		},
+	})
+}
+
+func testAccCheckLatestSchemaDataSourceConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_schema" "%s" {
+	  schema_registry_cluster {
+        id = "%s"
+      }
+      rest_endpoint = "%s"
+      credentials {
+        key = "%s"
+        secret = "%s"
+	  }
+	  subject_name = "%s"
+	  latest = true
+	}
+	`, confluentCloudBaseUrl, testSchemaResourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, testSubjectName)
+}
+
+func TestSchemaDataSourceLatestValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        map[string]interface{}
+		expectedError bool
+	}{
+		{"schema_identifier", map[string]interface{}{paramSubjectName: testSubjectName, paramSchemaIdentifier: 10001}, false},
+		{"latest", map[string]interface{}{paramSubjectName: testSubjectName, paramLatest: true}, false},
+		{"latest = false", map[string]interface{}{paramSubjectName: testSubjectName, paramLatest: false}, true},
+		{"schema_identifier and latest", map[string]interface{}{paramSubjectName: testSubjectName, paramSchemaIdentifier: 10001, paramLatest: true}, true},
+		{"neither schema_identifier nor latest", map[string]interface{}{paramSubjectName: testSubjectName}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := schemaDataSource().Validate(terraform.NewResourceConfigRaw(tt.config))
+			if diags.HasError() != tt.expectedError {
+				t.Fatalf("expected an error: %t, got: %v", tt.expectedError, diags)
+			}
+		})
+	}
+}
@@ -113,31 +113,16 @@ func serviceAccountDataSourceReadUsingDisplayName(ctx context.Context, d *schema
 func loadServiceAccounts(ctx context.Context, c *Client) ([]v2.IamV2ServiceAccount, error) {
 	serviceAccounts := make([]v2.IamV2ServiceAccount, 0)
 
-	allServiceAccountsAreCollected := false
-	pageToken := ""
-	for !allServiceAccountsAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		serviceAccountPageList, _, err := executeListServiceAccounts(ctx, c, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Service Accounts: %s", createDescriptiveError(err))
+			return "", err
 		}
 		serviceAccounts = append(serviceAccounts, serviceAccountPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := serviceAccountPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allServiceAccountsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Service Accounts: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allServiceAccountsAreCollected = true
-		}
+		return serviceAccountPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Service Accounts: %s", createDescriptiveError(err))
 	}
 	return serviceAccounts, nil
 }
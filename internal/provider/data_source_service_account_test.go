@@ -20,6 +20,7 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -35,6 +36,9 @@ const (
 	saKind                   = "ServiceAccount"
 	saResourceLabel          = "test_sa_resource_label"
 	saLastPagePageToken      = "dyJpZCI6InNhLTd5OXbyby"
+	// There are Service Accounts with this display name on both pages
+	saAmbiguousDisplayName = "temp"
+	saMissingDisplayName   = "missing_service_account_display_name"
 )
 
 func TestAccDataSourceServiceAccount(t *testing.T) {
@@ -116,6 +120,14 @@ func TestAccDataSourceServiceAccount(t *testing.T) {
 					resource.TestCheckResourceAttr(fullServiceAccountDataSourceLabel, paramDescription, saDescription),
 				),
 			},
+			{
+				Config:      testAccCheckDataSourceServiceAccountConfigWithDisplayNameSet(mockServerUrl, saResourceLabel, saAmbiguousDisplayName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("there are multiple Service Accounts with \"display_name\"=\"%s\"", saAmbiguousDisplayName)),
+			},
+			{
+				Config:      testAccCheckDataSourceServiceAccountConfigWithDisplayNameSet(mockServerUrl, saResourceLabel, saMissingDisplayName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Service Account with \"display_name\"=\"%s\" was not found", saMissingDisplayName)),
+			},
 		},
 	})
 }
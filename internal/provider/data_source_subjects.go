@@ -0,0 +1,110 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func subjectsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: subjectsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramSchemaRegistryCluster: schemaRegistryClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Schema Registry cluster, for example, `https://psrc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramFilter: {
+				MaxItems:    1,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Description: "Subject filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramSchemasFilterSubjectPrefix: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix of the Schema Registry Subject.",
+						},
+						paramSchemasFilterDeleted: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to return soft deleted subjects.",
+						},
+					},
+				},
+			},
+			paramSubjects: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The names of the matching Schema Registry Subjects, sorted alphabetically.",
+			},
+		},
+	}
+}
+
+func subjectsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading Subjects")
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet)
+	subjectPrefix := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterSubjectPrefix)).(string)
+	deleted := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterDeleted)).(bool)
+
+	// The endpoint returns all matching subjects at once, there's no pagination
+	subjects, _, err := schemaRegistryRestClient.apiClient.SubjectsV1Api.List(schemaRegistryRestClient.apiContext(ctx)).SubjectPrefix(subjectPrefix).Deleted(deleted).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	sort.Strings(subjects)
+
+	if err := d.Set(paramSubjects, subjects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return nil
+}
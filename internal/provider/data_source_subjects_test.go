@@ -0,0 +1,114 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/walkerus/go-wiremock"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	subjectsDataSourceScenarioName = "confluent_subjects Data Source Lifecycle"
+	fullSubjectsDataSourceLabel    = "data.confluent_subjects.test_subjects"
+	testSubjectsDataSourceLabel    = "test_subjects"
+	testSubjectsSubjectPrefix      = "test"
+)
+
+var readSubjectsPath = "/subjects"
+
+func TestAccDataSourceSubjects(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readSubjectsResponse, _ := ioutil.ReadFile("../testdata/schema_registry_subjects/read_subjects.json")
+	readSubjectsStub := wiremock.Get(wiremock.URLPathEqualTo(readSubjectsPath)).
+		WithQueryParam("subjectPrefix", wiremock.EqualTo(testSubjectsSubjectPrefix)).
+		WithQueryParam("deleted", wiremock.EqualTo("true")).
+		InScenario(subjectsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readSubjectsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readSubjectsStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSubjectsDataSourceConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "schema_registry_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "schema_registry_cluster.0.id", testStreamGovernanceClusterId),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "rest_endpoint", mockSchemaTestServerUrl),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "filter.#", "1"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "filter.0.subject_prefix", testSubjectsSubjectPrefix),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "filter.0.deleted", "true"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.#", "3"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0", "test1-key"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.1", "test1-value"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.2", "test2-value"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, readSubjectsStub, fmt.Sprintf("GET %s", readSubjectsPath), expectedCountOne)
+}
+
+func testAccCheckSubjectsDataSourceConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_subjects" "%s" {
+	  schema_registry_cluster {
+        id = "%s"
+      }
+      rest_endpoint = "%s"
+      credentials {
+        key = "%s"
+        secret = "%s"
+	  }
+	  filter {
+		subject_prefix = "%s"
+		deleted = true
+	  }
+	}
+	`, confluentCloudBaseUrl, testSubjectsDataSourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, testSubjectsSubjectPrefix)
+}
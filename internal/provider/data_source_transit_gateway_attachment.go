@@ -132,31 +132,16 @@ func orgHasMultipleTransitGatewayAttachmentsWithTargetDisplayName(transitGateway
 func loadTransitGatewayAttachments(ctx context.Context, c *Client, environmentId string) ([]net.NetworkingV1TransitGatewayAttachment, error) {
 	transitGatewayAttachments := make([]net.NetworkingV1TransitGatewayAttachment, 0)
 
-	allTransitGatewayAttachmentsAreCollected := false
-	pageToken := ""
-	for !allTransitGatewayAttachmentsAreCollected {
+	err := paginate(func(pageToken string) (string, error) {
 		transitGatewayAttachmentsPageList, _, err := executeListTransitGatewayAttachments(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading TransitGatewayAttachments: %s", createDescriptiveError(err))
+			return "", err
 		}
 		transitGatewayAttachments = append(transitGatewayAttachments, transitGatewayAttachmentsPageList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := transitGatewayAttachmentsPageList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				allTransitGatewayAttachmentsAreCollected = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading TransitGatewayAttachments: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			allTransitGatewayAttachmentsAreCollected = true
-		}
+		return transitGatewayAttachmentsPageList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading TransitGatewayAttachments: %s", createDescriptiveError(err))
 	}
 	return transitGatewayAttachments, nil
 }
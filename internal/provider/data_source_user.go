@@ -137,31 +137,16 @@ func userDataSourceReadUsingEmail(ctx context.Context, d *schema.ResourceData, m
 func loadUsers(ctx context.Context, c *Client) ([]v2.IamV2User, error) {
 	users := make([]v2.IamV2User, 0)
 
-	collectedAllUsers := false
-	pageToken := ""
-	for !collectedAllUsers {
+	err := paginate(func(pageToken string) (string, error) {
 		userList, _, err := executeListUsers(ctx, c, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Users: %s", createDescriptiveError(err))
+			return "", err
 		}
 		users = append(users, userList.GetData()...)
-
-		// nextPageUrlStringNullable is nil for the last page
-		nextPageUrlStringNullable := userList.GetMetadata().Next
-
-		if nextPageUrlStringNullable.IsSet() {
-			nextPageUrlString := *nextPageUrlStringNullable.Get()
-			if nextPageUrlString == "" {
-				collectedAllUsers = true
-			} else {
-				pageToken, err = extractPageToken(nextPageUrlString)
-				if err != nil {
-					return nil, fmt.Errorf("error reading Users: %s", createDescriptiveError(err))
-				}
-			}
-		} else {
-			collectedAllUsers = true
-		}
+		return userList.Metadata.GetNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Users: %s", createDescriptiveError(err))
 	}
 	return users, nil
 }
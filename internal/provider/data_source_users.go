@@ -17,21 +17,30 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
+	v2 "github.com/confluentinc/ccloud-sdk-go-v2/iam/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
-	paramIds = "ids"
+	paramIds         = "ids"
+	paramUsers       = "users"
+	paramDeactivated = "deactivated"
 )
 
 func usersDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: usersDataSourceRead,
 		Schema: map[string]*schema.Schema{
+			paramEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The email address to filter the Users by.",
+			},
 			paramIds: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -40,6 +49,40 @@ func usersDataSource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			paramUsers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of users with their details.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the User (e.g., `u-abc123`).",
+						},
+						paramEmail: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the User.",
+						},
+						paramFullName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the User.",
+						},
+						paramAuthType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user's authentication method.",
+						},
+						paramDeactivated: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the User has been deactivated.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -53,6 +96,10 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("error reading Users: %s", createDescriptiveError(err))
 	}
 
+	if email := d.Get(paramEmail).(string); email != "" {
+		users = filterUsersByEmail(users, email)
+	}
+
 	result := make([]string, len(users))
 	for i, user := range users {
 		result[i] = user.GetId()
@@ -61,8 +108,37 @@ func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err := d.Set(paramIds, result); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(paramUsers, buildUsersAttribute(users)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 
 	return nil
 }
+
+// filterUsersByEmail matches email addresses case-insensitively since the domain part of an email address is case-insensitive
+func filterUsersByEmail(users []v2.IamV2User, email string) []v2.IamV2User {
+	filteredUsers := make([]v2.IamV2User, 0)
+	for _, user := range users {
+		if strings.EqualFold(user.GetEmail(), email) {
+			filteredUsers = append(filteredUsers, user)
+		}
+	}
+	return filteredUsers
+}
+
+func buildUsersAttribute(users []v2.IamV2User) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(users))
+	for i, user := range users {
+		// Deactivated Users are still listed but might be missing some of their details
+		result[i] = map[string]interface{}{
+			paramId:          user.GetId(),
+			paramEmail:       user.GetEmail(),
+			paramFullName:    user.GetFullName(),
+			paramAuthType:    user.GetAuthType(),
+			paramDeactivated: user.Metadata != nil && user.Metadata.HasDeletedAt(),
+		}
+	}
+	return result
+}
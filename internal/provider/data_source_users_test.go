@@ -17,12 +17,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	v2 "github.com/confluentinc/ccloud-sdk-go-v2/iam/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -89,12 +92,77 @@ func TestAccDataSourceUsers(t *testing.T) {
 					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.0", paramIds), userIds[0]),
 					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.1", paramIds), userIds[1]),
 					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.2", paramIds), userIds[2]),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.#", paramUsers), "3"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.0.%s", paramUsers, paramId), userIds[0]),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.0.%s", paramUsers, paramEmail), "test1@gmail.com"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.0.%s", paramUsers, paramFullName), "Alex #1"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.2.%s", paramUsers, paramId), userIds[2]),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.2.%s", paramUsers, paramEmail), "test3@gmail.com"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.2.%s", paramUsers, paramAuthType), "AUTH_TYPE_SSO"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.2.%s", paramUsers, paramDeactivated), "false"),
+				),
+			},
+			{
+				// The user on the second page must be found too
+				Config: testAccCheckDataSourceUsersWithEmailFilter(mockServerUrl, userResourceLabel, "TEST3@gmail.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExists(fullUserDataSourceLabel),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.#", paramIds), "1"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.0", paramIds), userIds[2]),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.#", paramUsers), "1"),
+					resource.TestCheckResourceAttr(fullUserDataSourceLabel, fmt.Sprintf("%s.0.%s", paramUsers, paramFullName), "Alex #3"),
 				),
 			},
 		},
 	})
 }
 
+func TestFilterUsersByEmail(t *testing.T) {
+	users := []v2.IamV2User{
+		{Id: v2.PtrString("u-1"), Email: v2.PtrString("alice@example.com")},
+		{Id: v2.PtrString("u-2"), Email: v2.PtrString("bob@example.com")},
+		// Deactivated Users might not have an email address
+		{Id: v2.PtrString("u-3")},
+	}
+
+	filteredUsers := filterUsersByEmail(users, "Bob@Example.com")
+	if len(filteredUsers) != 1 || filteredUsers[0].GetId() != "u-2" {
+		t.Fatalf("Unexpected result: expected [u-2], got %v", filteredUsers)
+	}
+	if filteredUsers := filterUsersByEmail(users, "carol@example.com"); len(filteredUsers) != 0 {
+		t.Fatalf("Unexpected result: expected no Users, got %v", filteredUsers)
+	}
+}
+
+func TestBuildUsersAttribute(t *testing.T) {
+	deletedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := []v2.IamV2User{
+		{
+			Id:       v2.PtrString("u-1"),
+			Email:    v2.PtrString("alice@example.com"),
+			FullName: v2.PtrString("Alice"),
+			AuthType: v2.PtrString("AUTH_TYPE_LOCAL"),
+			Metadata: &v2.ObjectMeta{},
+		},
+		{
+			Id:       v2.PtrString("u-2"),
+			Metadata: &v2.ObjectMeta{DeletedAt: &deletedAt},
+		},
+		{
+			Id: v2.PtrString("u-3"),
+		},
+	}
+	expected := []map[string]interface{}{
+		{paramId: "u-1", paramEmail: "alice@example.com", paramFullName: "Alice", paramAuthType: "AUTH_TYPE_LOCAL", paramDeactivated: false},
+		{paramId: "u-2", paramEmail: "", paramFullName: "", paramAuthType: "", paramDeactivated: true},
+		{paramId: "u-3", paramEmail: "", paramFullName: "", paramAuthType: "", paramDeactivated: false},
+	}
+
+	if result := buildUsersAttribute(users); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Unexpected result: expected %v, got %v", expected, result)
+	}
+}
+
 func testAccCheckDataSourceUsers(mockServerUrl, userResourceLabel string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
@@ -105,6 +173,17 @@ func testAccCheckDataSourceUsers(mockServerUrl, userResourceLabel string) string
 	`, mockServerUrl, userResourceLabel)
 }
 
+func testAccCheckDataSourceUsersWithEmailFilter(mockServerUrl, userResourceLabel, email string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_users" "%s" {
+		email = "%s"
+	}
+	`, mockServerUrl, userResourceLabel, email)
+}
+
 func testAccCheckUsersExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,52 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const paramDeletionProtection = "deletion_protection"
+
+// deletionProtectionSchema is a provider-side only attribute: it's never sent to Confluent Cloud API,
+// so the protection applies only to the Terraform workspace that manages the resource.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether Terraform is prevented from destroying the resource (including `terraform destroy` and replacements).",
+	}
+}
+
+// checkDeletionProtection must be called at the beginning of a Delete function. It reads the value from TF state,
+// so removing the resource from the configuration or running `terraform destroy` doesn't bypass the protection.
+func checkDeletionProtection(d *schema.ResourceData, resourceName string) diag.Diagnostics {
+	if !d.Get(paramDeletionProtection).(bool) {
+		return nil
+	}
+	return diag.Errorf("error deleting %s %q: deletion protection is enabled, set %q to false and run `terraform apply` first",
+		resourceName, d.Id(), paramDeletionProtection)
+}
+
+// setDeletionProtectionDefault explicitly sets deletion_protection to the default value if unset,
+// for example, when a resource is imported or was created by an older version of the provider.
+func setDeletionProtectionDefault(d *schema.ResourceData) error {
+	if _, ok := d.GetOk(paramDeletionProtection); !ok {
+		return d.Set(paramDeletionProtection, d.Get(paramDeletionProtection))
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newDeletionProtectionTestClient returns a Client pointed at a stub server that counts the DELETE requests it receives.
+func newDeletionProtectionTestClient(t *testing.T) (*Client, *int32) {
+	var deleteCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			atomic.AddInt32(&deleteCount, 1)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	orgCfg := org.NewConfiguration()
+	orgCfg.Servers[0].URL = server.URL
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = server.URL
+	return &Client{
+		orgClient:            org.NewAPIClient(orgCfg),
+		cmkClient:            cmk.NewAPIClient(cmkCfg),
+		isAcceptanceTestMode: true,
+	}, &deleteCount
+}
+
+func TestDeletionProtection(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		id       string
+		delete   func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+	}{
+		{
+			name:     "Kafka Cluster",
+			resource: kafkaResource(),
+			id:       "lkc-abc123",
+			delete:   kafkaDelete,
+		},
+		{
+			name:     "Environment",
+			resource: environmentResource(),
+			id:       "env-abc123",
+			delete:   environmentDelete,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, deleteCount := newDeletionProtectionTestClient(t)
+
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, map[string]interface{}{
+				paramDeletionProtection: true,
+			})
+			d.SetId(tc.id)
+			diags := tc.delete(context.Background(), d, c)
+			if !diags.HasError() {
+				t.Fatalf("expected deletion of %s to be blocked, got no error", tc.name)
+			}
+			if !strings.Contains(diags[0].Summary, paramDeletionProtection) {
+				t.Fatalf("expected the error to mention %q, got %q", paramDeletionProtection, diags[0].Summary)
+			}
+			if got := atomic.LoadInt32(deleteCount); got != 0 {
+				t.Fatalf("expected no DELETE requests when deletion protection is enabled, got %d", got)
+			}
+
+			d = schema.TestResourceDataRaw(t, tc.resource.Schema, map[string]interface{}{
+				paramDeletionProtection: false,
+			})
+			d.SetId(tc.id)
+			if diags := tc.delete(context.Background(), d, c); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got := atomic.LoadInt32(deleteCount); got != 1 {
+				t.Fatalf("expected 1 DELETE request when deletion protection is disabled, got %d", got)
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionDefault(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, kafkaResource().Schema, map[string]interface{}{})
+	if err := setDeletionProtectionDefault(d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Get(paramDeletionProtection).(bool) {
+		t.Fatalf("expected %q to default to false", paramDeletionProtection)
+	}
+
+	d = schema.TestResourceDataRaw(t, kafkaResource().Schema, map[string]interface{}{
+		paramDeletionProtection: true,
+	})
+	if err := setDeletionProtectionDefault(d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !d.Get(paramDeletionProtection).(bool) {
+		t.Fatalf("expected %q to be left unchanged", paramDeletionProtection)
+	}
+}
@@ -2,7 +2,13 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	dc "github.com/confluentinc/ccloud-sdk-go-v2/data-catalog/v1"
 	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
@@ -16,9 +22,13 @@ import (
 )
 
 type FlinkRestClientFactory struct {
-	ctx        context.Context
-	userAgent  string
-	maxRetries *int
+	ctx                context.Context
+	userAgent          string
+	maxRetries         *int
+	requestTimeout     time.Duration
+	maxIdleConns       int
+	tlsConfig          *tls.Config
+	concurrencyLimiter concurrencyLimiter
 }
 
 func (f FlinkRestClientFactory) CreateFlinkRestClient(restEndpoint, organizationId, environmentId, computePoolId, principalId, flinkApiKey, flinkApiSecret string, isMetadataSetInProviderBlock bool) *FlinkRestClient {
@@ -31,6 +41,7 @@ func (f FlinkRestClientFactory) CreateFlinkRestClient(restEndpoint, organization
 
 	config.UserAgent = f.userAgent
 	config.Servers[0].URL = restEndpoint
+	opts = append(opts, WithRequestTimeout(f.requestTimeout), WithMaxIdleConns(f.maxIdleConns), WithTLSConfig(f.tlsConfig), WithConcurrencyLimiter(f.concurrencyLimiter))
 	config.HTTPClient = NewRetryableClientFactory(f.ctx, opts...).CreateRetryableClient()
 
 	return &FlinkRestClient{
@@ -47,9 +58,13 @@ func (f FlinkRestClientFactory) CreateFlinkRestClient(restEndpoint, organization
 }
 
 type SchemaRegistryRestClientFactory struct {
-	ctx        context.Context
-	userAgent  string
-	maxRetries *int
+	ctx                context.Context
+	userAgent          string
+	maxRetries         *int
+	requestTimeout     time.Duration
+	maxIdleConns       int
+	tlsConfig          *tls.Config
+	concurrencyLimiter concurrencyLimiter
 }
 
 func (f SchemaRegistryRestClientFactory) CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret string, isMetadataSetInProviderBlock bool) *SchemaRegistryRestClient {
@@ -61,6 +76,7 @@ func (f SchemaRegistryRestClientFactory) CreateSchemaRegistryRestClient(restEndp
 
 	config.UserAgent = f.userAgent
 	config.Servers[0].URL = restEndpoint
+	opts = append(opts, WithRequestTimeout(f.requestTimeout), WithMaxIdleConns(f.maxIdleConns), WithTLSConfig(f.tlsConfig), WithConcurrencyLimiter(f.concurrencyLimiter))
 	config.HTTPClient = NewRetryableClientFactory(f.ctx, opts...).CreateRetryableClient()
 
 	return &SchemaRegistryRestClient{
@@ -84,6 +100,7 @@ func (f SchemaRegistryRestClientFactory) CreateDataCatalogClient(restEndpoint, c
 
 	config.UserAgent = f.userAgent
 	config.Servers[0].URL = restEndpoint
+	opts = append(opts, WithRequestTimeout(f.requestTimeout), WithMaxIdleConns(f.maxIdleConns), WithTLSConfig(f.tlsConfig), WithConcurrencyLimiter(f.concurrencyLimiter))
 	config.HTTPClient = NewRetryableClientFactory(f.ctx, opts...).CreateRetryableClient()
 
 	return &SchemaRegistryRestClient{
@@ -97,9 +114,13 @@ func (f SchemaRegistryRestClientFactory) CreateDataCatalogClient(restEndpoint, c
 }
 
 type KafkaRestClientFactory struct {
-	ctx        context.Context
-	userAgent  string
-	maxRetries *int
+	ctx                context.Context
+	userAgent          string
+	maxRetries         *int
+	requestTimeout     time.Duration
+	maxIdleConns       int
+	tlsConfig          *tls.Config
+	concurrencyLimiter concurrencyLimiter
 }
 
 func (f KafkaRestClientFactory) CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret string, isMetadataSetInProviderBlock, isClusterIdSetInProviderBlock bool) *KafkaRestClient {
@@ -112,6 +133,7 @@ func (f KafkaRestClientFactory) CreateKafkaRestClient(restEndpoint, clusterId, c
 
 	config.UserAgent = f.userAgent
 	config.Servers[0].URL = restEndpoint
+	opts = append(opts, WithRequestTimeout(f.requestTimeout), WithMaxIdleConns(f.maxIdleConns), WithTLSConfig(f.tlsConfig), WithConcurrencyLimiter(f.concurrencyLimiter))
 	config.HTTPClient = NewRetryableClientFactory(f.ctx, opts...).CreateRetryableClient()
 
 	return &KafkaRestClient{
@@ -128,8 +150,13 @@ func (f KafkaRestClientFactory) CreateKafkaRestClient(restEndpoint, clusterId, c
 type RetryableClientFactoryOption = func(c *RetryableClientFactory)
 
 type RetryableClientFactory struct {
-	ctx        context.Context
-	maxRetries *int
+	ctx                context.Context
+	maxRetries         *int
+	oauthTokenSource   *OAuthTokenSource
+	requestTimeout     time.Duration
+	maxIdleConns       int
+	tlsConfig          *tls.Config
+	concurrencyLimiter concurrencyLimiter
 }
 
 func WithMaxRetries(maxRetries int) RetryableClientFactoryOption {
@@ -138,6 +165,42 @@ func WithMaxRetries(maxRetries int) RetryableClientFactoryOption {
 	}
 }
 
+func WithOAuthTokenSource(oauthTokenSource *OAuthTokenSource) RetryableClientFactoryOption {
+	return func(c *RetryableClientFactory) {
+		c.oauthTokenSource = oauthTokenSource
+	}
+}
+
+// WithRequestTimeout sets the timeout of a single HTTP request attempt. Zero value means no timeout.
+func WithRequestTimeout(requestTimeout time.Duration) RetryableClientFactoryOption {
+	return func(c *RetryableClientFactory) {
+		c.requestTimeout = requestTimeout
+	}
+}
+
+// WithMaxIdleConns sets the maximum number of idle (keep-alive) connections. Zero value means the default is used.
+func WithMaxIdleConns(maxIdleConns int) RetryableClientFactoryOption {
+	return func(c *RetryableClientFactory) {
+		c.maxIdleConns = maxIdleConns
+	}
+}
+
+// WithTLSConfig sets the TLS configuration (for example, custom root CAs or a client certificate for mTLS).
+// Nil value means the default TLS configuration is used.
+func WithTLSConfig(tlsConfig *tls.Config) RetryableClientFactoryOption {
+	return func(c *RetryableClientFactory) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+// WithConcurrencyLimiter limits the number of in-flight HTTP requests. The limiter should be shared by all HTTP clients
+// so that the limit applies to the provider as a whole. Nil value means the number of in-flight HTTP requests is unlimited.
+func WithConcurrencyLimiter(limiter concurrencyLimiter) RetryableClientFactoryOption {
+	return func(c *RetryableClientFactory) {
+		c.concurrencyLimiter = limiter
+	}
+}
+
 func NewRetryableClientFactory(ctx context.Context, opts ...RetryableClientFactoryOption) *RetryableClientFactory {
 	c := &RetryableClientFactory{
 		ctx: ctx,
@@ -170,9 +233,129 @@ func (f RetryableClientFactory) CreateRetryableClient() *http.Client {
 	// This logger will be used to send retryablehttp's internal logs to tflog
 	retryClient.Logger = logger
 
+	retryClient.CheckRetry = retryPolicy
+	retryClient.Backoff = retryBackoff
+
+	// The timeout is set on the underlying client (rather than on the returned one) so that it applies to every
+	// attempt separately and a timed out attempt can still be retried.
+	// Long-running operations (for example, waiting for a cluster to be provisioned) poll with short requests and
+	// are bounded by the resource's context deadline instead.
+	if f.requestTimeout > 0 {
+		retryClient.HTTPClient.Timeout = f.requestTimeout
+	}
+	if transport, ok := retryClient.HTTPClient.Transport.(*http.Transport); ok {
+		if f.maxIdleConns > 0 {
+			transport.MaxIdleConns = f.maxIdleConns
+			transport.MaxIdleConnsPerHost = f.maxIdleConns
+		}
+		if f.tlsConfig != nil {
+			transport.TLSClientConfig = f.tlsConfig.Clone()
+		}
+	}
+	// The logging transport wraps the underlying transport (rather than the returned client) so that every attempt,
+	// including the retried ones, is logged separately.
+	retryClient.HTTPClient.Transport = &loggingRoundTripper{
+		ctx:  f.ctx,
+		next: retryClient.HTTPClient.Transport,
+	}
+	// The limiting transport wraps the logging one so that the time spent waiting for a free slot isn't logged as
+	// the duration of the request, and a request doesn't hold its slot while waiting for the next retry attempt.
+	if f.concurrencyLimiter != nil {
+		retryClient.HTTPClient.Transport = &concurrencyLimitingRoundTripper{
+			limiter: f.concurrencyLimiter,
+			next:    retryClient.HTTPClient.Transport,
+		}
+	}
+	// The OAuth transport wraps the underlying transport (rather than the returned client) so that every attempt
+	// gets a token of its own, and an attempt retried after 401 status code is sent with a fresh token.
+	if f.oauthTokenSource != nil {
+		retryClient.HTTPClient.Transport = &oauthRoundTripper{
+			tokenSource: f.oauthTokenSource,
+			next:        retryClient.HTTPClient.Transport,
+		}
+	}
+
 	return retryClient.StandardClient()
 }
 
+// Idempotent HTTP methods as defined in https://www.rfc-editor.org/rfc/rfc9110.html#name-idempotent-methods
+var idempotentHttpMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+// retryPolicy extends retryablehttp.DefaultRetryPolicy to avoid retrying non-idempotent requests (for example, POST)
+// unless they were rejected with 429 status code, since retrying them might create duplicate resources.
+// Requests rejected with 401 status code are retried when they were sent with an OAuth token, since
+// oauthRoundTripper refreshes the rejected token.
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() == nil && resp != nil && resp.StatusCode == http.StatusUnauthorized && isOAuthAuthorizedRequest(resp.Request) {
+		return true, nil
+	}
+	shouldRetry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if !shouldRetry {
+		return false, checkErr
+	}
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true, checkErr
+	}
+	method := requestMethod(resp, err)
+	if method == "" || stringInSlice(method, idempotentHttpMethods, false) {
+		return true, checkErr
+	}
+	return false, checkErr
+}
+
+func requestMethod(resp *http.Response, err error) string {
+	if resp != nil && resp.Request != nil {
+		return resp.Request.Method
+	}
+	// net/http sets url.Error.Op to the request method, for example, "Post"
+	if urlErr, ok := err.(*url.Error); ok {
+		return strings.ToUpper(urlErr.Op)
+	}
+	return ""
+}
+
+// retryBackoff is similar to retryablehttp.DefaultBackoff: it honors the Retry-After header for 429 and 503 status codes
+// and falls back to an exponential backoff otherwise. Unlike retryablehttp.DefaultBackoff, it never waits longer than max.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if sleep, ok := parseRetryAfterHeader(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if sleep > max {
+				return max
+			}
+			return sleep
+		}
+	}
+
+	mult := math.Pow(2, float64(attemptNum)) * float64(min)
+	sleep := time.Duration(mult)
+	if float64(sleep) != mult || sleep > max {
+		sleep = max
+	}
+	return sleep
+}
+
+// parseRetryAfterHeader parses the value of Retry-After header that is either a number of seconds (for example, "120")
+// or an HTTP-date (for example, "Fri, 31 Dec 1999 23:59:59 GMT"). The bool returned is false if the value is not parseable.
+func parseRetryAfterHeader(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	retryTime, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if sleep := retryTime.Sub(now); sleep > 0 {
+		return sleep, true
+	}
+	return 0, true
+}
+
 // Logger is used to log messages from retryablehttp.Client to tflog.
 type retryClientLogger struct {
 	ctx context.Context
@@ -203,3 +386,101 @@ func (l retryClientLogger) additionalFields(keysAndValues []interface{}) map[str
 
 	return additionalFields
 }
+
+const (
+	requestIdHeader = "X-Request-Id"
+	redactedValue   = "[REDACTED]"
+)
+
+// Headers whose values must never be written to the logs.
+var sensitiveHttpHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// loggingRoundTripper logs every outbound request: its method, path, response status and request ID are logged at
+// DEBUG level, and the request headers (with credentials redacted) are additionally logged at TRACE level.
+// The log level is controlled by TF_LOG (or TF_LOG_PROVIDER) environment variable, for example, TF_LOG=DEBUG.
+// Logs are written to the factory's context (like retryClientLogger does) since requests are usually sent with API
+// contexts derived from context.Background() that carry no provider logger.
+type loggingRoundTripper struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *loggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.ctx
+	tflog.Trace(ctx, "Sending HTTP request", map[string]interface{}{
+		"http_method":          req.Method,
+		"http_path":            req.URL.Path,
+		"http_request_headers": redactHttpHeaders(req.Header),
+	})
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields := map[string]interface{}{
+		"http_method":      req.Method,
+		"http_path":        req.URL.Path,
+		"http_duration_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "HTTP request failed", fields)
+		return resp, err
+	}
+	fields["http_status_code"] = resp.StatusCode
+	if requestId := resp.Header.Get(requestIdHeader); requestId != "" {
+		fields["http_request_id"] = requestId
+	}
+	tflog.Debug(ctx, "Received HTTP response", fields)
+	return resp, nil
+}
+
+// concurrencyLimiter is a semaphore that limits the number of in-flight HTTP requests.
+type concurrencyLimiter chan struct{}
+
+// newConcurrencyLimiter returns nil (no limit) when maxConcurrentRequests is 0.
+func newConcurrencyLimiter(maxConcurrentRequests int) concurrencyLimiter {
+	if maxConcurrentRequests <= 0 {
+		return nil
+	}
+	return make(concurrencyLimiter, maxConcurrentRequests)
+}
+
+// acquire blocks until a slot is free or ctx is done, in which case ctx's error is returned and no slot is held.
+func (l concurrencyLimiter) acquire(ctx context.Context) error {
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l concurrencyLimiter) release() {
+	<-l
+}
+
+type concurrencyLimitingRoundTripper struct {
+	limiter concurrencyLimiter
+	next    http.RoundTripper
+}
+
+// RoundTrip holds a slot until the response headers are received or the request fails.
+func (t *concurrencyLimitingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.acquire(req.Context()); err != nil {
+		return nil, fmt.Errorf("error waiting for a free slot to send %s %s request (max_concurrent_requests = %d): %w", req.Method, req.URL.Path, cap(t.limiter), err)
+	}
+	defer t.limiter.release()
+	return t.next.RoundTrip(req)
+}
+
+// redactHttpHeaders returns a copy of the headers suitable for logging, with the values of sensitive headers replaced.
+func redactHttpHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if stringInSlice(name, sensitiveHttpHeaders, true) {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+	return redacted
+}
@@ -0,0 +1,386 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		statusCode  int
+		err         error
+		auth        string
+		shouldRetry bool
+	}{
+		{name: "GET with 429", method: http.MethodGet, statusCode: http.StatusTooManyRequests, shouldRetry: true},
+		{name: "GET with 500", method: http.MethodGet, statusCode: http.StatusInternalServerError, shouldRetry: true},
+		{name: "GET with 501", method: http.MethodGet, statusCode: http.StatusNotImplemented, shouldRetry: false},
+		{name: "GET with 404", method: http.MethodGet, statusCode: http.StatusNotFound, shouldRetry: false},
+		{name: "GET with 200", method: http.MethodGet, statusCode: http.StatusOK, shouldRetry: false},
+		{name: "DELETE with 503", method: http.MethodDelete, statusCode: http.StatusServiceUnavailable, shouldRetry: true},
+		{name: "PUT with 502", method: http.MethodPut, statusCode: http.StatusBadGateway, shouldRetry: true},
+		{name: "POST with 429", method: http.MethodPost, statusCode: http.StatusTooManyRequests, shouldRetry: true},
+		{name: "POST with 500", method: http.MethodPost, statusCode: http.StatusInternalServerError, shouldRetry: false},
+		{name: "PATCH with 503", method: http.MethodPatch, statusCode: http.StatusServiceUnavailable, shouldRetry: false},
+		{name: "GET with connection error", err: &url.Error{Op: "Get", URL: "https://api.confluent.cloud", Err: errors.New("connection reset by peer")}, shouldRetry: true},
+		{name: "POST with connection error", err: &url.Error{Op: "Post", URL: "https://api.confluent.cloud", Err: errors.New("connection reset by peer")}, shouldRetry: false},
+		{name: "GET with 401 and OAuth token", method: http.MethodGet, statusCode: http.StatusUnauthorized, auth: "Bearer token", shouldRetry: true},
+		{name: "POST with 401 and OAuth token", method: http.MethodPost, statusCode: http.StatusUnauthorized, auth: "Bearer token", shouldRetry: true},
+		{name: "GET with 401 and API Key", method: http.MethodGet, statusCode: http.StatusUnauthorized, auth: "Basic a2V5OnNlY3JldA==", shouldRetry: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{
+					StatusCode: tt.statusCode,
+					Request:    &http.Request{Method: tt.method, Header: http.Header{}},
+				}
+				if tt.auth != "" {
+					resp.Request.Header.Set("Authorization", tt.auth)
+				}
+			}
+			shouldRetry, _ := retryPolicy(context.Background(), resp, tt.err)
+			if shouldRetry != tt.shouldRetry {
+				t.Fatalf("Unexpected result: expected %t, got %t", tt.shouldRetry, shouldRetry)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Request:    &http.Request{Method: http.MethodGet},
+	}
+	shouldRetry, err := retryPolicy(ctx, resp, nil)
+	if shouldRetry {
+		t.Fatalf("Unexpected retry for a canceled context")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Unexpected error: expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	retryWaitMin := 1 * time.Second
+	retryWaitMax := 30 * time.Second
+	tests := []struct {
+		name       string
+		attemptNum int
+		statusCode int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{name: "first attempt", attemptNum: 0, statusCode: http.StatusInternalServerError, expected: 1 * time.Second},
+		{name: "second attempt", attemptNum: 1, statusCode: http.StatusInternalServerError, expected: 2 * time.Second},
+		{name: "fourth attempt", attemptNum: 3, statusCode: http.StatusInternalServerError, expected: 8 * time.Second},
+		{name: "capped at max", attemptNum: 10, statusCode: http.StatusInternalServerError, expected: retryWaitMax},
+		{name: "Retry-After for 429", attemptNum: 0, statusCode: http.StatusTooManyRequests, retryAfter: "5", expected: 5 * time.Second},
+		{name: "Retry-After for 503", attemptNum: 3, statusCode: http.StatusServiceUnavailable, retryAfter: "3", expected: 3 * time.Second},
+		{name: "Retry-After capped at max", attemptNum: 0, statusCode: http.StatusTooManyRequests, retryAfter: "3600", expected: retryWaitMax},
+		{name: "Retry-After ignored for 500", attemptNum: 1, statusCode: http.StatusInternalServerError, retryAfter: "5", expected: 2 * time.Second},
+		{name: "invalid Retry-After", attemptNum: 2, statusCode: http.StatusTooManyRequests, retryAfter: "soon", expected: 4 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tt.statusCode,
+				Header:     http.Header{},
+			}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			actual := retryBackoff(retryWaitMin, retryWaitMax, tt.attemptNum, resp)
+			if actual != tt.expected {
+				t.Fatalf("Unexpected backoff: expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfterHeader(t *testing.T) {
+	now := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value      string
+		expected   time.Duration
+		expectedOk bool
+	}{
+		{value: "", expected: 0, expectedOk: false},
+		{value: "0", expected: 0, expectedOk: true},
+		{value: "120", expected: 120 * time.Second, expectedOk: true},
+		{value: "-1", expected: 0, expectedOk: false},
+		{value: "soon", expected: 0, expectedOk: false},
+		{value: "Sun, 01 Jan 2023 12:00:30 GMT", expected: 30 * time.Second, expectedOk: true},
+		{value: "Sun, 01 Jan 2023 11:59:00 GMT", expected: 0, expectedOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			actual, ok := parseRetryAfterHeader(tt.value, now)
+			if ok != tt.expectedOk || actual != tt.expected {
+				t.Fatalf("Unexpected result: expected (%s, %t), got (%s, %t)", tt.expected, tt.expectedOk, actual, ok)
+			}
+		})
+	}
+}
+
+func TestRetryableClientRequestTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	maxRetries := 0
+	meta := &Client{
+		kafkaRestClientFactory: &KafkaRestClientFactory{
+			ctx:            context.Background(),
+			userAgent:      "test",
+			maxRetries:     &maxRetries,
+			requestTimeout: 100 * time.Millisecond,
+		},
+	}
+	d := schema.TestResourceDataRaw(t, kafkaTopicResource().Schema, map[string]interface{}{
+		paramKafkaCluster: []interface{}{map[string]interface{}{paramId: "lkc-123"}},
+		paramTopicName:    "orders",
+		paramRestEndpoint: server.URL,
+		paramCredentials:  []interface{}{map[string]interface{}{paramKey: "key", paramSecret: "secret"}},
+	})
+	d.SetId(createKafkaTopicId("lkc-123", "orders"))
+
+	start := time.Now()
+	diags := kafkaTopicRead(context.Background(), d, meta)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error diagnostic, got none")
+	}
+	if !strings.Contains(diags[0].Summary, "Client.Timeout exceeded") {
+		t.Fatalf("Expected a timeout diagnostic, got %q", diags[0].Summary)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected the request to time out quickly, it took %s", elapsed)
+	}
+}
+
+func TestConcurrencyLimiterNeverExceedsLimit(t *testing.T) {
+	const maxConcurrentRequests = 3
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	// The limit applies to all HTTP clients sharing the limiter, for example, Cloud API and Kafka REST API clients
+	limiter := newConcurrencyLimiter(maxConcurrentRequests)
+	clients := []*http.Client{
+		NewRetryableClientFactory(context.Background(), WithMaxRetries(0), WithConcurrencyLimiter(limiter)).CreateRetryableClient(),
+		NewRetryableClientFactory(context.Background(), WithMaxRetries(0), WithConcurrencyLimiter(limiter)).CreateRetryableClient(),
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 30)
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(client *http.Client) {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				errs <- err
+				return
+			}
+			_ = resp.Body.Close()
+		}(clients[i%len(clients)])
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if maxInFlight > maxConcurrentRequests {
+		t.Fatalf("Expected at most %d in-flight requests, got %d", maxConcurrentRequests, maxInFlight)
+	}
+	if maxInFlight < 2 {
+		t.Fatalf("Expected requests to be sent concurrently, got at most %d in-flight requests", maxInFlight)
+	}
+	if len(limiter) != 0 {
+		t.Fatalf("Expected all slots to be released, %d are still held", len(limiter))
+	}
+}
+
+func TestConcurrencyLimiterReleasesSlotOnCanceledContext(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	limiter := newConcurrencyLimiter(1)
+	client := NewRetryableClientFactory(context.Background(), WithMaxRetries(0), WithConcurrencyLimiter(limiter)).CreateRetryableClient()
+	get := func(ctx context.Context) error {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err := client.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	// The first request holds the only slot until it's canceled
+	inFlightCtx, cancelInFlight := context.WithCancel(context.Background())
+	inFlightErr := make(chan error, 1)
+	go func() { inFlightErr <- get(inFlightCtx) }()
+	for len(limiter) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The second request gives up waiting for a free slot once its context is done
+	waitingCtx, cancelWaiting := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelWaiting()
+	if err := get(waitingCtx); err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the waiting request to fail with %q, got %v", context.DeadlineExceeded, err)
+	}
+
+	cancelInFlight()
+	if err := <-inFlightErr; err == nil {
+		t.Fatalf("Expected the canceled request to fail, got no error")
+	}
+	if len(limiter) != 0 {
+		t.Fatalf("Expected the slot to be released by the canceled requests, %d are still held", len(limiter))
+	}
+
+	// The released slot can be acquired again
+	close(unblock)
+	if err := get(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+}
+
+func TestNewConcurrencyLimiterUnlimitedByDefault(t *testing.T) {
+	if limiter := newConcurrencyLimiter(0); limiter != nil {
+		t.Fatalf("Expected no limiter for max_concurrent_requests = 0, got one with capacity %d", cap(limiter))
+	}
+}
+
+func TestLoggingRoundTripperRecordsRequestId(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdHeader, "req-abc123")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	maxRetries := 0
+	client := NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
+	// API clients send requests with contexts that don't carry the provider logger
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/iam/v2/service-accounts/sa-123", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	req.SetBasicAuth("key", "secret")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Unable to decode log entries: %v", err)
+	}
+	var responseEntry map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "Received HTTP response" {
+			responseEntry = entry
+		}
+	}
+	if responseEntry == nil {
+		t.Fatalf("Expected a log entry for the HTTP response, got %v", entries)
+	}
+	if responseEntry["@level"] != "debug" {
+		t.Fatalf("Expected the HTTP response to be logged at debug level, got %v", responseEntry["@level"])
+	}
+	if responseEntry["http_request_id"] != "req-abc123" {
+		t.Fatalf("Expected request ID %q to be logged, got %v", "req-abc123", responseEntry["http_request_id"])
+	}
+	if responseEntry["http_method"] != http.MethodGet || responseEntry["http_path"] != "/iam/v2/service-accounts/sa-123" {
+		t.Fatalf("Unexpected method or path logged: %v %v", responseEntry["http_method"], responseEntry["http_path"])
+	}
+	if responseEntry["http_status_code"] != float64(http.StatusNotFound) {
+		t.Fatalf("Expected status code %d to be logged, got %v", http.StatusNotFound, responseEntry["http_status_code"])
+	}
+	if strings.Contains(output.String(), "a2V5OnNlY3JldA==") {
+		t.Fatalf("Expected Authorization header to be redacted, got %s", output.String())
+	}
+}
+
+func TestRedactHttpHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer token")
+	headers.Set("Content-Type", "application/json")
+	headers.Add("Accept", "application/json")
+	headers.Add("Accept", "text/plain")
+
+	redacted := redactHttpHeaders(headers)
+	if redacted["Authorization"] != redactedValue {
+		t.Fatalf("Expected Authorization header to be redacted, got %q", redacted["Authorization"])
+	}
+	if redacted["Content-Type"] != "application/json" {
+		t.Fatalf("Unexpected Content-Type header: %q", redacted["Content-Type"])
+	}
+	if redacted["Accept"] != "application/json, text/plain" {
+		t.Fatalf("Unexpected Accept header: %q", redacted["Accept"])
+	}
+	if headers.Get("Authorization") != "Bearer token" {
+		t.Fatalf("Expected the original headers to be left unchanged")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	paramOAuth                  = "oauth"
+	paramOAuthTokenEndpoint     = "token_endpoint"
+	paramOAuthClientId          = "client_id"
+	paramOAuthClientSecret      = "client_secret"
+	paramOAuthScope             = "scope"
+	paramOAuthIdentityPoolId    = "identity_pool_id"
+	oauthGrantTypeClientCreds   = "client_credentials"
+	oauthGrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	oauthTokenTypeJwt           = "urn:ietf:params:oauth:token-type:jwt"
+	oauthTokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+	confluentStsTokenPath       = "/sts/v1/oauth2/token"
+	// Refresh a token slightly before it expires to avoid sending requests with an expired token
+	oauthTokenRefreshBuffer = 1 * time.Minute
+	// The lifetime of a token whose response doesn't specify expires_in. A token that is rejected earlier
+	// is refreshed anyway since oauthRoundTripper invalidates tokens on 401 responses.
+	oauthTokenDefaultLifetime = 15 * time.Minute
+)
+
+type OAuthConfig struct {
+	tokenEndpoint  string
+	clientId       string
+	clientSecret   string
+	scope          string
+	identityPoolId string
+	// The base endpoint of Confluent Cloud API used for exchanging an external token for a Confluent Cloud token
+	stsEndpoint string
+}
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// OAuthTokenSource fetches an access token from an external identity provider using OAuth client credentials flow,
+// optionally exchanges it for a Confluent Cloud token when identity pool ID is set, and caches it until it's about to expire.
+type OAuthTokenSource struct {
+	config     OAuthConfig
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewOAuthTokenSource(config OAuthConfig, httpClient *http.Client) *OAuthTokenSource {
+	return &OAuthTokenSource{
+		config:     config,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+func (s *OAuthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Add(oauthTokenRefreshBuffer).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	externalToken, err := s.requestToken(ctx, s.config.tokenEndpoint, url.Values{
+		"grant_type":    {oauthGrantTypeClientCreds},
+		"client_id":     {s.config.clientId},
+		"client_secret": {s.config.clientSecret},
+	}, s.config.scope)
+	if err != nil {
+		return "", fmt.Errorf("error fetching OAuth token from %q: %s", s.config.tokenEndpoint, err)
+	}
+	token := externalToken
+
+	if s.config.identityPoolId != "" {
+		stsTokenEndpoint := strings.TrimSuffix(s.config.stsEndpoint, "/") + confluentStsTokenPath
+		token, err = s.requestToken(ctx, stsTokenEndpoint, url.Values{
+			"grant_type":           {oauthGrantTypeTokenExchange},
+			"subject_token":        {externalToken.AccessToken},
+			"subject_token_type":   {oauthTokenTypeJwt},
+			"requested_token_type": {oauthTokenTypeAccessToken},
+			"identity_pool_id":     {s.config.identityPoolId},
+		}, "")
+		if err != nil {
+			return "", fmt.Errorf("error exchanging OAuth token for identity pool %q: %s", s.config.identityPoolId, err)
+		}
+	}
+
+	s.token = token.AccessToken
+	s.expiresAt = s.now().Add(tokenLifetime(token))
+	return s.token, nil
+}
+
+// invalidate drops the cached token unless it has already been replaced with a newer one, so that the next call to
+// Token fetches a fresh token.
+func (s *OAuthTokenSource) invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+		s.expiresAt = time.Time{}
+	}
+}
+
+// tokenLifetime falls back to oauthTokenDefaultLifetime when expires_in is missing or zero, since it's only
+// RECOMMENDED by https://www.rfc-editor.org/rfc/rfc6749#section-5.1
+func tokenLifetime(token oauthTokenResponse) time.Duration {
+	if token.ExpiresIn <= 0 {
+		return oauthTokenDefaultLifetime
+	}
+	return time.Duration(token.ExpiresIn) * time.Second
+}
+
+func (s *OAuthTokenSource) requestToken(ctx context.Context, tokenEndpoint string, form url.Values, scope string) (oauthTokenResponse, error) {
+	if scope != "" {
+		form.Set("scope", scope)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return oauthTokenResponse{}, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	var token oauthTokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return oauthTokenResponse{}, fmt.Errorf("error unmarshaling token response: %s", err)
+	}
+	if token.AccessToken == "" {
+		return oauthTokenResponse{}, fmt.Errorf("token response is missing %q", "access_token")
+	}
+	return token, nil
+}
+
+// oauthRoundTripper sets a bearer token on requests that don't have Authorization header set already.
+// A token rejected with 401 status code is invalidated, so that a retried attempt gets a fresh one.
+type oauthRoundTripper struct {
+	tokenSource *OAuthTokenSource
+	next        http.RoundTripper
+}
+
+func (t *oauthRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		return t.next.RoundTrip(req)
+	}
+	token, err := t.tokenSource.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	// RoundTrip must not modify the original request
+	authorizedReq := req.Clone(req.Context())
+	authorizedReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	resp, err := t.next.RoundTrip(authorizedReq)
+	if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+		t.tokenSource.invalidate(token)
+	}
+	return resp, err
+}
+
+// isOAuthAuthorizedRequest returns true if the request has been sent with a bearer token set by oauthRoundTripper.
+func isOAuthAuthorizedRequest(req *http.Request) bool {
+	return req != nil && strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ")
+}
@@ -0,0 +1,270 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testOAuthClientId       = "test-client-id"
+	testOAuthClientSecret   = "test-client-secret"
+	testOAuthScope          = "api://confluent/.default"
+	testOAuthIdentityPoolId = "pool-W5Qe"
+)
+
+func newTestOAuthServer(t *testing.T, tokenRequests, stsRequests *int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("error parsing form: %s", err)
+		}
+		if r.Method != http.MethodPost || r.PostForm.Get("grant_type") != oauthGrantTypeClientCreds ||
+			r.PostForm.Get("client_id") != testOAuthClientId || r.PostForm.Get("client_secret") != testOAuthClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"error":"invalid_client"}`)
+			return
+		}
+		if r.PostForm.Get("scope") != testOAuthScope {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"error":"invalid_scope"}`)
+			return
+		}
+		n := atomic.AddInt32(tokenRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"external-token-%d","token_type":"Bearer","expires_in":3600}`, n)
+	})
+	mux.HandleFunc(confluentStsTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("error parsing form: %s", err)
+		}
+		if r.PostForm.Get("grant_type") != oauthGrantTypeTokenExchange || r.PostForm.Get("identity_pool_id") != testOAuthIdentityPoolId ||
+			r.PostForm.Get("subject_token") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"error":"invalid_request"}`)
+			return
+		}
+		n := atomic.AddInt32(stsRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"confluent-token-%d-for-%s","token_type":"Bearer","expires_in":900}`, n, r.PostForm.Get("subject_token"))
+	})
+	mux.HandleFunc("/iam/v2/service-accounts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, r.Header.Get("Authorization"))
+	})
+	// Rejects the first token, for example, because it has been revoked before it expired
+	mux.HandleFunc("/iam/v2/environments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer external-token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprint(w, r.Header.Get("Authorization"))
+	})
+	return httptest.NewServer(mux)
+}
+
+func newTestOAuthTokenSource(serverUrl, identityPoolId string) *OAuthTokenSource {
+	return NewOAuthTokenSource(OAuthConfig{
+		tokenEndpoint:  serverUrl + "/oauth2/token",
+		clientId:       testOAuthClientId,
+		clientSecret:   testOAuthClientSecret,
+		scope:          testOAuthScope,
+		identityPoolId: identityPoolId,
+		stsEndpoint:    serverUrl,
+	}, http.DefaultClient)
+}
+
+func TestOAuthTokenSourceCachesAndRefreshesToken(t *testing.T) {
+	var tokenRequests, stsRequests int32
+	server := newTestOAuthServer(t, &tokenRequests, &stsRequests)
+	defer server.Close()
+
+	tokenSource := newTestOAuthTokenSource(server.URL, "")
+	now := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tokenSource.now = func() time.Time { return now }
+
+	token, err := tokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "external-token-1" {
+		t.Fatalf("Unexpected token: expected %q, got %q", "external-token-1", token)
+	}
+
+	// The token is cached until it's about to expire
+	now = now.Add(50 * time.Minute)
+	token, err = tokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "external-token-1" || atomic.LoadInt32(&tokenRequests) != 1 {
+		t.Fatalf("Unexpected token refresh: got %q after %d requests", token, atomic.LoadInt32(&tokenRequests))
+	}
+
+	// The token is refreshed when it's about to expire
+	now = now.Add(9*time.Minute + 30*time.Second)
+	token, err = tokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "external-token-2" || atomic.LoadInt32(&tokenRequests) != 2 {
+		t.Fatalf("Expected token to be refreshed: got %q after %d requests", token, atomic.LoadInt32(&tokenRequests))
+	}
+	if atomic.LoadInt32(&stsRequests) != 0 {
+		t.Fatalf("Unexpected token exchange requests: %d", atomic.LoadInt32(&stsRequests))
+	}
+}
+
+func TestOAuthTokenSourceWithoutExpiresIn(t *testing.T) {
+	var tokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&tokenRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"external-token-%d","token_type":"Bearer"}`, n)
+	}))
+	defer server.Close()
+
+	tokenSource := newTestOAuthTokenSource(server.URL, "")
+	now := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tokenSource.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		token, err := tokenSource.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if token != "external-token-1" {
+			t.Fatalf("Expected token without expires_in to be cached: got %q after %d requests", token, atomic.LoadInt32(&tokenRequests))
+		}
+	}
+
+	// The token is refreshed when it's about to reach the default lifetime
+	now = now.Add(oauthTokenDefaultLifetime - oauthTokenRefreshBuffer)
+	token, err := tokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "external-token-2" {
+		t.Fatalf("Expected token to be refreshed: got %q", token)
+	}
+}
+
+func TestOAuthTokenSourceExchangesTokenForIdentityPool(t *testing.T) {
+	var tokenRequests, stsRequests int32
+	server := newTestOAuthServer(t, &tokenRequests, &stsRequests)
+	defer server.Close()
+
+	tokenSource := newTestOAuthTokenSource(server.URL, testOAuthIdentityPoolId)
+	now := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	tokenSource.now = func() time.Time { return now }
+
+	token, err := tokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "confluent-token-1-for-external-token-1" {
+		t.Fatalf("Unexpected token: got %q", token)
+	}
+
+	// Confluent Cloud token expires earlier than the external token
+	now = now.Add(14 * time.Minute)
+	token, err = tokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if token != "confluent-token-2-for-external-token-2" || atomic.LoadInt32(&tokenRequests) != 2 || atomic.LoadInt32(&stsRequests) != 2 {
+		t.Fatalf("Expected token to be refreshed: got %q after %d and %d requests", token, atomic.LoadInt32(&tokenRequests), atomic.LoadInt32(&stsRequests))
+	}
+}
+
+func TestOAuthTokenSourceInvalidCredentials(t *testing.T) {
+	var tokenRequests, stsRequests int32
+	server := newTestOAuthServer(t, &tokenRequests, &stsRequests)
+	defer server.Close()
+
+	tokenSource := newTestOAuthTokenSource(server.URL, "")
+	tokenSource.config.clientSecret = "invalid"
+
+	if _, err := tokenSource.Token(context.Background()); err == nil {
+		t.Fatalf("Expected an error for invalid client credentials")
+	}
+}
+
+func TestOAuthRoundTripper(t *testing.T) {
+	var tokenRequests, stsRequests int32
+	server := newTestOAuthServer(t, &tokenRequests, &stsRequests)
+	defer server.Close()
+
+	client := NewRetryableClientFactory(context.Background(), WithOAuthTokenSource(newTestOAuthTokenSource(server.URL, ""))).CreateRetryableClient()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/iam/v2/service-accounts", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	var body [64]byte
+	n, _ := resp.Body.Read(body[:])
+	if got := string(body[:n]); got != "Bearer external-token-1" {
+		t.Fatalf("Unexpected Authorization header: got %q", got)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Fatalf("Original request must not be modified")
+	}
+
+	// Authorization header set explicitly (for example, Cloud API Key) takes precedence
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/iam/v2/service-accounts", nil)
+	req.SetBasicAuth("key", "secret")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	n, _ = resp.Body.Read(body[:])
+	if got := string(body[:n]); got != req.Header.Get("Authorization") {
+		t.Fatalf("Unexpected Authorization header: got %q", got)
+	}
+	if atomic.LoadInt32(&tokenRequests) != 1 {
+		t.Fatalf("Unexpected number of token requests: %d", atomic.LoadInt32(&tokenRequests))
+	}
+}
+
+func TestOAuthRoundTripperRefreshesRejectedToken(t *testing.T) {
+	var tokenRequests, stsRequests int32
+	server := newTestOAuthServer(t, &tokenRequests, &stsRequests)
+	defer server.Close()
+
+	client := NewRetryableClientFactory(context.Background(), WithOAuthTokenSource(newTestOAuthTokenSource(server.URL, ""))).CreateRetryableClient()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/iam/v2/environments", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	var body [64]byte
+	n, _ := resp.Body.Read(body[:])
+	if resp.StatusCode != http.StatusOK || string(body[:n]) != "Bearer external-token-2" {
+		t.Fatalf("Expected the request to be retried with a fresh token: got %d %q", resp.StatusCode, string(body[:n]))
+	}
+	if atomic.LoadInt32(&tokenRequests) != 2 {
+		t.Fatalf("Unexpected number of token requests: %d", atomic.LoadInt32(&tokenRequests))
+	}
+}
@@ -89,13 +89,16 @@ type Client struct {
 	quotasClient                    *quotas.APIClient
 	srcmClient                      *srcm.APIClient
 	ssoClient                       *sso.APIClient
+	cloudApiRequestConfig           apiRequestConfig
 	userAgent                       string
 	cloudApiKey                     string
 	cloudApiSecret                  string
+	isOAuthEnabled                  bool
 	kafkaClusterId                  string
 	kafkaApiKey                     string
 	kafkaApiSecret                  string
 	kafkaRestEndpoint               string
+	environmentId                   string
 	isKafkaClusterIdSet             bool
 	isKafkaMetadataSet              bool
 	schemaRegistryClusterId         string
@@ -112,6 +115,8 @@ type Client struct {
 	flinkRestEndpoint               string
 	isFlinkMetadataSet              bool
 	isAcceptanceTestMode            bool
+	responseCache                   *responseCache
+	validateReferences              bool
 }
 
 // Customize configs for terraform-plugin-docs
@@ -213,7 +218,7 @@ func New(version, userAgent string) func() *schema.Provider {
 					Type:        schema.TypeString,
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("CONFLUENT_ENVIRONMENT_ID", ""),
-					Description: "The Flink Environment ID.",
+					Description: "The Environment ID used by Flink resources and as the default `environment` of resources that omit the `environment` block.",
 				},
 				"flink_compute_pool_id": {
 					Type:        schema.TypeString,
@@ -255,10 +260,108 @@ func New(version, userAgent string) func() *schema.Provider {
 					ValidateFunc: validation.IntAtLeast(4),
 					Description:  "Maximum number of retries of HTTP client. Defaults to 4.",
 				},
+				paramOAuth: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "OAuth client credentials used to authenticate with Confluent Cloud API instead of Cloud API Key.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							paramOAuthTokenEndpoint: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The token endpoint of the external identity provider.",
+								ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							},
+							paramOAuthClientId: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The client ID of the OAuth application.",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							paramOAuthClientSecret: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								Description:  "The client secret of the OAuth application.",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							paramOAuthScope: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The scope to request from the external identity provider.",
+							},
+							paramOAuthIdentityPoolId: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The ID of the Identity Pool to exchange the external token for a Confluent Cloud token.",
+							},
+						},
+					},
+				},
+				"enable_retries": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("TF_PROVIDER_CONFLUENT_ENABLE_RETRIES", true),
+					Description: "Whether HTTP client retries requests that failed with 429 or 5xx status codes. Defaults to `true`.",
+				},
+				"request_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("TF_PROVIDER_CONFLUENT_REQUEST_TIMEOUT", 0),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Timeout in seconds of a single HTTP request attempt. Defaults to 0 (no timeout).",
+				},
+				"disable_response_cache": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("TF_PROVIDER_CONFLUENT_DISABLE_RESPONSE_CACHE", false),
+					Description: "Whether to disable caching of Environment and Kafka Cluster lookups for the duration of a single plan or apply. Defaults to `false`.",
+				},
+				paramValidateReferences: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("TF_PROVIDER_CONFLUENT_VALIDATE_REFERENCES", false),
+					Description: "Whether to verify during `terraform plan` that the referenced Environments and Gateways exist. Defaults to `false`.",
+				},
+				"max_idle_conns": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Maximum number of idle (keep-alive) connections of HTTP client per host. Defaults to 0 (HTTP client defaults are used).",
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("TF_PROVIDER_CONFLUENT_MAX_CONCURRENT_REQUESTS", 0),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Maximum number of in-flight HTTP requests across all HTTP clients of the provider. Defaults to 0 (unlimited).",
+				},
+				paramCaBundle: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("TF_PROVIDER_CONFLUENT_CA_BUNDLE", ""),
+					Description: "The path to a PEM file (or inline PEM) with CA certificates to trust in addition to the system root CAs.",
+				},
+				paramClientCert: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{paramClientKey},
+					Description:  "The path to a PEM file (or inline PEM) with the client certificate to use for mTLS.",
+				},
+				paramClientKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					RequiredWith: []string{paramClientCert},
+					Description:  "The path to a PEM file (or inline PEM) with the private key of the client certificate to use for mTLS.",
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"confluent_kafka_cluster":                      kafkaDataSource(),
+				"confluent_kafka_acl":                          kafkaAclDataSource(),
 				"confluent_kafka_topic":                        kafkaTopicDataSource(),
+				"confluent_kafka_topics":                       kafkaTopicsDataSource(),
 				"confluent_environment":                        environmentDataSource(),
 				"confluent_environments":                       environmentsDataSource(),
 				"confluent_group_mapping":                      groupMappingDataSource(),
@@ -266,6 +369,7 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_flink_compute_pool":                 computePoolDataSource(),
 				"confluent_flink_region":                       flinkRegionDataSource(),
 				"confluent_identity_pool":                      identityPoolDataSource(),
+				"confluent_ip_group":                           ipGroupDataSource(),
 				"confluent_identity_provider":                  identityProviderDataSource(),
 				"confluent_ip_addresses":                       ipAddressesDataSource(),
 				"confluent_kafka_client_quota":                 kafkaClientQuotaDataSource(),
@@ -280,8 +384,10 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_private_link_attachment":            privateLinkAttachmentDataSource(),
 				"confluent_private_link_attachment_connection": privateLinkAttachmentConnectionDataSource(),
 				"confluent_role_binding":                       roleBindingDataSource(),
+				"confluent_role_bindings":                      roleBindingsDataSource(),
 				"confluent_schema":                             schemaDataSource(),
 				"confluent_schemas":                            schemasDataSource(),
+				"confluent_subjects":                           subjectsDataSource(),
 				"confluent_users":                              usersDataSource(),
 				"confluent_service_account":                    serviceAccountDataSource(),
 				"confluent_schema_registry_cluster":            schemaRegistryClusterDataSource(),
@@ -311,10 +417,18 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_environment":                        environmentResource(),
 				"confluent_identity_pool":                      identityPoolResource(),
 				"confluent_identity_provider":                  identityProviderResource(),
+				"confluent_ip_filter":                          ipFilterResource(),
+				"confluent_ip_group":                           ipGroupResource(),
+				"confluent_certificate_authority":              certificateAuthorityResource(),
+				"confluent_certificate_pool":                   certificatePoolResource(),
+				"confluent_provider_integration":               providerIntegrationResource(),
+				"confluent_tableflow_topic":                    tableflowTopicResource(),
+				"confluent_tableflow_catalog_integration":      catalogIntegrationResource(),
 				"confluent_group_mapping":                      groupMappingResource(),
 				"confluent_kafka_client_quota":                 kafkaClientQuotaResource(),
 				"confluent_ksql_cluster":                       ksqlResource(),
 				"confluent_flink_compute_pool":                 computePoolResource(),
+				"confluent_flink_artifact":                     flinkArtifactResource(),
 				"confluent_flink_statement":                    flinkStatementResource(),
 				"confluent_connector":                          connectorResource(),
 				"confluent_custom_connector_plugin":            customConnectorPluginResource(),
@@ -322,6 +436,7 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_kafka_topic":                        kafkaTopicResource(),
 				"confluent_kafka_mirror_topic":                 kafkaMirrorTopicResource(),
 				"confluent_kafka_acl":                          kafkaAclResource(),
+				"confluent_kafka_acls":                         kafkaAclsResource(),
 				"confluent_network":                            networkResource(),
 				"confluent_access_point":                       accessPointResource(),
 				"confluent_dns_forwarder":                      dnsForwarderResource(),
@@ -375,14 +490,44 @@ func environmentSchema() *schema.Schema {
 				},
 			},
 		},
-		Required:    true,
-		MinItems:    1,
+		// Defaults to provider.environment_id when omitted, see extractEnvironmentId()
+		Optional:    true,
+		Computed:    true,
 		MaxItems:    1,
 		ForceNew:    true,
 		Description: "Environment objects represent an isolated namespace for your Confluent resources for organizational purposes.",
 	}
 }
 
+// extractEnvironmentId returns environment.id from the resource block, falling back to provider.environment_id when
+// the block is omitted. The resolved ID is written back to the environment block, so that read, update and delete
+// operations can keep reading it from the TF state.
+func extractEnvironmentId(client *Client, d *schema.ResourceData) (string, error) {
+	if environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId); environmentId != "" {
+		return environmentId, nil
+	}
+	if client.environmentId != "" {
+		if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, client.environmentId, d); err != nil {
+			return "", err
+		}
+		return client.environmentId, nil
+	}
+	return "", fmt.Errorf("one of provider.environment_id (defaults to CONFLUENT_ENVIRONMENT_ID environment variable) or resource.environment.id must be set")
+}
+
+// extractEnvironmentIdFromDiff is the plan-time counterpart of extractEnvironmentId. It returns false when the
+// Environment ID is known only after apply, or when neither resource.environment.id nor provider.environment_id is set.
+func extractEnvironmentIdFromDiff(client *Client, diff *schema.ResourceDiff) (string, bool) {
+	environmentIdKey := fmt.Sprintf("%s.0.%s", paramEnvironment, paramId)
+	if !diff.NewValueKnown(environmentIdKey) {
+		return "", false
+	}
+	if environmentId := diff.Get(environmentIdKey).(string); environmentId != "" {
+		return environmentId, true
+	}
+	return client.environmentId, client.environmentId != ""
+}
+
 // https://github.com/hashicorp/terraform-plugin-sdk/issues/155#issuecomment-489699737
 // //  alternative - https://github.com/hashicorp/terraform-plugin-sdk/issues/248#issuecomment-725013327
 func environmentDataSourceSchema() *schema.Schema {
@@ -422,6 +567,36 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	flinkApiSecret := d.Get("flink_api_secret").(string)
 	flinkRestEndpoint := d.Get("flink_rest_endpoint").(string)
 	maxRetries := d.Get("max_retries").(int)
+	if !d.Get("enable_retries").(bool) {
+		maxRetries = 0
+	}
+	requestTimeout := time.Duration(d.Get("request_timeout").(int)) * time.Second
+	maxIdleConns := d.Get("max_idle_conns").(int)
+	limiter := newConcurrencyLimiter(d.Get("max_concurrent_requests").(int))
+	var cache *responseCache
+	if !d.Get("disable_response_cache").(bool) {
+		cache = newResponseCache()
+	}
+	tlsConfig, err := buildTLSConfig(d.Get(paramCaBundle).(string), d.Get(paramClientCert).(string), d.Get(paramClientKey).(string))
+	if err != nil {
+		return nil, diag.Errorf("error configuring TLS: %s", err)
+	}
+
+	var oauthTokenSource *OAuthTokenSource
+	if oauthBlocks := d.Get(paramOAuth).([]interface{}); len(oauthBlocks) > 0 && oauthBlocks[0] != nil {
+		if cloudApiKey != "" || cloudApiSecret != "" {
+			return nil, diag.Errorf("cloud_api_key and cloud_api_secret attributes should not be set in the provider block when %q block is set", paramOAuth)
+		}
+		oauthBlock := oauthBlocks[0].(map[string]interface{})
+		oauthTokenSource = NewOAuthTokenSource(OAuthConfig{
+			tokenEndpoint:  oauthBlock[paramOAuthTokenEndpoint].(string),
+			clientId:       oauthBlock[paramOAuthClientId].(string),
+			clientSecret:   oauthBlock[paramOAuthClientSecret].(string),
+			scope:          oauthBlock[paramOAuthScope].(string),
+			identityPoolId: oauthBlock[paramOAuthIdentityPoolId].(string),
+			stsEndpoint:    endpoint,
+		}, NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries), WithRequestTimeout(requestTimeout), WithMaxIdleConns(maxIdleConns), WithTLSConfig(tlsConfig), WithConcurrencyLimiter(limiter)).CreateRetryableClient())
+	}
 
 	// 3 or 4 attributes should be set or not set at the same time
 	// Option #2: (kafka_api_key, kafka_api_secret, kafka_rest_endpoint)
@@ -441,9 +616,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		return nil, diag.Errorf("All 4 schema_registry_api_key, schema_registry_api_secret, schema_registry_rest_endpoint, schema_registry_id attributes should be set or not set in the provider block at the same time")
 	}
 
-	// All 7 attributes should be set or not set at the same time
+	// All 7 attributes should be set or not set at the same time.
+	// environment_id can also be set on its own since it's the default Environment of environment-scoped resources.
 	allFlinkAttributesAreSet := (flinkApiKey != "") && (flinkApiSecret != "") && (flinkRestEndpoint != "") && (flinkOrganizationId != "") && (flinkEnvironmentId != "") && (flinkComputePoolId != "") && (flinkPrincipalId != "")
-	allFlinkAttributesAreNotSet := (flinkApiKey == "") && (flinkApiSecret == "") && (flinkRestEndpoint == "") && (flinkOrganizationId == "") && (flinkEnvironmentId == "") && (flinkComputePoolId == "") && (flinkPrincipalId == "")
+	allFlinkAttributesAreNotSet := (flinkApiKey == "") && (flinkApiSecret == "") && (flinkRestEndpoint == "") && (flinkOrganizationId == "") && (flinkComputePoolId == "") && (flinkPrincipalId == "")
 	justSubsetOfFlinkAttributesAreSet := !(allFlinkAttributesAreSet || allFlinkAttributesAreNotSet)
 	if justSubsetOfFlinkAttributesAreSet {
 		return nil, diag.Errorf("All 7 flink_api_key, flink_api_secret, flink_rest_endpoint, organization_id, environment_id, flink_compute_pool_id, flink_principal_id attributes should be set or not set in the provider block at the same time")
@@ -527,29 +703,43 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	var kafkaRestClientFactory *KafkaRestClientFactory
 	var schemaRegistryRestClientFactory *SchemaRegistryRestClientFactory
 
-	flinkRestClientFactory = &FlinkRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
-	kafkaRestClientFactory = &KafkaRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
-	schemaRegistryRestClientFactory = &SchemaRegistryRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
-
-	apiKeysCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	byokCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	ccpCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	cmkCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	connectCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	fcpmCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	iamCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	iamV1Cfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	mdsCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	netCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	netIpCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	netPLCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	netDnsCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	oidcCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	orgCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	srcmCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	ksqlCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	quotasCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
-	ssoCfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
+	flinkRestClientFactory = &FlinkRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, requestTimeout: requestTimeout, maxIdleConns: maxIdleConns, tlsConfig: tlsConfig, concurrencyLimiter: limiter}
+	kafkaRestClientFactory = &KafkaRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, requestTimeout: requestTimeout, maxIdleConns: maxIdleConns, tlsConfig: tlsConfig, concurrencyLimiter: limiter}
+	schemaRegistryRestClientFactory = &SchemaRegistryRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, requestTimeout: requestTimeout, maxIdleConns: maxIdleConns, tlsConfig: tlsConfig, concurrencyLimiter: limiter}
+
+	// Cloud API clients use OAuth tokens when "oauth" block is set and Cloud API Key otherwise
+	cloudApiClientFactoryOptions := []RetryableClientFactoryOption{WithMaxRetries(maxRetries), WithRequestTimeout(requestTimeout), WithMaxIdleConns(maxIdleConns), WithTLSConfig(tlsConfig), WithConcurrencyLimiter(limiter)}
+	if oauthTokenSource != nil {
+		cloudApiClientFactoryOptions = append(cloudApiClientFactoryOptions, WithOAuthTokenSource(oauthTokenSource))
+	}
+
+	apiKeysCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	byokCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	ccpCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	cmkCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	connectCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	fcpmCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	iamCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	iamV1Cfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	mdsCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	netAccessPointCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	netCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	netIpCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	netPLCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	netDnsCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	oidcCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	orgCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	srcmCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	ksqlCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	quotasCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+	ssoCfg.HTTPClient = NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient()
+
+	// Used for Cloud APIs that aren't supported by ccloud-sdk-go-v2 yet
+	cloudApiRequestCfg := apiRequestConfig{
+		serverUrl:  endpoint,
+		userAgent:  userAgent,
+		httpClient: NewRetryableClientFactory(ctx, cloudApiClientFactoryOptions...).CreateRetryableClient(),
+	}
 
 	client := Client{
 		apiKeysClient:                   apikeys.NewAPIClient(apiKeysCfg),
@@ -575,13 +765,16 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		mdsClient:                       mds.NewAPIClient(mdsCfg),
 		quotasClient:                    quotas.NewAPIClient(quotasCfg),
 		ssoClient:                       sso.NewAPIClient(ssoCfg),
+		cloudApiRequestConfig:           cloudApiRequestCfg,
 		userAgent:                       userAgent,
 		cloudApiKey:                     cloudApiKey,
 		cloudApiSecret:                  cloudApiSecret,
+		isOAuthEnabled:                  oauthTokenSource != nil,
 		kafkaClusterId:                  kafkaClusterId,
 		kafkaApiKey:                     kafkaApiKey,
 		kafkaApiSecret:                  kafkaApiSecret,
 		kafkaRestEndpoint:               kafkaRestEndpoint,
+		environmentId:                   flinkEnvironmentId,
 		schemaRegistryClusterId:         schemaRegistryClusterId,
 		schemaRegistryApiKey:            schemaRegistryApiKey,
 		schemaRegistryApiSecret:         schemaRegistryApiSecret,
@@ -599,6 +792,8 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		isSchemaRegistryMetadataSet: allSchemaRegistryAttributesAreSet,
 		isFlinkMetadataSet:          allFlinkAttributesAreSet,
 		isAcceptanceTestMode:        acceptanceTestMode,
+		responseCache:               cache,
+		validateReferences:          d.Get(paramValidateReferences).(bool),
 	}
 
 	return &client, nil
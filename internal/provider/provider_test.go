@@ -15,7 +15,10 @@
 package provider
 
 import (
+	"context"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,6 +55,121 @@ func TestProvider_InternalValidate(t *testing.T) {
 	}
 }
 
+func TestProviderConfigure(t *testing.T) {
+	p := New(testVersion, "")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{})
+
+	meta, diags := providerConfigure(context.Background(), d, p, testVersion, "")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	c := meta.(*Client)
+	subClients := map[string]interface{}{
+		"apiKeysClient":                   c.apiKeysClient,
+		"byokClient":                      c.byokClient,
+		"ccpClient":                       c.ccpClient,
+		"cmkClient":                       c.cmkClient,
+		"connectClient":                   c.connectClient,
+		"fcpmClient":                      c.fcpmClient,
+		"iamClient":                       c.iamClient,
+		"iamV1Client":                     c.iamV1Client,
+		"netClient":                       c.netClient,
+		"netAccessPointClient":            c.netAccessPointClient,
+		"netIpClient":                     c.netIpClient,
+		"netPLClient":                     c.netPLClient,
+		"netDnsClient":                    c.netDnsClient,
+		"oidcClient":                      c.oidcClient,
+		"orgClient":                       c.orgClient,
+		"srcmClient":                      c.srcmClient,
+		"ksqlClient":                      c.ksqlClient,
+		"mdsClient":                       c.mdsClient,
+		"quotasClient":                    c.quotasClient,
+		"ssoClient":                       c.ssoClient,
+		"flinkRestClientFactory":          c.flinkRestClientFactory,
+		"kafkaRestClientFactory":          c.kafkaRestClientFactory,
+		"schemaRegistryRestClientFactory": c.schemaRegistryRestClientFactory,
+	}
+	for name, subClient := range subClients {
+		if reflect.ValueOf(subClient).IsNil() {
+			t.Errorf("expected %s to be set after configure", name)
+		}
+	}
+}
+
+func TestProviderConfigureFailure(t *testing.T) {
+	p := New(testVersion, "")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		paramCaBundle: "/nonexistent/ca.pem",
+	})
+
+	meta, diags := providerConfigure(context.Background(), d, p, testVersion, "")
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing CA bundle")
+	}
+	if meta != nil {
+		t.Errorf("expected no client to be returned on error, got %#v", meta)
+	}
+	if summary := diags[0].Summary; !strings.HasPrefix(summary, "error configuring TLS:") || !strings.Contains(summary, "/nonexistent/ca.pem") {
+		t.Errorf("expected error to name the TLS configuration and the CA bundle path, got %q", summary)
+	}
+}
+
+func TestProviderConfigureEnvironmentIdOnly(t *testing.T) {
+	p := New(testVersion, "")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"environment_id": "env-abc123",
+	})
+
+	meta, diags := providerConfigure(context.Background(), d, p, testVersion, "")
+	if diags.HasError() {
+		t.Fatalf("expected environment_id to be accepted without the other Flink attributes, got: %v", diags)
+	}
+	c := meta.(*Client)
+	if c.environmentId != "env-abc123" {
+		t.Errorf("expected default environment %q, got %q", "env-abc123", c.environmentId)
+	}
+	if c.isFlinkMetadataSet {
+		t.Error("expected Flink metadata not to be set")
+	}
+}
+
+func TestExtractEnvironmentId(t *testing.T) {
+	tests := []struct {
+		name                  string
+		resourceEnvironmentId string
+		providerEnvironmentId string
+		expected              string
+		expectError           bool
+	}{
+		{"resource block only", "env-resource", "", "env-resource", false},
+		{"resource block takes precedence", "env-resource", "env-provider", "env-resource", false},
+		{"provider default", "", "env-provider", "env-provider", false},
+		{"neither is set", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+			if tt.resourceEnvironmentId != "" {
+				raw[paramEnvironment] = []interface{}{map[string]interface{}{paramId: tt.resourceEnvironmentId}}
+			}
+			d := schema.TestResourceDataRaw(t, networkResource().Schema, raw)
+
+			environmentId, err := extractEnvironmentId(&Client{environmentId: tt.providerEnvironmentId}, d)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %t, got: %v", tt.expectError, err)
+			}
+			if environmentId != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, environmentId)
+			}
+			// The resolved ID is stored in the environment block for read, update and delete operations
+			if got := extractStringValueFromBlock(d, paramEnvironment, paramId); got != tt.expected {
+				t.Fatalf("expected %q in the environment block, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	ccApiKey := getEnv("CONFLUENT_CLOUD_API_KEY", "")
 	ccApiSecret := getEnv("CONFLUENT_CLOUD_API_SECRET", "")
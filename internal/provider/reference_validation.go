@@ -0,0 +1,93 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const paramValidateReferences = "validate_references"
+
+// validateReferencedEnvironmentCustomizeDiff verifies at plan time that the Environment referenced by environment.0.id
+// exists, so that a typo is reported during `terraform plan` instead of surfacing as a 404 during `terraform apply`.
+// Like validateReferencedGatewayCustomizeDiff, it's a no-op unless the provider's validate_references attribute is set.
+func validateReferencedEnvironmentCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c := meta.(*Client)
+	environmentIdKey := fmt.Sprintf("%s.0.%s", paramEnvironment, paramId)
+	if !c.validateReferences || !shouldValidateReference(diff, environmentIdKey) {
+		return nil
+	}
+	return validateReferencedEnvironment(ctx, c, diff.Get(environmentIdKey).(string))
+}
+
+// validateReferencedGatewayCustomizeDiff verifies at plan time that the Gateway referenced by gateway.0.id
+// exists in the Environment referenced by environment.0.id, or in provider.environment_id when the block is omitted.
+func validateReferencedGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c := meta.(*Client)
+	gatewayIdKey := fmt.Sprintf("%s.0.%s", paramGateway, paramId)
+	if !c.validateReferences || !shouldValidateReference(diff, gatewayIdKey) {
+		return nil
+	}
+	environmentId, ok := extractEnvironmentIdFromDiff(c, diff)
+	if !ok {
+		return nil
+	}
+	return validateReferencedGateway(ctx, c, environmentId, diff.Get(gatewayIdKey).(string))
+}
+
+// shouldValidateReference returns true when the reference is new or changed, and its value is known at plan time.
+// The value is unknown when the referenced object is created in the same plan, there's nothing to check then.
+func shouldValidateReference(diff *schema.ResourceDiff, key string) bool {
+	if !diff.NewValueKnown(key) {
+		return false
+	}
+	if diff.Get(key).(string) == "" {
+		return false
+	}
+	return diff.Id() == "" || diff.HasChange(key)
+}
+
+func validateReferencedEnvironment(ctx context.Context, c *Client, environmentId string) error {
+	if _, ok := c.responseCache.get(environmentCacheKey(environmentId)); ok {
+		return nil
+	}
+	environment, resp, err := executeEnvironmentRead(ctx, c, environmentId)
+	if isNonKafkaRestApiResourceNotFound(resp) {
+		return fmt.Errorf("error validating references: Environment %q referenced by %q was not found", environmentId, fmt.Sprintf("%s.0.%s", paramEnvironment, paramId))
+	}
+	if err != nil {
+		// Don't block the plan on errors that don't indicate a missing Environment; they will resurface during apply
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of Environment %q: %s", environmentId, createDescriptiveError(err)), map[string]interface{}{environmentLoggingKey: environmentId})
+		return nil
+	}
+	c.responseCache.set(environmentCacheKey(environmentId), environment)
+	return nil
+}
+
+func validateReferencedGateway(ctx context.Context, c *Client, environmentId, gatewayId string) error {
+	request := c.netClient.GatewaysNetworkingV1Api.GetNetworkingV1Gateway(c.netApiContext(ctx), gatewayId).Environment(environmentId)
+	_, resp, err := c.netClient.GatewaysNetworkingV1Api.GetNetworkingV1GatewayExecute(request)
+	if isNonKafkaRestApiResourceNotFound(resp) {
+		return fmt.Errorf("error validating references: Gateway %q referenced by %q was not found in Environment %q", gatewayId, fmt.Sprintf("%s.0.%s", paramGateway, paramId), environmentId)
+	}
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of Gateway %q: %s", gatewayId, createDescriptiveError(err)), map[string]interface{}{gatewayKey: gatewayId})
+	}
+	return nil
+}
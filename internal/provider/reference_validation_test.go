@@ -0,0 +1,172 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	referenceValidationTestEnvironmentId = "env-abc123"
+	referenceValidationTestGatewayId     = "gw-abc123"
+	// The value Terraform uses for attributes that are known only after apply
+	referenceValidationTestUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+)
+
+// newReferenceValidationTestClient returns a Client pointed at a stub server that knows about a single Environment
+// and a single Gateway and responds with 404 for anything else.
+func newReferenceValidationTestClient(t *testing.T, validateReferences bool) (*Client, *int32) {
+	var requestCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case fmt.Sprintf("/org/v2/environments/%s", referenceValidationTestEnvironmentId):
+			_, _ = fmt.Fprintf(w, `{"id": %q, "display_name": "prod"}`, referenceValidationTestEnvironmentId)
+		case fmt.Sprintf("/networking/v1/gateways/%s", referenceValidationTestGatewayId):
+			if r.URL.Query().Get("environment") != referenceValidationTestEnvironmentId {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"id": %q}`, referenceValidationTestGatewayId)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"errors": [{"status": "404", "detail": "Not Found"}]}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	orgCfg := org.NewConfiguration()
+	orgCfg.Servers[0].URL = server.URL
+	netCfg := net.NewConfiguration()
+	netCfg.Servers[0].URL = server.URL
+	return &Client{
+		orgClient:            org.NewAPIClient(orgCfg),
+		netClient:            net.NewAPIClient(netCfg),
+		isAcceptanceTestMode: true,
+		validateReferences:   validateReferences,
+	}, &requestCount
+}
+
+func testAccessPointConfigWithReferences(environmentId, gatewayId string) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramEnvironment: []interface{}{map[string]interface{}{
+			paramId: environmentId,
+		}},
+		paramGateway: []interface{}{map[string]interface{}{
+			paramId: gatewayId,
+		}},
+		paramAwsEgressPrivateLinkEndpoint: []interface{}{map[string]interface{}{
+			paramVpcEndpointServiceName: "com.amazonaws.vpce.us-west-2.vpce-svc-00000000000000000",
+		}},
+	})
+}
+
+func TestValidateReferencesCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		environmentId string
+		gatewayId     string
+		expectedError string
+	}{
+		{"existing references", referenceValidationTestEnvironmentId, referenceValidationTestGatewayId, ""},
+		{"missing environment", "env-typo", referenceValidationTestGatewayId, `Environment "env-typo" referenced by "environment.0.id" was not found`},
+		{"missing gateway", referenceValidationTestEnvironmentId, "gw-typo", `Gateway "gw-typo" referenced by "gateway.0.id" was not found in Environment "env-abc123"`},
+		{"environment created in the same plan", referenceValidationTestUnknownValue, referenceValidationTestUnknownValue, ""},
+		{"gateway created in the same plan", referenceValidationTestEnvironmentId, referenceValidationTestUnknownValue, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newReferenceValidationTestClient(t, true)
+			_, err := accessPointResource().Diff(context.Background(), nil, testAccessPointConfigWithReferences(tt.environmentId, tt.gatewayId), c)
+			if tt.expectedError == "" && err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if tt.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedError)) {
+				t.Fatalf("expected an error containing %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestValidateReferencesCustomizeDiffDisabled(t *testing.T) {
+	c, requestCount := newReferenceValidationTestClient(t, false)
+	if _, err := accessPointResource().Diff(context.Background(), nil, testAccessPointConfigWithReferences("env-typo", "gw-typo"), c); err != nil {
+		t.Fatalf("expected no error when %q is disabled, got: %s", paramValidateReferences, err)
+	}
+	if got := atomic.LoadInt32(requestCount); got != 0 {
+		t.Fatalf("expected no requests when %q is disabled, got %d", paramValidateReferences, got)
+	}
+}
+
+func TestValidateReferencesCustomizeDiffUsesResponseCache(t *testing.T) {
+	c, requestCount := newReferenceValidationTestClient(t, true)
+	c.responseCache = newResponseCache()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramDisplayName: "my-network",
+		paramCloud:       "AWS",
+		paramRegion:      "us-east-1",
+		paramEnvironment: []interface{}{map[string]interface{}{
+			paramId: referenceValidationTestEnvironmentId,
+		}},
+		paramConnectionTypes: []interface{}{"PRIVATELINK"},
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := networkResource().Diff(context.Background(), nil, config, c); err != nil {
+			t.Fatalf("expected no error, got: %s", err)
+		}
+	}
+	if got := atomic.LoadInt32(requestCount); got != 1 {
+		t.Fatalf("expected 1 request for 2 plans referencing the same Environment, got %d", got)
+	}
+}
+
+func TestValidateReferencesCustomizeDiffWithoutEnvironmentBlock(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramGateway: []interface{}{map[string]interface{}{
+			paramId: referenceValidationTestGatewayId,
+		}},
+		paramAwsEgressPrivateLinkEndpoint: []interface{}{map[string]interface{}{
+			paramVpcEndpointServiceName: "com.amazonaws.vpce.us-west-2.vpce-svc-00000000000000000",
+		}},
+	})
+
+	// The Gateway is looked up in provider.environment_id when the environment block is omitted
+	c, _ := newReferenceValidationTestClient(t, true)
+	c.environmentId = referenceValidationTestEnvironmentId
+	if _, err := accessPointResource().Diff(context.Background(), nil, config, c); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	// There's nothing to look the Gateway up in when provider.environment_id isn't set either
+	c, requestCount := newReferenceValidationTestClient(t, true)
+	if _, err := accessPointResource().Diff(context.Background(), nil, config, c); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if got := atomic.LoadInt32(requestCount); got != 0 {
+		t.Fatalf("expected no requests, got %d", got)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	netap "github.com/confluentinc/ccloud-sdk-go-v2/networking-access-point/v1"
@@ -63,6 +64,12 @@ func accessPointResource() *schema.Resource {
 			paramAwsEgressPrivateLinkEndpoint:   paramAwsEgressPrivateLinkEndpointSchema(),
 			paramAzureEgressPrivateLinkEndpoint: paramAzureEgressPrivateLinkEndpointSchema(),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
+			Update: schema.DefaultTimeout(networkingAPICreateTimeout),
+			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff, validateReferencedGatewayCustomizeDiff),
 	}
 }
 
@@ -132,7 +139,8 @@ func paramAzureEgressPrivateLinkEndpointSchema() *schema.Schema {
 					Computed: true,
 				},
 				paramPrivateEndpointCustomDnsConfigDomains: {
-					Type:     schema.TypeList,
+					// The API doesn't guarantee the order of the domains between reads
+					Type:     schema.TypeSet,
 					Computed: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
@@ -147,7 +155,10 @@ func accessPointCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	displayName := d.Get(paramDisplayName).(string)
 	gatewayId := extractStringValueFromBlock(d, paramGateway, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Access Point: %s", createDescriptiveError(err))
+	}
 
 	isAwsEgressPrivateLinkEndpoint := len(d.Get(paramAwsEgressPrivateLinkEndpoint).([]interface{})) > 0
 	isAzureEgressPrivateLinkEndpoint := len(d.Get(paramAzureEgressPrivateLinkEndpoint).([]interface{})) > 0
@@ -197,7 +208,7 @@ func accessPointCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.SetId(createdAccessPoint.GetId())
 
-	if err := waitForAccessPointToProvision(c.netAPApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForAccessPointToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Access Point %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -270,6 +281,10 @@ func accessPointDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error deleting Access Point %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
+	if err := waitForAccessPointToBeDeleted(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Access Point %q to be deleted: %s", d.Id(), createDescriptiveError(err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Access Point %q", d.Id()), map[string]interface{}{accessPointKey: d.Id()})
 
 	return nil
@@ -305,6 +320,10 @@ func accessPointUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error updating Access Point %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
+	if err := waitForAccessPointToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for Access Point %q to be updated: %s", d.Id(), createDescriptiveError(err))
+	}
+
 	updatedAccessPointJson, err := json.Marshal(updatedAccessPoint)
 	if err != nil {
 		return diag.Errorf("error updating Access Point %q: error marshaling %#v to json: %s", d.Id(), updatedAccessPoint, createDescriptiveError(err))
@@ -316,15 +335,21 @@ func accessPointUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 func accessPointImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tflog.Debug(ctx, fmt.Sprintf("Importing Access Point %q", d.Id()), map[string]interface{}{accessPointKey: d.Id()})
 
-	envIDAndAccessPointId := d.Id()
-	parts := strings.Split(envIDAndAccessPointId, "/")
-
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("error importing Access Point: invalid format: expected '<env ID>/<Access Point ID>'")
+	importId := d.Id()
+	parts := strings.Split(importId, "/")
+
+	var environmentId, gatewayId, accessPointId string
+	switch len(parts) {
+	case 2:
+		environmentId, accessPointId = parts[0], parts[1]
+	case 3:
+		environmentId, gatewayId, accessPointId = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("error importing Access Point: invalid format: expected '<env ID>/<Access Point ID>' or '<env ID>/<Gateway ID>/<Access Point ID>'")
+	}
+	if environmentId == "" || accessPointId == "" || (len(parts) == 3 && gatewayId == "") {
+		return nil, fmt.Errorf("error importing Access Point: invalid format: %q contains an empty segment", importId)
 	}
-
-	environmentId := parts[0]
-	accessPointId := parts[1]
 	d.SetId(accessPointId)
 
 	// Mark resource as new to avoid d.Set("") when getting 404
@@ -332,6 +357,9 @@ func accessPointImport(ctx context.Context, d *schema.ResourceData, meta interfa
 	if _, err := readAccessPointAndSetAttributes(ctx, d, meta, environmentId, accessPointId); err != nil {
 		return nil, fmt.Errorf("error importing Access Point %q: %s", d.Id(), err)
 	}
+	if actualGatewayId := extractStringValueFromBlock(d, paramGateway, paramId); gatewayId != "" && actualGatewayId != gatewayId {
+		return nil, fmt.Errorf("error importing Access Point %q: Access Point belongs to Gateway %q, not %q", d.Id(), actualGatewayId, gatewayId)
+	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished importing Access Point %q", d.Id()), map[string]interface{}{accessPointKey: d.Id()})
 	return []*schema.ResourceData{d}, nil
 }
@@ -19,18 +19,25 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/walkerus/go-wiremock"
 )
 
 const (
-	scenarioStateAccessPointIsProvisioning = "The new access point is provisioning"
-	scenarioStateAccessPointHasBeenCreated = "The new access point has been just created"
-	scenarioStateAccessPointHasBeenUpdated = "The new access point has been updated"
-	awsEgressAccessPointScenarioName       = "confluent_access_point Aws Egress Private Link Endpoint Resource Lifecycle"
-	azureEgressAccessPointScenarioName     = "confluent_access_point Azure Egress Private Link Endpoint Resource Lifecycle"
+	scenarioStateAccessPointIsProvisioning      = "The new access point is provisioning"
+	scenarioStateAccessPointHasBeenCreated      = "The new access point has been just created"
+	scenarioStateAccessPointHasBeenUpdated      = "The new access point has been updated"
+	scenarioStateAccessPointHasFailed           = "The new access point has failed to provision"
+	scenarioStateAccessPointHasBeenRead         = "The new access point has been read"
+	scenarioStateAccessPointHasBeenDeleted      = "The new access point has been deleted"
+	awsEgressAccessPointScenarioName            = "confluent_access_point Aws Egress Private Link Endpoint Resource Lifecycle"
+	azureEgressAccessPointScenarioName          = "confluent_access_point Azure Egress Private Link Endpoint Resource Lifecycle"
+	failedAwsEgressAccessPointScenarioName      = "confluent_access_point Aws Egress Private Link Endpoint Failed Provisioning"
+	reorderedAzureEgressAccessPointScenarioName = "confluent_access_point Azure Egress Private Link Endpoint Reordered Domains"
 
 	accessPointUrlPath       = "/networking/v1/access-points"
 	accessPointResourceLabel = "confluent_access_point.main"
@@ -108,12 +115,22 @@ func TestAccAccessPointAwsEgressPrivateLinkEndpoint(t *testing.T) {
 
 	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
 		InScenario(awsEgressAccessPointScenarioName).
+		WillSetStateTo(scenarioStateAccessPointHasBeenDeleted).
 		WillReturn(
 			"",
 			contentTypeJSONHeader,
 			http.StatusNoContent,
 		))
 
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(awsEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -152,6 +169,18 @@ func TestAccAccessPointAwsEgressPrivateLinkEndpoint(t *testing.T) {
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "aws_egress_private_link_endpoint.0.vpc_endpoint_dns_name", "*.vpce-00000000000000000-abcd1234.s3.us-west-2.vpce.amazonaws.com"),
 				),
 			},
+			{
+				ResourceName:      accessPointResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.RootModule().Resources
+					accessPointId := resources[accessPointResourceLabel].Primary.ID
+					environmentId := resources[accessPointResourceLabel].Primary.Attributes["environment.0.id"]
+					gatewayId := resources[accessPointResourceLabel].Primary.Attributes["gateway.0.id"]
+					return environmentId + "/" + gatewayId + "/" + accessPointId, nil
+				},
+			},
 		},
 	})
 }
@@ -228,12 +257,22 @@ func TestAccAccessPointAzureEgressPrivateLinkEndpoint(t *testing.T) {
 
 	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
 		InScenario(azureEgressAccessPointScenarioName).
+		WillSetStateTo(scenarioStateAccessPointHasBeenDeleted).
 		WillReturn(
 			"",
 			contentTypeJSONHeader,
 			http.StatusNoContent,
 		))
 
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(azureEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -257,8 +296,8 @@ func TestAccAccessPointAzureEgressPrivateLinkEndpoint(t *testing.T) {
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_domain", "dbname.database.windows.net"),
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_ip_address", "10.2.0.68"),
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.#", "2"),
-					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.0", "dbname.database.windows.net"),
-					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.1", "dbname-region.database.windows.net"),
+					resource.TestCheckTypeSetElemAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.*", "dbname.database.windows.net"),
+					resource.TestCheckTypeSetElemAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.*", "dbname-region.database.windows.net"),
 				),
 			},
 			{
@@ -278,14 +317,196 @@ func TestAccAccessPointAzureEgressPrivateLinkEndpoint(t *testing.T) {
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_domain", "dbname.database.windows.net"),
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_ip_address", "10.2.0.68"),
 					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.#", "2"),
-					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.0", "dbname.database.windows.net"),
-					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.1", "dbname-region.database.windows.net"),
+					resource.TestCheckTypeSetElemAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.*", "dbname.database.windows.net"),
+					resource.TestCheckTypeSetElemAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.*", "dbname-region.database.windows.net"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAccessPointAwsEgressPrivateLinkEndpointFailed(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createAccessPointResponse, _ := os.ReadFile("../testdata/network_access_point/create_aws_egress_ap.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(accessPointUrlPath)).
+		InScenario(failedAwsEgressAccessPointScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateAccessPointIsProvisioning).
+		WillReturn(
+			string(createAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	accessPointReadUrlPath := fmt.Sprintf("%s/ap-abc123", accessPointUrlPath)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(failedAwsEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointIsProvisioning).
+		WillSetStateTo(scenarioStateAccessPointHasFailed).
+		WillReturn(
+			string(createAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readFailedAccessPointResponse, _ := os.ReadFile("../testdata/network_access_point/read_failed_aws_egress_ap.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(failedAwsEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasFailed).
+		WillReturn(
+			string(readFailedAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(failedAwsEgressAccessPointScenarioName).
+		WillSetStateTo(scenarioStateAccessPointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(failedAwsEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckResourceAccessPointAwsEgressWithIdSet(mockServerUrl),
+				ExpectError: regexp.MustCompile(`access point "ap-abc123" provisioning status is "FAILED": VPC endpoint connection vpce-00000000000000000 was rejected by the service owner`),
+			},
+		},
+	})
+}
+
+func TestAccAccessPointAzureEgressPrivateLinkEndpointReorderedDomains(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createAccessPointResponse, _ := os.ReadFile("../testdata/network_access_point/create_azure_egress_ap.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(accessPointUrlPath)).
+		InScenario(reorderedAzureEgressAccessPointScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateAccessPointIsProvisioning).
+		WillReturn(
+			string(createAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	accessPointReadUrlPath := fmt.Sprintf("%s/ap-def456", accessPointUrlPath)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(reorderedAzureEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointIsProvisioning).
+		WillSetStateTo(scenarioStateAccessPointHasBeenCreated).
+		WillReturn(
+			string(createAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedAccessPointResponse, _ := os.ReadFile("../testdata/network_access_point/read_created_azure_egress_ap.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(reorderedAzureEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasBeenCreated).
+		WillSetStateTo(scenarioStateAccessPointHasBeenRead).
+		WillReturn(
+			string(readCreatedAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// Every subsequent read returns the same domains in the reversed order
+	readReorderedAccessPointResponse, _ := os.ReadFile("../testdata/network_access_point/read_reordered_azure_egress_ap.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(reorderedAzureEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasBeenRead).
+		WillReturn(
+			string(readReorderedAccessPointResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(reorderedAzureEgressAccessPointScenarioName).
+		WillSetStateTo(scenarioStateAccessPointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(accessPointReadUrlPath)).
+		InScenario(reorderedAzureEgressAccessPointScenarioName).
+		WhenScenarioStateIs(scenarioStateAccessPointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceAccessPointAzureEgressWithIdSet(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(accessPointResourceLabel, "id", "ap-def456"),
+					resource.TestCheckResourceAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.#", "2"),
+					resource.TestCheckTypeSetElemAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.*", "dbname.database.windows.net"),
+					resource.TestCheckTypeSetElemAttr(accessPointResourceLabel, "azure_egress_private_link_endpoint.0.private_endpoint_custom_dns_config_domains.*", "dbname-region.database.windows.net"),
+				),
+			},
+			{
+				Config:             testAccCheckResourceAccessPointAzureEgressWithIdSet(mockServerUrl),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func testAccCheckResourceAccessPointAwsEgressWithIdSet(mockServerUrl string) string {
 	return fmt.Sprintf(`
     provider "confluent" {
@@ -21,6 +21,7 @@ import (
 	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -34,28 +35,38 @@ const (
 	paramOwner               = "owner"
 	paramResource            = "managed_resource"
 	paramDisableWaitForReady = "disable_wait_for_ready"
+	paramRotateTrigger       = "rotate_trigger"
 
 	serviceAccountKind   = "ServiceAccount"
 	userKind             = "User"
+	identityPoolKind     = "IdentityPool"
 	clusterKind          = "Cluster"
 	regionKind           = "Region"
 	schemaRegistryKind   = "SchemaRegistry"
 	ksqlDbKind           = "ksqlDB"
+	tableflowKind        = "Tableflow"
 	cloudKindInLowercase = "cloud"
 
-	iamApiVersion      = "iam/v2"
-	cmkApiVersion      = "cmk/v2"
-	srcmV2ApiVersion   = "srcm/v2"
-	srcmV3ApiVersion   = "srcm/v3"
-	ksqldbcmApiVersion = "ksqldbcm/v2"
-	fcpmApiVersion     = "fcpm/v2"
+	iamApiVersion       = "iam/v2"
+	cmkApiVersion       = "cmk/v2"
+	srcmV2ApiVersion    = "srcm/v2"
+	srcmV3ApiVersion    = "srcm/v3"
+	ksqldbcmApiVersion  = "ksqldbcm/v2"
+	fcpmApiVersion      = "fcpm/v2"
+	tableflowApiVersion = "tableflow/v1"
 )
 
-var acceptedOwnerKinds = []string{serviceAccountKind, userKind}
-var acceptedResourceKinds = []string{clusterKind, regionKind}
+var acceptedOwnerKinds = []string{serviceAccountKind, userKind, identityPoolKind}
+var acceptedResourceKinds = []string{clusterKind, regionKind, tableflowKind}
+
+var ownerIdPrefixes = map[string]string{
+	serviceAccountKind: "sa-",
+	userKind:           "u-",
+	identityPoolKind:   "pool-",
+}
 
 var acceptedOwnerApiVersions = []string{iamApiVersion}
-var acceptedResourceApiVersions = []string{cmkApiVersion, srcmV2ApiVersion, srcmV3ApiVersion, ksqldbcmApiVersion, fcpmApiVersion}
+var acceptedResourceApiVersions = []string{cmkApiVersion, srcmV2ApiVersion, srcmV3ApiVersion, ksqldbcmApiVersion, fcpmApiVersion, tableflowApiVersion}
 
 func apiKeyResource() *schema.Resource {
 	return &schema.Resource{
@@ -94,8 +105,44 @@ func apiKeyResource() *schema.Resource {
 				Default:  false,
 				ForceNew: true,
 			},
+			// The value is never sent to Confluent Cloud, it only forces creation of a new API key when it changes
+			paramRotateTrigger: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "An arbitrary string that, when changed, forces the API key to be rotated.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 		},
+		CustomizeDiff: customdiff.Sequence(apiKeyOwnerCustomizeDiff),
+	}
+}
+
+// apiKeyOwnerCustomizeDiff catches an owner ID that doesn't match the owner kind at plan time
+// instead of failing in the middle of an apply.
+func apiKeyOwnerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(fmt.Sprintf("%s.0.%s", paramOwner, paramId)) || !diff.NewValueKnown(fmt.Sprintf("%s.0.%s", paramOwner, paramKind)) {
+		return nil
 	}
+	ownerId := diff.Get(fmt.Sprintf("%s.0.%s", paramOwner, paramId)).(string)
+	ownerKind := diff.Get(fmt.Sprintf("%s.0.%s", paramOwner, paramKind)).(string)
+	return validateApiKeyOwner(ownerId, ownerKind)
+}
+
+// validateApiKeyOwner checks that the owner ID prefix matches the owner kind, for example,
+// an identity pool owned (principal-less) API key must reference a "pool-" ID.
+func validateApiKeyOwner(ownerId, ownerKind string) error {
+	if ownerId == "" || ownerKind == "" {
+		return nil
+	}
+	expectedPrefix, ok := ownerIdPrefixes[ownerKind]
+	if !ok {
+		return fmt.Errorf("error validating %q block: %q must be one of %v, got %q", paramOwner, paramKind, acceptedOwnerKinds, ownerKind)
+	}
+	if !strings.HasPrefix(ownerId, expectedPrefix) {
+		return fmt.Errorf("error validating %q block: the owner ID %q must start with %q when %q is %q", paramOwner, ownerId, expectedPrefix, paramKind, ownerKind)
+	}
+	return nil
 }
 
 func apiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -113,7 +160,7 @@ func apiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	spec.SetDescription(description)
 	spec.SetOwner(apikeys.ObjectReference{Id: ownerId, Kind: &ownerKind})
 
-	// If paramResource block is present, then the API Key is a resource-specific API key (Kafka, Schema Registry, Flink, and ksqlDB).
+	// If paramResource block is present, then the API Key is a resource-specific API key (Kafka, Schema Registry, Flink, ksqlDB, and Tableflow).
 	// https://docs.confluent.io/cloud/current/access-management/authenticate/api-keys/api-keys.html#resource-specific-api-keys
 	// Otherwise, it's Cloud API Key.
 	isResourceSpecificApiKey := len(d.Get(paramResource).([]interface{})) > 0
@@ -126,14 +173,18 @@ func apiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		apiVersion := extractStringValueFromBlock(d, paramResource, paramApiVersion)
 		spec.SetResource(apikeys.ObjectReference{Id: resourceId, Kind: &resourceKind})
 
-		// Client needs to specify api_version only when creating Flink API Key
-		if apiVersion == fcpmApiVersion {
-			spec.Resource.SetApiVersion(fcpmApiVersion)
+		// Client needs to specify api_version only when creating Flink and Tableflow API Keys
+		if apiVersion == fcpmApiVersion || apiVersion == tableflowApiVersion {
+			spec.Resource.SetApiVersion(apiVersion)
 		}
 		if isFlinkApiKey(apikeys.IamV2ApiKey{Spec: spec}) {
 			spec.Resource.SetId(resourceId)
 			spec.Resource.SetEnvironment(environmentId)
 		}
+		// Tableflow API Keys are scoped to an organization rather than to an environment
+		if environmentId == "" && !isTableflowApiKey(apikeys.IamV2ApiKey{Spec: spec}) {
+			return diag.Errorf("error creating API Key: %q block must be set in %q block for %s %s resource", paramEnvironment, paramResource, apiVersion, resourceKind)
+		}
 	}
 
 	createApiKeyRequest := apikeys.IamV2ApiKey{Spec: spec}
@@ -151,21 +202,25 @@ func apiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("Created API Key is malformed: %s", err)
 	}
 
-	if !skipSync {
-		// Wait until the API Key is synced and is ready to use
-		tflog.Debug(ctx, fmt.Sprintf("Waiting for API Key %q to sync", createdApiKey.GetId()), map[string]interface{}{apiKeyLoggingKey: createdApiKey.GetId()})
-		if err := waitForApiKeyToSync(ctx, c, createdApiKey, isResourceSpecificApiKey, environmentId); err != nil {
-			return diag.FromErr(createDescriptiveError(err))
-		}
-	}
-
 	// Save the API Key Secret
 	if err := d.Set(paramSecret, createdApiKey.Spec.GetSecret()); err != nil {
 		return diag.FromErr(createDescriptiveError(err))
 	}
 
+	// Set the ID before waiting so that an API Key that never becomes usable is tracked (as tainted) rather than leaked
 	d.SetId(createdApiKey.GetId())
 
+	if !skipSync {
+		// Wait until the API Key is synced and is ready to use
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for API Key %q to sync", createdApiKey.GetId()), map[string]interface{}{apiKeyLoggingKey: createdApiKey.GetId()})
+		if err := waitForApiKeyToSync(ctx, c, createdApiKey, isResourceSpecificApiKey, environmentId); err != nil {
+			return diag.Errorf("error creating API Key %q: the API Key was created but it could not be used: %s. "+
+				"The API Key will be replaced on the next apply. "+
+				"Set %q to true to skip this check, for example, when the API Key's managed resource is not reachable over the network.",
+				createdApiKey.GetId(), createDescriptiveError(err), paramDisableWaitForReady)
+		}
+	}
+
 	// Set the API Key Secret (sensitive value) to an empty string
 	createdApiKey.Spec.SetSecret("")
 	createdApiKeyJson, err := json.Marshal(createdApiKey)
@@ -355,7 +410,7 @@ func apiKeyOwnerSchema() *schema.Schema {
 		MaxItems:    1,
 		Required:    true,
 		ForceNew:    true,
-		Description: "The owner to which the API Key belongs. The owner can be one of 'iam.v2.User', 'iam.v2.ServiceAccount'.",
+		Description: "The owner to which the API Key belongs. The owner can be one of 'iam.v2.User', 'iam.v2.ServiceAccount', 'iam.v2.IdentityPool'.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				paramId: {
@@ -363,7 +418,7 @@ func apiKeyOwnerSchema() *schema.Schema {
 					Required:     true,
 					ForceNew:     true,
 					Description:  "The unique identifier for the referred owner.",
-					ValidateFunc: validation.StringMatch(regexp.MustCompile("^(u-|sa-)"), "the owner ID must be of the form 'u-' or 'sa-'"),
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^(u-|sa-|pool-)"), "the owner ID must be of the form 'u-', 'sa-' or 'pool-'"),
 				},
 				paramKind: {
 					Type:         schema.TypeString,
@@ -392,7 +447,7 @@ func apiKeyResourceSchema() *schema.Schema {
 		// If the resource is not specified, then Cloud API Key gets created
 		Optional:    true,
 		ForceNew:    true,
-		Description: "The resource associated with this object. The only resource that is supported is 'cmk.v2.Cluster', 'srcm.v2.Cluster', 'srcm.v3.Cluster'.",
+		Description: "The resource associated with this object. The only resource that is supported is 'cmk.v2.Cluster', 'srcm.v2.Cluster', 'srcm.v3.Cluster', 'ksqldbcm.v2.Cluster', 'fcpm.v2.Region', 'tableflow.v1.Tableflow'.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				paramId: {
@@ -422,12 +477,21 @@ func apiKeyResourceSchema() *schema.Schema {
 						return olds[0] == news[0] && stringInSlice(new, acceptedResourceApiVersions, false)
 					},
 				},
-				paramEnvironment: environmentSchema(),
+				paramEnvironment: optionalApiKeyEnvironmentSchema(),
 			},
 		},
 	}
 }
 
+func optionalApiKeyEnvironmentSchema() *schema.Schema {
+	environmentSchema := environmentSchema()
+	// Tableflow API Keys don't belong to any environment
+	environmentSchema.Optional = true
+	// Unlike other resources, API Keys don't fall back to provider.environment_id
+	environmentSchema.Computed = false
+	return environmentSchema
+}
+
 // extractStringValueFromBlock() returns the string for the given key, or "" if the key doesn't exist in the configuration.
 // Schema definition's required:true property guarantees that all required resource attributes are set
 // hence extractStringValueFromBlock() doesn't return errors (similar to d.Get())
@@ -457,7 +521,7 @@ func validateApiKey(apiKey apikeys.IamV2ApiKey) error {
 
 // Send a GetCluster request to CMK API to find out rest_endpoint for a given (environmentId, clusterId) pair
 func fetchHttpEndpointOfKafkaCluster(ctx context.Context, c *Client, environmentId, clusterId string) (string, error) {
-	cluster, _, err := executeKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
+	cluster, err := executeCachedKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
 	if err != nil {
 		return "", fmt.Errorf("error reading Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
 	}
@@ -516,6 +580,10 @@ func isFlinkApiKey(apiKey apikeys.IamV2ApiKey) bool {
 	return apiKey.Spec.Resource.GetKind() == regionKind && apiKey.Spec.Resource.GetApiVersion() == fcpmApiVersion
 }
 
+func isTableflowApiKey(apiKey apikeys.IamV2ApiKey) bool {
+	return apiKey.Spec.Resource.GetKind() == tableflowKind && apiKey.Spec.Resource.GetApiVersion() == tableflowApiVersion
+}
+
 func isKsqlDbClusterApiKey(apiKey apikeys.IamV2ApiKey) bool {
 	// At the moment, API Key Mgmt API temporarily returns ksqlDbKind instead of clusterKind
 	return (apiKey.Spec.Resource.GetKind() == clusterKind || apiKey.Spec.Resource.GetKind() == ksqlDbKind) && apiKey.Spec.Resource.GetApiVersion() == ksqldbcmApiVersion
@@ -567,6 +635,9 @@ func waitForApiKeyToSync(ctx context.Context, c *Client, createdApiKey apikeys.I
 			// to check whether the Cluster API Key is synced which is why we're adding SleepIfNotTestMode() here.
 			// TODO: SVCF-3560
 			SleepIfNotTestMode(5*time.Minute, c.isAcceptanceTestMode)
+		} else if isTableflowApiKey(createdApiKey) {
+			// Currently, there is no data plane API for Tableflow that we could leverage to check whether the API Key is synced.
+			tflog.Debug(ctx, fmt.Sprintf("Skipping waiting for Tableflow API Key %q to sync", createdApiKey.GetId()), map[string]interface{}{apiKeyLoggingKey: createdApiKey.GetId()})
 		} else {
 			resourceJson, err := json.Marshal(createdApiKey.Spec.GetResource())
 			if err != nil {
@@ -0,0 +1,199 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	scenarioStateRotatedApiKeyHasBeenCreated  = "The rotated cloud api key has been just created"
+	scenarioStateOriginalApiKeyHasBeenDeleted = "The original cloud api key has been deleted"
+	scenarioStateRotatedApiKeyHasBeenDeleted  = "The rotated cloud api key has been deleted"
+	rotatedApiKeyScenarioName                 = "confluent_api_key (Cloud API Key) Rotation"
+
+	originalCloudApiKeyId     = "HRVR6K4VMXYD2LDZ"
+	originalCloudApiKeySecret = "p07o8EyjQvink5NmErBffigyynQXrTsYGKBzIgr3M10Mg+JOgnObYjlqCC1Q1id1"
+	rotatedCloudApiKeyId      = "JFZJ5K2LQKV3PQ7U"
+	rotatedCloudApiKeySecret  = "Xq2mN8vR4tY7uI1oP3aS5dF6gH9jK0lZ2xC4vB7nM1qW3eR5tY8uI0oP2aS4dF6g"
+	rotateTrigger             = "2024-01"
+	updatedRotateTrigger      = "2024-02"
+)
+
+func TestAccCloudApiKeyRotation(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	originalApiKeyPath := fmt.Sprintf("/iam/v2/api-keys/%s", originalCloudApiKeyId)
+	rotatedApiKeyPath := fmt.Sprintf("/iam/v2/api-keys/%s", rotatedCloudApiKeyId)
+
+	createCloudApiKeyResponse, _ := os.ReadFile("../testdata/apikey/create_cloud_api_key.json")
+	createCloudApiKeyStub := wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/api-keys")).
+		InScenario(rotatedApiKeyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateCloudApiKeyHasBeenCreated).
+		WillReturn(
+			string(createCloudApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createCloudApiKeyStub)
+
+	// create_before_destroy creates the rotated API key before the original one is deleted
+	createRotatedCloudApiKeyResponse, _ := os.ReadFile("../testdata/apikey/create_rotated_cloud_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/api-keys")).
+		InScenario(rotatedApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateCloudApiKeyHasBeenCreated).
+		WillSetStateTo(scenarioStateRotatedApiKeyHasBeenCreated).
+		WillReturn(
+			string(createRotatedCloudApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	readCreatedCloudApiKeyResponse, _ := os.ReadFile("../testdata/apikey/read_created_cloud_api_key.json")
+	for _, state := range []string{scenarioStateCloudApiKeyHasBeenCreated, scenarioStateRotatedApiKeyHasBeenCreated} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(originalApiKeyPath)).
+			InScenario(rotatedApiKeyScenarioName).
+			WhenScenarioStateIs(state).
+			WillReturn(
+				string(readCreatedCloudApiKeyResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	readRotatedCloudApiKeyResponse, _ := os.ReadFile("../testdata/apikey/read_rotated_cloud_api_key.json")
+	for _, state := range []string{scenarioStateRotatedApiKeyHasBeenCreated, scenarioStateOriginalApiKeyHasBeenDeleted} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(rotatedApiKeyPath)).
+			InScenario(rotatedApiKeyScenarioName).
+			WhenScenarioStateIs(state).
+			WillReturn(
+				string(readRotatedCloudApiKeyResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	deleteOriginalCloudApiKeyStub := wiremock.Delete(wiremock.URLPathEqualTo(originalApiKeyPath)).
+		InScenario(rotatedApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateRotatedApiKeyHasBeenCreated).
+		WillSetStateTo(scenarioStateOriginalApiKeyHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteOriginalCloudApiKeyStub)
+
+	deleteRotatedCloudApiKeyStub := wiremock.Delete(wiremock.URLPathEqualTo(rotatedApiKeyPath)).
+		InScenario(rotatedApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateOriginalApiKeyHasBeenDeleted).
+		WillSetStateTo(scenarioStateRotatedApiKeyHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteRotatedCloudApiKeyStub)
+
+	readDeletedCloudApiKeyResponse, _ := os.ReadFile("../testdata/apikey/read_deleted_cloud_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(rotatedApiKeyPath)).
+		InScenario(rotatedApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateRotatedApiKeyHasBeenDeleted).
+		WillReturn(
+			string(readDeletedCloudApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+
+	cloudApiKeyResourceLabel := "test_cloud_api_key_resource_label"
+	fullCloudApiKeyResourceLabel := fmt.Sprintf("confluent_api_key.%s", cloudApiKeyResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApiKeyDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudApiKeyWithRotateTriggerConfig(mockServerUrl, cloudApiKeyResourceLabel, rotateTrigger),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fullCloudApiKeyResourceLabel),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "id", originalCloudApiKeyId),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "rotate_trigger", rotateTrigger),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "secret", originalCloudApiKeySecret),
+				),
+			},
+			{
+				Config: testAccCheckCloudApiKeyWithRotateTriggerConfig(mockServerUrl, cloudApiKeyResourceLabel, updatedRotateTrigger),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fullCloudApiKeyResourceLabel),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "id", rotatedCloudApiKeyId),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "rotate_trigger", updatedRotateTrigger),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "secret", rotatedCloudApiKeySecret),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createCloudApiKeyStub, "POST /iam/v2/api-keys", expectedCountTwo)
+	checkStubCount(t, wiremockClient, deleteOriginalCloudApiKeyStub, fmt.Sprintf("DELETE %s", originalApiKeyPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteRotatedCloudApiKeyStub, fmt.Sprintf("DELETE %s", rotatedApiKeyPath), expectedCountOne)
+}
+
+func testAccCheckCloudApiKeyWithRotateTriggerConfig(mockServerUrl, cloudApiKeyResourceLabel, rotateTrigger string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_api_key" "%s" {
+		display_name = "CI Cloud API Key"
+		description = "temp description"
+		owner {
+			id = "sa-12mgdv"
+			api_version = "iam/v2"
+			kind = "ServiceAccount"
+		}
+		rotate_trigger = "%s"
+		disable_wait_for_ready = true
+
+		lifecycle {
+			create_before_destroy = true
+		}
+	}
+	`, mockServerUrl, cloudApiKeyResourceLabel, rotateTrigger)
+}
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -48,6 +49,18 @@ const (
 	scenarioStateCloudApiKeyHasBeenUpdated = "The new cloud api key's description and display_name have been just updated"
 	scenarioStateCloudApiKeyHasBeenDeleted = "The new cloud api key has been deleted"
 	cloudApiKeyScenarioName                = "confluent_api_key (Cloud API Key) Resource Lifecycle"
+
+	scenarioStateCloudApiKeyHasReturned401Once  = "The new cloud api key has returned 401 once"
+	scenarioStateCloudApiKeyHasReturned401Twice = "The new cloud api key has returned 401 twice"
+	cloudApiKeyWaitForReadyScenarioName         = "confluent_api_key (Cloud API Key) Wait For Ready"
+
+	scenarioStateTableflowApiKeyHasBeenCreated = "The new tableflow api key has been just created"
+	scenarioStateTableflowApiKeyHasBeenDeleted = "The new tableflow api key has been deleted"
+	tableflowApiKeyScenarioName                = "confluent_api_key (Tableflow API Key) Resource Lifecycle"
+
+	scenarioStateIdentityPoolApiKeyHasBeenCreated = "The new identity pool api key has been just created"
+	scenarioStateIdentityPoolApiKeyHasBeenDeleted = "The new identity pool api key has been deleted"
+	identityPoolApiKeyScenarioName                = "confluent_api_key (Identity Pool API Key) Resource Lifecycle"
 )
 
 func TestAccKafkaApiKey(t *testing.T) {
@@ -703,6 +716,349 @@ func TestAccCloudApiKey(t *testing.T) {
 	checkStubCount(t, wiremockClient, listEnvsOrgApi401Stub, "GET /org/v2/environments", 2)
 }
 
+func TestAccCloudApiKeyWaitForReady(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createCloudApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/create_cloud_api_key.json")
+	createCloudApiKeyStub := wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/api-keys")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(createCloudApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createCloudApiKeyStub)
+
+	// The new API Key is rejected twice before it becomes usable
+	listEnvs401Response, _ := ioutil.ReadFile("../testdata/apikey/read_list_envs_401.json")
+	listEnvsOrgApi401Stub := wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateCloudApiKeyHasReturned401Once).
+		WillReturn(
+			string(listEnvs401Response),
+			contentTypeJSONHeader,
+			http.StatusUnauthorized,
+		)
+	_ = wiremockClient.StubFor(listEnvsOrgApi401Stub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(scenarioStateCloudApiKeyHasReturned401Once).
+		WillSetStateTo(scenarioStateCloudApiKeyHasReturned401Twice).
+		WillReturn(
+			string(listEnvs401Response),
+			contentTypeJSONHeader,
+			http.StatusUnauthorized,
+		))
+
+	listEnvs200Response, _ := ioutil.ReadFile("../testdata/apikey/read_list_envs_200.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(scenarioStateCloudApiKeyHasReturned401Twice).
+		WillSetStateTo(scenarioStateCloudApiKeyHasBeenCreated).
+		WillReturn(
+			string(listEnvs200Response),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedCloudApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/read_created_cloud_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/api-keys/HRVR6K4VMXYD2LDZ")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(scenarioStateCloudApiKeyHasBeenCreated).
+		WillReturn(
+			string(readCreatedCloudApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedCloudApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/read_deleted_cloud_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/api-keys/HRVR6K4VMXYD2LDZ")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(scenarioStateCloudApiKeyHasBeenDeleted).
+		WillReturn(
+			string(readDeletedCloudApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo("/iam/v2/api-keys/HRVR6K4VMXYD2LDZ")).
+		InScenario(cloudApiKeyWaitForReadyScenarioName).
+		WhenScenarioStateIs(scenarioStateCloudApiKeyHasBeenCreated).
+		WillSetStateTo(scenarioStateCloudApiKeyHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		))
+
+	cloudApiKeyResourceLabel := "test_cloud_api_key_resource_label"
+	fullCloudApiKeyResourceLabel := fmt.Sprintf("confluent_api_key.%s", cloudApiKeyResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApiKeyDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudApiKeyConfig(mockServerUrl, cloudApiKeyResourceLabel, "CI Cloud API Key", "temp description", "sa-12mgdv", "iam/v2", "ServiceAccount"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fullCloudApiKeyResourceLabel),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "id", "HRVR6K4VMXYD2LDZ"),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "disable_wait_for_ready", "false"),
+					resource.TestCheckResourceAttr(fullCloudApiKeyResourceLabel, "secret", "p07o8EyjQvink5NmErBffigyynQXrTsYGKBzIgr3M10Mg+JOgnObYjlqCC1Q1id1"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createCloudApiKeyStub, "POST /iam/v2/api-keys", expectedCountOne)
+	// Combine all stubs into a single check since it doesn't differentiate between states
+	checkStubCount(t, wiremockClient, listEnvsOrgApi401Stub, "GET /org/v2/environments", 3)
+}
+
+func TestAccTableflowApiKey(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createTableflowApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/create_tableflow_api_key.json")
+	createTableflowApiKeyStub := wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/api-keys")).
+		WithBodyPattern(wiremock.MatchingJsonPath("$.spec.resource[?(@.id == 'tableflow' && @.kind == 'Tableflow' && @.api_version == 'tableflow/v1')]")).
+		InScenario(tableflowApiKeyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateTableflowApiKeyHasBeenCreated).
+		WillReturn(
+			string(createTableflowApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTableflowApiKeyStub)
+
+	readCreatedTableflowApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/read_created_tableflow_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/api-keys/TFLW3K2QJ6XN5MZA")).
+		InScenario(tableflowApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateTableflowApiKeyHasBeenCreated).
+		WillReturn(
+			string(readCreatedTableflowApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedTableflowApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/read_deleted_cloud_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/api-keys/TFLW3K2QJ6XN5MZA")).
+		InScenario(tableflowApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateTableflowApiKeyHasBeenDeleted).
+		WillReturn(
+			string(readDeletedTableflowApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+	deleteTableflowApiKeyStub := wiremock.Delete(wiremock.URLPathEqualTo("/iam/v2/api-keys/TFLW3K2QJ6XN5MZA")).
+		InScenario(tableflowApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateTableflowApiKeyHasBeenCreated).
+		WillSetStateTo(scenarioStateTableflowApiKeyHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTableflowApiKeyStub)
+
+	tableflowApiKeyDisplayName := "CI Tableflow API Key"
+	tableflowApiKeyDescription := "Tableflow API Key"
+	tableflowApiKeyResourceLabel := "test_tableflow_api_key_resource_label"
+	fullTableflowApiKeyResourceLabel := fmt.Sprintf("confluent_api_key.%s", tableflowApiKeyResourceLabel)
+
+	// Set fake values for secrets since those are required for importing
+	os.Setenv("API_KEY_SECRET", "Wd3lP9kQ2mZx7Rt5Yb1Nc8Hv4Gf6Js0Ka2Le9Ou3Iy5Tr7Ew1Qa4Sd6Fg8Hj0Kl2")
+	defer func() {
+		os.Unsetenv("API_KEY_SECRET")
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApiKeyDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTableflowApiKeyConfig(mockServerUrl, tableflowApiKeyResourceLabel, tableflowApiKeyDisplayName, tableflowApiKeyDescription),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fullTableflowApiKeyResourceLabel),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "id", "TFLW3K2QJ6XN5MZA"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "display_name", tableflowApiKeyDisplayName),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "description", tableflowApiKeyDescription),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "owner.#", "1"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "owner.0.id", "sa-12mgdv"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "managed_resource.#", "1"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "managed_resource.0.api_version", "tableflow/v1"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "managed_resource.0.id", "tableflow"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "managed_resource.0.kind", "Tableflow"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "managed_resource.0.environment.#", "0"),
+					resource.TestCheckResourceAttr(fullTableflowApiKeyResourceLabel, "secret", "Wd3lP9kQ2mZx7Rt5Yb1Nc8Hv4Gf6Js0Ka2Le9Ou3Iy5Tr7Ew1Qa4Sd6Fg8Hj0Kl2"),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullTableflowApiKeyResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createTableflowApiKeyStub, "POST /iam/v2/api-keys", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTableflowApiKeyStub, "DELETE /iam/v2/api-keys/TFLW3K2QJ6XN5MZA", expectedCountOne)
+}
+
+func TestAccIdentityPoolApiKey(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createIdentityPoolApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/create_identity_pool_api_key.json")
+	createIdentityPoolApiKeyStub := wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/api-keys")).
+		WithBodyPattern(wiremock.MatchingJsonPath("$.spec.owner[?(@.id == 'pool-W5Qe' && @.kind == 'IdentityPool')]")).
+		InScenario(identityPoolApiKeyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateIdentityPoolApiKeyHasBeenCreated).
+		WillReturn(
+			string(createIdentityPoolApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createIdentityPoolApiKeyStub)
+
+	readCreatedIdentityPoolApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/read_created_identity_pool_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/api-keys/PLKY7Q2MZN4XR8TA")).
+		InScenario(identityPoolApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateIdentityPoolApiKeyHasBeenCreated).
+		WillReturn(
+			string(readCreatedIdentityPoolApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedIdentityPoolApiKeyResponse, _ := ioutil.ReadFile("../testdata/apikey/read_deleted_cloud_api_key.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/api-keys/PLKY7Q2MZN4XR8TA")).
+		InScenario(identityPoolApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateIdentityPoolApiKeyHasBeenDeleted).
+		WillReturn(
+			string(readDeletedIdentityPoolApiKeyResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+	deleteIdentityPoolApiKeyStub := wiremock.Delete(wiremock.URLPathEqualTo("/iam/v2/api-keys/PLKY7Q2MZN4XR8TA")).
+		InScenario(identityPoolApiKeyScenarioName).
+		WhenScenarioStateIs(scenarioStateIdentityPoolApiKeyHasBeenCreated).
+		WillSetStateTo(scenarioStateIdentityPoolApiKeyHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteIdentityPoolApiKeyStub)
+
+	identityPoolApiKeyResourceLabel := "test_identity_pool_api_key_resource_label"
+	fullIdentityPoolApiKeyResourceLabel := fmt.Sprintf("confluent_api_key.%s", identityPoolApiKeyResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApiKeyDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIdentityPoolApiKeyConfig(mockServerUrl, identityPoolApiKeyResourceLabel, "sa-12mgdv"),
+				ExpectError: regexp.MustCompile("the owner ID \"sa-12mgdv\" must start with \"pool-\""),
+			},
+			{
+				Config: testAccCheckIdentityPoolApiKeyConfig(mockServerUrl, identityPoolApiKeyResourceLabel, "pool-W5Qe"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fullIdentityPoolApiKeyResourceLabel),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "id", "PLKY7Q2MZN4XR8TA"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "display_name", "CI Identity Pool API Key"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "description", "Identity Pool API Key"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "owner.#", "1"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "owner.0.api_version", "iam/v2"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "owner.0.id", "pool-W5Qe"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "owner.0.kind", "IdentityPool"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "managed_resource.#", "0"),
+					resource.TestCheckResourceAttr(fullIdentityPoolApiKeyResourceLabel, "secret", "Gm4Tz8Lq1Wv6Rb3Nx9Kc2Hs7Pd5Fj0Ya4Ue8Io2Bw6Qn1Mr3Xt9Zl5Cv7Ks0Dg2Hp4"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createIdentityPoolApiKeyStub, "POST /iam/v2/api-keys", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteIdentityPoolApiKeyStub, "DELETE /iam/v2/api-keys/PLKY7Q2MZN4XR8TA", expectedCountOne)
+}
+
+func TestValidateApiKeyOwner(t *testing.T) {
+	tests := []struct {
+		ownerId   string
+		ownerKind string
+		wantErr   bool
+	}{
+		{"sa-12mgdv", serviceAccountKind, false},
+		{"u-4vd2kq", userKind, false},
+		{"pool-W5Qe", identityPoolKind, false},
+		{"pool-W5Qe", serviceAccountKind, true},
+		{"sa-12mgdv", identityPoolKind, true},
+		{"u-4vd2kq", identityPoolKind, true},
+		{"sa-12mgdv", "Group", true},
+		// Unknown values are validated during apply
+		{"", identityPoolKind, false},
+	}
+	for _, test := range tests {
+		err := validateApiKeyOwner(test.ownerId, test.ownerKind)
+		if (err != nil) != test.wantErr {
+			t.Errorf("validateApiKeyOwner(%q, %q) returned error %v, wantErr=%t", test.ownerId, test.ownerKind, err, test.wantErr)
+		}
+	}
+}
+
 func testAccCheckApiKeyDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 	// Loop through the resources in state, verifying each kafka api key is destroyed
@@ -795,6 +1151,46 @@ func testAccCheckCloudApiKeyConfig(mockServerUrl, cloudApiKeyResourceLabel, clou
 	`, mockServerUrl, cloudApiKeyResourceLabel, cloudApiKeyDisplayName, cloudApiKeyDescription, ownerId, ownerApiVersion, ownerKind)
 }
 
+func testAccCheckTableflowApiKeyConfig(mockServerUrl, tableflowApiKeyResourceLabel, tableflowApiKeyDisplayName, tableflowApiKeyDescription string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_api_key" "%s" {
+		display_name = "%s"
+		description = "%s"
+		owner {
+			id = "sa-12mgdv"
+			api_version = "iam/v2"
+			kind = "ServiceAccount"
+		}
+		managed_resource {
+			id = "tableflow"
+			api_version = "tableflow/v1"
+			kind = "Tableflow"
+		}
+	}
+	`, mockServerUrl, tableflowApiKeyResourceLabel, tableflowApiKeyDisplayName, tableflowApiKeyDescription)
+}
+
+func testAccCheckIdentityPoolApiKeyConfig(mockServerUrl, identityPoolApiKeyResourceLabel, ownerId string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_api_key" "%s" {
+		display_name = "CI Identity Pool API Key"
+		description = "Identity Pool API Key"
+		owner {
+			id = "%s"
+			api_version = "iam/v2"
+			kind = "IdentityPool"
+		}
+		disable_wait_for_ready = true
+	}
+	`, mockServerUrl, identityPoolApiKeyResourceLabel, ownerId)
+}
+
 func testAccCheckApiKeyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -124,6 +124,12 @@ func TestAccBusinessMetadataBindingSrSchema(t *testing.T) {
 					resource.TestCheckResourceAttr(businessMetadataBindingLabel, fmt.Sprintf("%s.attr2", paramAttributes), "value2"),
 				),
 			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      businessMetadataBindingLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -166,6 +166,12 @@ func TestAccBusinessMetadata(t *testing.T) {
 					resource.TestCheckResourceAttr(businessMetadataLabel, fmt.Sprintf("%s.2.%s.applicableEntityTypes", paramAttributeDef, paramOptions), "[\"cf_entity\"]"),
 					resource.TestCheckResourceAttr(businessMetadataLabel, fmt.Sprintf("%s.2.%s.maxStrLength", paramAttributeDef, paramOptions), "5000")),
 			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      businessMetadataLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"net/http"
 	"regexp"
 )
 
@@ -108,8 +109,11 @@ func catalogEntityAttributesCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Creating new Entity Attributes: %s", createEntityAttributesRequestJson))
 
-	createdEntityAttributes, _, err := request.Execute()
+	createdEntityAttributes, resp, err := request.Execute()
 	if err != nil {
+		if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+			return diag.Errorf("error creating Entity Attributes %q: entity %q of type %q was not found, make sure it exists and has been synced to Stream Catalog: %s", entityAttributesId, entityName, entityType, createDescriptiveError(err))
+		}
 		return diag.Errorf("error creating Entity Attributes %s", createDescriptiveError(err))
 	}
 
@@ -0,0 +1,86 @@
+// Copyright 2023 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestAccCatalogEntityAttributesEntityNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	entityNotFoundResponse, _ := ioutil.ReadFile("../testdata/entity_attributes/entity_not_found.json")
+	createEntityAttributesStub := wiremock.Put(wiremock.URLPathEqualTo(createEntityAttributesUrlPath)).
+		WillReturn(
+			string(entityNotFoundResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		)
+	_ = wiremockClient.StubFor(createEntityAttributesStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config:      entityAttributesMissingEntityResourceConfig(mockServerUrl),
+				ExpectError: regexp.MustCompile("was not found"),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createEntityAttributesStub, fmt.Sprintf("PUT %s", createEntityAttributesUrlPath), expectedCountOne)
+}
+
+func entityAttributesMissingEntityResourceConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+ 	provider "confluent" {
+ 	  schema_registry_id = "xxx"
+	  schema_registry_rest_endpoint = "%s" # optionally use SCHEMA_REGISTRY_REST_ENDPOINT env var
+	  schema_registry_api_key       = "x"       # optionally use SCHEMA_REGISTRY_API_KEY env var
+	  schema_registry_api_secret = "x"
+ 	}
+ 	resource "confluent_catalog_entity_attributes" "main" {
+	  entity_name = "lkc-15xq83:topic_1"
+	  entity_type = "kafka_topic"
+	  attributes = {
+		"description": "test_des"
+	  }
+	}
+ 	`, mockServerUrl)
+}
@@ -0,0 +1,342 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramCertificateChain         = "certificate_chain"
+	paramCertificateChainFilename = "certificate_chain_filename"
+	paramFingerprints             = "fingerprints"
+	paramExpirationDates          = "expiration_dates"
+	paramSerialNumbers            = "serial_numbers"
+	paramCrlSource                = "crl_source"
+	paramCrlUrl                   = "crl_url"
+	paramCrlChain                 = "crl_chain"
+	paramCrlUpdatedAt             = "crl_updated_at"
+
+	certificateAuthoritiesPath = "/iam/v2/certificate-authorities"
+)
+
+// iamCertificateAuthority represents iam.v2.CertificateAuthority since ccloud-sdk-go-v2/iam doesn't support Certificate Authorities yet
+type iamCertificateAuthority struct {
+	ApiVersion               string   `json:"api_version,omitempty"`
+	Kind                     string   `json:"kind,omitempty"`
+	Id                       string   `json:"id,omitempty"`
+	DisplayName              string   `json:"display_name,omitempty"`
+	Description              string   `json:"description"`
+	CertificateChain         string   `json:"certificate_chain,omitempty"`
+	CertificateChainFilename string   `json:"certificate_chain_filename,omitempty"`
+	Fingerprints             []string `json:"fingerprints,omitempty"`
+	ExpirationDates          []string `json:"expiration_dates,omitempty"`
+	SerialNumbers            []string `json:"serial_numbers,omitempty"`
+	CrlSource                string   `json:"crl_source,omitempty"`
+	CrlUrl                   string   `json:"crl_url,omitempty"`
+	CrlChain                 string   `json:"crl_chain,omitempty"`
+	CrlUpdatedAt             string   `json:"crl_updated_at,omitempty"`
+}
+
+func certificateAuthorityResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: certificateAuthorityCreate,
+		ReadContext:   certificateAuthorityRead,
+		UpdateContext: certificateAuthorityUpdate,
+		DeleteContext: certificateAuthorityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: certificateAuthorityImport,
+		},
+		CustomizeDiff: certificateAuthorityCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			paramDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A human-readable name for the Certificate Authority.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A free-form description of the Certificate Authority.",
+			},
+			paramCertificateChain: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A PEM-encoded string containing the signing certificate chain.",
+				ValidateFunc: validateCertificateChain,
+			},
+			paramCertificateChainFilename: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the certificate file.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramCrlUrl: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramCrlChain},
+				Description:   "The URL from which to fetch the CRL (Certificate Revocation List).",
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+			},
+			paramCrlChain: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramCrlUrl},
+				Description:   "A PEM-encoded string containing the CRL for this Certificate Authority.",
+			},
+			paramCrlSource: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The source specifies whether the CRL is provided by a URL (`URL`) or a chain (`LOCAL`).",
+			},
+			paramCrlUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The timestamp for when the CRL was last updated.",
+			},
+			paramFingerprints: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SHA-1 fingerprints of the certificates in the certificate chain.",
+			},
+			paramExpirationDates: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The expiration dates of the certificates in the certificate chain.",
+			},
+			paramSerialNumbers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The serial numbers of the certificates in the certificate chain.",
+			},
+		},
+	}
+}
+
+// certificateAuthorityCustomizeDiff marks the attributes that describe the certificate chain as unknown
+// when the chain is updated since they're calculated by the server.
+func certificateAuthorityCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange(paramCertificateChain) {
+		return nil
+	}
+	for _, attribute := range []string{paramFingerprints, paramExpirationDates, paramSerialNumbers} {
+		if err := diff.SetNewComputed(attribute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func certificateAuthorityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	createCertificateAuthorityRequest := buildCertificateAuthority(d)
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Certificate Authority %q", createCertificateAuthorityRequest.DisplayName))
+
+	var createdCertificateAuthority iamCertificateAuthority
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPost, certificateAuthoritiesPath, createCertificateAuthorityRequest, &createdCertificateAuthority); err != nil {
+		return diag.Errorf("error creating Certificate Authority %q: %s", createCertificateAuthorityRequest.DisplayName, createDescriptiveError(err))
+	}
+	d.SetId(createdCertificateAuthority.Id)
+
+	createdCertificateAuthorityJson, err := json.Marshal(createdCertificateAuthority)
+	if err != nil {
+		return diag.Errorf("error creating Certificate Authority %q: error marshaling %#v to json: %s", d.Id(), createdCertificateAuthority, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Certificate Authority %q: %s", d.Id(), createdCertificateAuthorityJson), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+	return certificateAuthorityRead(ctx, d, meta)
+}
+
+func executeCertificateAuthorityRead(ctx context.Context, c *Client, certificateAuthorityId string) (iamCertificateAuthority, *http.Response, error) {
+	var certificateAuthority iamCertificateAuthority
+	resp, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodGet, certificateAuthorityPath(certificateAuthorityId), nil, &certificateAuthority)
+	return certificateAuthority, resp, err
+}
+
+func certificateAuthorityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	certificateAuthority, resp, err := executeCertificateAuthorityRead(ctx, c, d.Id())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Certificate Authority %q: %s", d.Id(), createDescriptiveError(err)), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Certificate Authority %q in TF state because Certificate Authority could not be found on the server", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	certificateAuthorityJson, err := json.Marshal(certificateAuthority)
+	if err != nil {
+		return diag.Errorf("error reading Certificate Authority %q: error marshaling %#v to json: %s", d.Id(), certificateAuthority, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Certificate Authority %q: %s", d.Id(), certificateAuthorityJson), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+	if _, err := setCertificateAuthorityAttributes(d, certificateAuthority); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+	return nil
+}
+
+func certificateAuthorityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	updateCertificateAuthorityRequest := buildCertificateAuthority(d)
+	tflog.Debug(ctx, fmt.Sprintf("Updating Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+	c := meta.(*Client)
+	var updatedCertificateAuthority iamCertificateAuthority
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPut, certificateAuthorityPath(d.Id()), updateCertificateAuthorityRequest, &updatedCertificateAuthority); err != nil {
+		return diag.Errorf("error updating Certificate Authority %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	updatedCertificateAuthorityJson, err := json.Marshal(updatedCertificateAuthority)
+	if err != nil {
+		return diag.Errorf("error updating Certificate Authority %q: error marshaling %#v to json: %s", d.Id(), updatedCertificateAuthority, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating Certificate Authority %q: %s", d.Id(), updatedCertificateAuthorityJson), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+	return certificateAuthorityRead(ctx, d, meta)
+}
+
+func certificateAuthorityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodDelete, certificateAuthorityPath(d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting Certificate Authority %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+
+	return nil
+}
+
+func certificateAuthorityImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if diagnostics := certificateAuthorityRead(ctx, d, meta); diagnostics != nil {
+		return nil, fmt.Errorf("error importing Certificate Authority %q: %s", d.Id(), diagnostics[0].Summary)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Certificate Authority %q", d.Id()), map[string]interface{}{certificateAuthorityLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildCertificateAuthority creates a request body, certificate_chain and crl_chain are sent base64-encoded
+func buildCertificateAuthority(d *schema.ResourceData) *iamCertificateAuthority {
+	certificateAuthority := &iamCertificateAuthority{
+		DisplayName:              d.Get(paramDisplayName).(string),
+		Description:              d.Get(paramDescription).(string),
+		CertificateChain:         base64.StdEncoding.EncodeToString([]byte(d.Get(paramCertificateChain).(string))),
+		CertificateChainFilename: d.Get(paramCertificateChainFilename).(string),
+		CrlUrl:                   d.Get(paramCrlUrl).(string),
+	}
+	if crlChain := d.Get(paramCrlChain).(string); crlChain != "" {
+		certificateAuthority.CrlChain = base64.StdEncoding.EncodeToString([]byte(crlChain))
+	}
+	return certificateAuthority
+}
+
+// setCertificateAuthorityAttributes doesn't set certificate_chain and crl_chain attributes since they're not returned by the server
+func setCertificateAuthorityAttributes(d *schema.ResourceData, certificateAuthority iamCertificateAuthority) (*schema.ResourceData, error) {
+	if err := d.Set(paramDisplayName, certificateAuthority.DisplayName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramDescription, certificateAuthority.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramCertificateChainFilename, certificateAuthority.CertificateChainFilename); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramFingerprints, certificateAuthority.Fingerprints); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramExpirationDates, certificateAuthority.ExpirationDates); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramSerialNumbers, certificateAuthority.SerialNumbers); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramCrlSource, certificateAuthority.CrlSource); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramCrlUrl, certificateAuthority.CrlUrl); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramCrlUpdatedAt, certificateAuthority.CrlUpdatedAt); err != nil {
+		return nil, err
+	}
+	d.SetId(certificateAuthority.Id)
+	return d, nil
+}
+
+func certificateAuthorityPath(certificateAuthorityId string) string {
+	return fmt.Sprintf("%s/%s", certificateAuthoritiesPath, url.PathEscape(certificateAuthorityId))
+}
+
+// parseCertificateChain parses PEM-encoded certificates, it returns an error if there are none or any of them is invalid
+func parseCertificateChain(certificateChain string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	rest := []byte(certificateChain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block type %q, expected %q", block.Type, "CERTIFICATE")
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no PEM-encoded certificates were found")
+	}
+	return certificates, nil
+}
+
+func validateCertificateChain(i interface{}, k string) (_ []string, errors []error) {
+	if _, err := parseCertificateChain(i.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a PEM-encoded certificate chain: %s", k, err))
+	}
+	return nil, errors
+}
@@ -0,0 +1,279 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramCertificateAuthority = "certificate_authority"
+	paramExternalIdentifier   = "external_identifier"
+)
+
+// iamCertificatePool represents iam.v2.CertificateIdentityPool since ccloud-sdk-go-v2/iam doesn't support Certificate Pools yet
+type iamCertificatePool struct {
+	ApiVersion         string `json:"api_version,omitempty"`
+	Kind               string `json:"kind,omitempty"`
+	Id                 string `json:"id,omitempty"`
+	DisplayName        string `json:"display_name,omitempty"`
+	Description        string `json:"description"`
+	ExternalIdentifier string `json:"external_identifier,omitempty"`
+	Filter             string `json:"filter,omitempty"`
+}
+
+func certificatePoolResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: certificatePoolCreate,
+		ReadContext:   certificatePoolRead,
+		UpdateContext: certificatePoolUpdate,
+		DeleteContext: certificatePoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: certificatePoolImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramCertificateAuthority: certificateAuthoritySchema(),
+			paramDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A name for the Certificate Pool.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A description of the Certificate Pool.",
+			},
+			paramExternalIdentifier: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The certificate field that will be used to represent the pool's external identity for audit logging.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			paramFilter: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A filter expression (CEL) in Supported Common Expression Language that specifies which certificates can authenticate using the Certificate Pool.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func certificatePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+	certificateAuthorityId := extractStringValueFromBlock(d, paramCertificateAuthority, paramId)
+
+	createCertificatePoolRequest := buildCertificatePool(d)
+	createCertificatePoolRequestJson, err := json.Marshal(createCertificatePoolRequest)
+	if err != nil {
+		return diag.Errorf("error creating Certificate Pool: error marshaling %#v to json: %s", createCertificatePoolRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Certificate Pool: %s", createCertificatePoolRequestJson))
+
+	var createdCertificatePool iamCertificatePool
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPost, certificatePoolsPath(certificateAuthorityId), createCertificatePoolRequest, &createdCertificatePool); err != nil {
+		return diag.Errorf("error creating Certificate Pool %q: %s", createCertificatePoolRequest.DisplayName, createDescriptiveError(err))
+	}
+	d.SetId(createdCertificatePool.Id)
+
+	createdCertificatePoolJson, err := json.Marshal(createdCertificatePool)
+	if err != nil {
+		return diag.Errorf("error creating Certificate Pool %q: error marshaling %#v to json: %s", d.Id(), createdCertificatePool, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Certificate Pool %q: %s", d.Id(), createdCertificatePoolJson), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	return certificatePoolRead(ctx, d, meta)
+}
+
+func executeCertificatePoolRead(ctx context.Context, c *Client, certificateAuthorityId, certificatePoolId string) (iamCertificatePool, *http.Response, error) {
+	var certificatePool iamCertificatePool
+	resp, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodGet, certificatePoolPath(certificateAuthorityId, certificatePoolId), nil, &certificatePool)
+	return certificatePool, resp, err
+}
+
+func certificatePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Certificate Pool %q", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	certificateAuthorityId := extractStringValueFromBlock(d, paramCertificateAuthority, paramId)
+	if _, err := readCertificatePoolAndSetAttributes(ctx, d, meta, certificateAuthorityId, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Certificate Pool %q: %s", d.Id(), createDescriptiveError(err)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Certificate Pool %q", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	return nil
+}
+
+func readCertificatePoolAndSetAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, certificateAuthorityId, certificatePoolId string) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	certificatePool, resp, err := executeCertificatePoolRead(ctx, c, certificateAuthorityId, certificatePoolId)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Certificate Pool %q: %s", certificatePoolId, createDescriptiveError(err)), map[string]interface{}{certificatePoolLoggingKey: certificatePoolId})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Certificate Pool %q in TF state because Certificate Pool could not be found on the server", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+			d.SetId("")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	certificatePoolJson, err := json.Marshal(certificatePool)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Certificate Pool %q: error marshaling %#v to json: %s", certificatePoolId, certificatePool, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Certificate Pool %q: %s", certificatePoolId, certificatePoolJson), map[string]interface{}{certificatePoolLoggingKey: certificatePoolId})
+
+	if _, err := setCertificatePoolAttributes(d, certificatePool, certificateAuthorityId); err != nil {
+		return nil, createDescriptiveError(err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func certificatePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	updateCertificatePoolRequest := buildCertificatePool(d)
+	updateCertificatePoolRequestJson, err := json.Marshal(updateCertificatePoolRequest)
+	if err != nil {
+		return diag.Errorf("error updating Certificate Pool %q: error marshaling %#v to json: %s", d.Id(), updateCertificatePoolRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updating Certificate Pool %q: %s", d.Id(), updateCertificatePoolRequestJson), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	c := meta.(*Client)
+	certificateAuthorityId := extractStringValueFromBlock(d, paramCertificateAuthority, paramId)
+	var updatedCertificatePool iamCertificatePool
+	resp, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPut, certificatePoolPath(certificateAuthorityId, d.Id()), updateCertificatePoolRequest, &updatedCertificatePool)
+	if err != nil {
+		if ResponseHasExpectedStatusCode(resp, http.StatusBadRequest) {
+			return diag.Errorf("error updating Certificate Pool %q: the request was rejected, check that %q = %q and %q = %q are valid: %s", d.Id(), paramExternalIdentifier, updateCertificatePoolRequest.ExternalIdentifier, paramFilter, updateCertificatePoolRequest.Filter, createDescriptiveError(err))
+		}
+		return diag.Errorf("error updating Certificate Pool %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	updatedCertificatePoolJson, err := json.Marshal(updatedCertificatePool)
+	if err != nil {
+		return diag.Errorf("error updating Certificate Pool %q: error marshaling %#v to json: %s", d.Id(), updatedCertificatePool, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating Certificate Pool %q: %s", d.Id(), updatedCertificatePoolJson), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	return certificatePoolRead(ctx, d, meta)
+}
+
+func certificatePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Certificate Pool %q", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+	c := meta.(*Client)
+	certificateAuthorityId := extractStringValueFromBlock(d, paramCertificateAuthority, paramId)
+
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodDelete, certificatePoolPath(certificateAuthorityId, d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting Certificate Pool %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Certificate Pool %q", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	return nil
+}
+
+func certificatePoolImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Certificate Pool %q", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+
+	certificateAuthorityIdAndCertificatePoolId := d.Id()
+	parts := strings.Split(certificateAuthorityIdAndCertificatePoolId, "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("error importing Certificate Pool: invalid format: expected '<certificate authority ID>/<certificate pool ID>'")
+	}
+
+	certificateAuthorityId := parts[0]
+	certificatePoolId := parts[1]
+	d.SetId(certificatePoolId)
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readCertificatePoolAndSetAttributes(ctx, d, meta, certificateAuthorityId, certificatePoolId); err != nil {
+		return nil, fmt.Errorf("error importing Certificate Pool %q: %s", d.Id(), err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Certificate Pool %q", d.Id()), map[string]interface{}{certificatePoolLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildCertificatePool(d *schema.ResourceData) *iamCertificatePool {
+	return &iamCertificatePool{
+		DisplayName:        d.Get(paramDisplayName).(string),
+		Description:        d.Get(paramDescription).(string),
+		ExternalIdentifier: d.Get(paramExternalIdentifier).(string),
+		Filter:             d.Get(paramFilter).(string),
+	}
+}
+
+func setCertificatePoolAttributes(d *schema.ResourceData, certificatePool iamCertificatePool, certificateAuthorityId string) (*schema.ResourceData, error) {
+	if err := d.Set(paramDisplayName, certificatePool.DisplayName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramDescription, certificatePool.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramExternalIdentifier, certificatePool.ExternalIdentifier); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramFilter, certificatePool.Filter); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramCertificateAuthority, paramId, certificateAuthorityId, d); err != nil {
+		return nil, err
+	}
+	d.SetId(certificatePool.Id)
+	return d, nil
+}
+
+func certificatePoolsPath(certificateAuthorityId string) string {
+	return fmt.Sprintf("%s/identity-pools", certificateAuthorityPath(certificateAuthorityId))
+}
+
+func certificatePoolPath(certificateAuthorityId, certificatePoolId string) string {
+	return fmt.Sprintf("%s/%s", certificatePoolsPath(certificateAuthorityId), url.PathEscape(certificatePoolId))
+}
+
+func certificateAuthoritySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		MinItems:    1,
+		MaxItems:    1,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The Certificate Authority the Certificate Pool belongs to.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramId: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The unique identifier for the Certificate Authority.",
+				},
+			},
+		},
+	}
+}
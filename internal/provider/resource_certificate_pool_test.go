@@ -0,0 +1,213 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateCertificatePoolHasBeenCreated = "The new Certificate Pool has been just created"
+	scenarioStateCertificatePoolHasBeenUpdated = "The new Certificate Pool has been updated"
+	scenarioStateCertificatePoolHasBeenDeleted = "The new Certificate Pool has been deleted"
+	certificatePoolScenarioName                = "confluent_certificate_pool Resource Lifecycle"
+
+	certificatePoolId                 = "pool-def456"
+	certificatePoolDisplayName        = "my-certificate-pool"
+	certificatePoolDescription        = "Certificate Pool for Kafka clients"
+	certificatePoolExternalIdentifier = "CN"
+	certificatePoolFilter             = `CN == "kafka-client"`
+	certificatePoolUpdatedFilter      = `CN == "kafka-client" && O == "Confluent"`
+	certificatePoolResourceLabel      = "test_certificate_pool_resource_label"
+)
+
+var certificatePoolsUrlPath = fmt.Sprintf("/iam/v2/certificate-authorities/%s/identity-pools", certificateAuthorityId)
+var certificatePoolUrlPath = fmt.Sprintf("%s/%s", certificatePoolsUrlPath, certificatePoolId)
+
+func TestAccCertificatePool(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createCertificatePoolResponse, _ := ioutil.ReadFile("../testdata/certificate_pool/create_certificate_pool.json")
+	createCertificatePoolStub := wiremock.Post(wiremock.URLPathEqualTo(certificatePoolsUrlPath)).
+		InScenario(certificatePoolScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(`"external_identifier":"CN"`)).
+		WillSetStateTo(scenarioStateCertificatePoolHasBeenCreated).
+		WillReturn(
+			string(createCertificatePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createCertificatePoolStub)
+
+	readCreatedCertificatePoolResponse, _ := ioutil.ReadFile("../testdata/certificate_pool/read_created_certificate_pool.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(certificatePoolUrlPath)).
+		InScenario(certificatePoolScenarioName).
+		WhenScenarioStateIs(scenarioStateCertificatePoolHasBeenCreated).
+		WillReturn(
+			string(readCreatedCertificatePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedCertificatePoolResponse, _ := ioutil.ReadFile("../testdata/certificate_pool/read_updated_certificate_pool.json")
+	updateCertificatePoolStub := wiremock.Put(wiremock.URLPathEqualTo(certificatePoolUrlPath)).
+		InScenario(certificatePoolScenarioName).
+		WhenScenarioStateIs(scenarioStateCertificatePoolHasBeenCreated).
+		WithBodyPattern(wiremock.Contains(`O == \"Confluent\"`)).
+		WillSetStateTo(scenarioStateCertificatePoolHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedCertificatePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateCertificatePoolStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(certificatePoolUrlPath)).
+		InScenario(certificatePoolScenarioName).
+		WhenScenarioStateIs(scenarioStateCertificatePoolHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedCertificatePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedCertificatePoolResponse, _ := ioutil.ReadFile("../testdata/certificate_pool/read_deleted_certificate_pool.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(certificatePoolUrlPath)).
+		InScenario(certificatePoolScenarioName).
+		WhenScenarioStateIs(scenarioStateCertificatePoolHasBeenDeleted).
+		WillReturn(
+			string(readDeletedCertificatePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteCertificatePoolStub := wiremock.Delete(wiremock.URLPathEqualTo(certificatePoolUrlPath)).
+		InScenario(certificatePoolScenarioName).
+		WhenScenarioStateIs(scenarioStateCertificatePoolHasBeenUpdated).
+		WillSetStateTo(scenarioStateCertificatePoolHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteCertificatePoolStub)
+
+	fullCertificatePoolResourceLabel := fmt.Sprintf("confluent_certificate_pool.%s", certificatePoolResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCertificatePoolDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCertificatePoolConfig(mockServerUrl, certificatePoolFilter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "id", certificatePoolId),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "certificate_authority.#", "1"),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "certificate_authority.0.id", certificateAuthorityId),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "display_name", certificatePoolDisplayName),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "description", certificatePoolDescription),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "external_identifier", certificatePoolExternalIdentifier),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "filter", certificatePoolFilter),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullCertificatePoolResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.RootModule().Resources
+					poolId := resources[fullCertificatePoolResourceLabel].Primary.ID
+					certificateAuthorityId := resources[fullCertificatePoolResourceLabel].Primary.Attributes["certificate_authority.0.id"]
+					return certificateAuthorityId + "/" + poolId, nil
+				},
+			},
+			{
+				Config: testAccCheckCertificatePoolConfig(mockServerUrl, certificatePoolUpdatedFilter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "id", certificatePoolId),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "certificate_authority.0.id", certificateAuthorityId),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "external_identifier", certificatePoolExternalIdentifier),
+					resource.TestCheckResourceAttr(fullCertificatePoolResourceLabel, "filter", certificatePoolUpdatedFilter),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createCertificatePoolStub, fmt.Sprintf("POST %s", certificatePoolsUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateCertificatePoolStub, fmt.Sprintf("PUT %s", certificatePoolUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteCertificatePoolStub, fmt.Sprintf("DELETE %s", certificatePoolUrlPath), expectedCountOne)
+}
+
+func testAccCheckCertificatePoolDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each Certificate Pool is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_certificate_pool" {
+			continue
+		}
+		deletedCertificatePool, response, err := executeCertificatePoolRead(context.Background(), c, rs.Primary.Attributes["certificate_authority.0.id"], rs.Primary.ID)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedCertificatePool.Id == rs.Primary.ID {
+			return fmt.Errorf("Certificate Pool (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckCertificatePoolConfig(mockServerUrl, filter string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_certificate_pool" "%s" {
+		certificate_authority {
+			id = "%s"
+		}
+		display_name        = "%s"
+		description         = "%s"
+		external_identifier = "%s"
+		filter              = %q
+	}
+	`, mockServerUrl, certificatePoolResourceLabel, certificateAuthorityId, certificatePoolDisplayName, certificatePoolDescription, certificatePoolExternalIdentifier, filter)
+}
@@ -21,6 +21,7 @@ import (
 	v3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -142,9 +143,25 @@ func clusterLinkResource() *schema.Resource {
 				ValidateDiagFunc: clusterLinkSettingsKeysValidate,
 			},
 		},
+		CustomizeDiff: customdiff.Sequence(resourceClusterLinkCustomizeDiff),
 	}
 }
 
+// resourceClusterLinkCustomizeDiff validates the fields required by link_mode and connection_mode at plan time
+// instead of failing in the middle of an apply.
+func resourceClusterLinkCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// Only validate new Cluster Links since imported ones may not have all of the fields set
+	if diff.Id() != "" {
+		return nil
+	}
+	linkMode := diff.Get(paramLinkMode).(string)
+	connectionMode := diff.Get(paramConnectionMode).(string)
+	if err := validateClusterLinkInputByLinkModeAndConnectionMode(diff, linkMode, connectionMode); err != nil {
+		return fmt.Errorf("error validating Cluster Link: %s", err)
+	}
+	return nil
+}
+
 func clusterLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// TODO: update validateClusterLinkInput to accept BIDIRECTIONAL link mode
 	err := validateClusterLinkInput(d)
@@ -448,7 +465,7 @@ func clusterLinkDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error deleting Cluster Link %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForClusterLinkToBeDeleted(kafkaRestClient.apiContext(ctx), kafkaRestClient, linkName, meta.(*Client).isAcceptanceTestMode); err != nil {
+	if err := waitForClusterLinkToBeDeleted(ctx, kafkaRestClient, linkName, meta.(*Client).isAcceptanceTestMode); err != nil {
 		return diag.Errorf("error waiting for Cluster Link %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -835,42 +852,66 @@ func extractRemoteClusterApiKeyAndApiSecret() (string, string) {
 	return clusterApiKey, clusterApiSecret
 }
 
-func validateClusterLinkInputByLinkModeAndConnectionMode(d *schema.ResourceData, linkMode, connectionMode string) error {
+// clusterLinkAttributeGetter is implemented by both *schema.ResourceData and *schema.ResourceDiff
+type clusterLinkAttributeGetter interface {
+	Get(key string) interface{}
+}
+
+// isClusterLinkAttributeUnknown returns true for attributes that are unknown during plan
+// (e.g., an endpoint of a cluster that is created in the same apply).
+func isClusterLinkAttributeUnknown(d clusterLinkAttributeGetter, key string) bool {
+	diff, ok := d.(*schema.ResourceDiff)
+	return ok && !diff.NewValueKnown(key)
+}
+
+func isClusterLinkStringAttributeMissing(d clusterLinkAttributeGetter, key string) bool {
+	return !isClusterLinkAttributeUnknown(d, key) && d.Get(key).(string) == ""
+}
+
+func isClusterLinkBlockMissing(d clusterLinkAttributeGetter, blockCountKey string) bool {
+	return !isClusterLinkAttributeUnknown(d, blockCountKey) && d.Get(blockCountKey).(int) == 0
+}
+
+func isClusterLinkBlockPresent(d clusterLinkAttributeGetter, blockCountKey string) bool {
+	return !isClusterLinkAttributeUnknown(d, blockCountKey) && d.Get(blockCountKey).(int) != 0
+}
+
+func validateClusterLinkInputByLinkModeAndConnectionMode(d clusterLinkAttributeGetter, linkMode, connectionMode string) error {
 	if linkMode == linkModeBidirectional {
-		if d.Get(localKafkaCredentialsBlockKey).(int) == 0 {
+		if isClusterLinkBlockMissing(d, localKafkaCredentialsBlockKey) {
 			return fmt.Errorf("%q must be specified for %q", paramCredentials, paramLocalKafkaCluster)
 		}
 		// Expect
 		// * bootstrap_endpoint to be specified for a remote cluster
 		// * rest_endpoint to be specified for a local cluster
-		if d.Get(fmt.Sprintf("%s.0.%s", paramRemoteKafkaCluster, paramBootStrapEndpoint)).(string) == "" {
+		if isClusterLinkStringAttributeMissing(d, fmt.Sprintf("%s.0.%s", paramRemoteKafkaCluster, paramBootStrapEndpoint)) {
 			return fmt.Errorf("%q must be specified for %q", paramBootStrapEndpoint, paramRemoteKafkaCluster)
 		}
-		if d.Get(fmt.Sprintf("%s.0.%s", paramLocalKafkaCluster, paramRestEndpoint)).(string) == "" {
+		if isClusterLinkStringAttributeMissing(d, fmt.Sprintf("%s.0.%s", paramLocalKafkaCluster, paramRestEndpoint)) {
 			return fmt.Errorf("%q must be specified for %q", paramRestEndpoint, paramLocalKafkaCluster)
 		}
 		return nil
 	}
 
-	if d.Get(destinationKafkaCredentialsBlockKey).(int) == 0 {
+	if isClusterLinkBlockMissing(d, destinationKafkaCredentialsBlockKey) {
 		return fmt.Errorf("%q must be specified for %q", paramCredentials, paramDestinationKafkaCluster)
 	}
 	if linkMode == linkModeDestination {
 		// Expect
 		// * bootstrap_endpoint to be specified for a source cluster
 		// * rest_endpoint to be specified for a destination cluster
-		if d.Get(fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramBootStrapEndpoint)).(string) == "" {
+		if isClusterLinkStringAttributeMissing(d, fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramBootStrapEndpoint)) {
 			return fmt.Errorf("%q must be specified for %q", paramBootStrapEndpoint, paramSourceKafkaCluster)
 		}
-		if d.Get(fmt.Sprintf("%s.0.%s", paramDestinationKafkaCluster, paramRestEndpoint)).(string) == "" {
+		if isClusterLinkStringAttributeMissing(d, fmt.Sprintf("%s.0.%s", paramDestinationKafkaCluster, paramRestEndpoint)) {
 			return fmt.Errorf("%q must be specified for %q", paramRestEndpoint, paramDestinationKafkaCluster)
 		}
 		if connectionMode == connectionModeOutbound {
-			if d.Get(sourceKafkaCredentialsBlockKey).(int) == 0 {
+			if isClusterLinkBlockMissing(d, sourceKafkaCredentialsBlockKey) {
 				return fmt.Errorf("%q must be specified for %q", paramCredentials, paramSourceKafkaCluster)
 			}
 		} else {
-			if d.Get(sourceKafkaCredentialsBlockKey).(int) != 0 {
+			if isClusterLinkBlockPresent(d, sourceKafkaCredentialsBlockKey) {
 				return fmt.Errorf("%q must not be specified for %q", paramCredentials, paramSourceKafkaCluster)
 			}
 		}
@@ -879,13 +920,13 @@ func validateClusterLinkInputByLinkModeAndConnectionMode(d *schema.ResourceData,
 			// Expect
 			// * rest_endpoint to be specified for a source cluster
 			// * bootstrap_endpoint to be specified for a destination cluster
-			if d.Get(fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramRestEndpoint)).(string) == "" {
+			if isClusterLinkStringAttributeMissing(d, fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramRestEndpoint)) {
 				return fmt.Errorf("%q must be specified for %q", paramRestEndpoint, paramSourceKafkaCluster)
 			}
-			if d.Get(fmt.Sprintf("%s.0.%s", paramDestinationKafkaCluster, paramBootStrapEndpoint)).(string) == "" {
+			if isClusterLinkStringAttributeMissing(d, fmt.Sprintf("%s.0.%s", paramDestinationKafkaCluster, paramBootStrapEndpoint)) {
 				return fmt.Errorf("%q must be specified for %q", paramBootStrapEndpoint, paramDestinationKafkaCluster)
 			}
-			if d.Get(sourceKafkaCredentialsBlockKey).(int) == 0 {
+			if isClusterLinkBlockMissing(d, sourceKafkaCredentialsBlockKey) {
 				return fmt.Errorf("%q must be specified for %q", paramCredentials, paramSourceKafkaCluster)
 			}
 		} else {
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -188,6 +189,12 @@ func TestAccClusterLinkDestinationOutbound(t *testing.T) {
 		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
 		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
 		Steps: []resource.TestStep{
+			{
+				// Fields required by the link mode are validated during plan
+				Config:      testAccCheckClusterLinkDestinationOutboundConfigWithoutSourceCredentials(confluentCloudBaseUrl, mockClusterLinkTestServerUrl),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"credentials" must be specified for "source_kafka_cluster"`),
+			},
 			{
 				Config: testAccCheckClusterLinkDestinationOutboundConfig(confluentCloudBaseUrl, mockClusterLinkTestServerUrl),
 				Check: resource.ComposeTestCheckFunc(
@@ -311,6 +318,35 @@ func testAccCheckClusterLinkDestinationOutboundConfig(confluentCloudBaseUrl, moc
 		firstClusterClusterLinkConfigName, firstClusterClusterLinkConfigValue)
 }
 
+func testAccCheckClusterLinkDestinationOutboundConfigWithoutSourceCredentials(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_cluster_link" "%s" {
+	  link_name = "%s"
+      link_mode = "%s"
+      connection_mode = "%s"
+	  source_kafka_cluster {
+        id = "%s"
+        bootstrap_endpoint = "%s"
+      }
+
+	  destination_kafka_cluster {
+        id = "%s"
+        rest_endpoint = "%s"
+        credentials {
+		  key = "%s"
+		  secret = "%s"
+	    }
+      }
+	}
+	`, confluentCloudBaseUrl, clusterLinkResourceLabel,
+		clusterLinkName, linkModeDestination, connectionModeOutbound,
+		sourceClusterId, sourceClusterBootstrapEndpoint,
+		destinationClusterId, mockServerUrl, destinationClusterApiKey, destinationClusterApiSecret)
+}
+
 func testAccCheckClusterLinkDestinationOutboundConfigUpdated(confluentCloudBaseUrl, mockServerUrl string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
@@ -16,14 +16,21 @@ package provider
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	connect "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/samber/lo"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -50,6 +57,21 @@ const (
 	paramStatus   = "status"
 	statePaused   = "PAUSED"
 	stateDegraded = "DEGRADED"
+
+	paramOffsets   = "offsets"
+	paramPartition = "partition"
+	paramOffset    = "offset"
+
+	paramValidateConfig             = "validate_config"
+	paramValidateConfigDefaultValue = true
+
+	paramIgnoredConfigKeys = "ignored_config_keys"
+
+	paramRedactSensitiveConfig = "redact_sensitive_config"
+	paramRedactionKey          = "redaction_key"
+	// The prefix of the digests that are stored in TF state instead of sensitive config values when paramRedactSensitiveConfig is set
+	redactedConnectorConfigValuePrefix = "hmac-sha256:"
+	redactionKeyLength                 = 32
 )
 
 var connectorConfigFullAttributeName = fmt.Sprintf("%s.name", paramNonSensitiveConfig)
@@ -78,9 +100,11 @@ func connectorResource() *schema.Resource {
 			paramEnvironment:  environmentSchema(),
 			paramKafkaCluster: requiredKafkaClusterBlockSchema(),
 			paramStatus: {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The desired status of the Connector: `RUNNING` or `PAUSED`.",
+				ValidateFunc: validation.StringInSlice([]string{stateRunning, statePaused}, false),
 			},
 			paramNonSensitiveConfig: {
 				Type: schema.TypeMap,
@@ -95,23 +119,134 @@ func connectorResource() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				Sensitive:        true,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         false,
+				Description:      "The sensitive configuration settings to set (e.g., `\"gcs.credentials.config\" = \"**REDACTED***\"`). Should not be set for an import operation.",
+				DiffSuppressFunc: suppressRedactedConnectorConfigDiff,
+			},
+			paramRedactSensitiveConfig: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the HMAC-SHA256 digests of the sensitive configuration settings should be stored in TF state instead of their values.",
+			},
+			paramRedactionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
 				Sensitive:   true,
+				Description: "The random key of the Connector the digests of the redacted sensitive configuration settings are computed with.",
+			},
+			paramValidateConfig: {
+				Type:        schema.TypeBool,
 				Optional:    true,
+				Default:     paramValidateConfigDefaultValue,
+				Description: "Whether the Connector config should be validated against the connector plugin during `terraform plan`.",
+			},
+			paramIgnoredConfigKeys: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The names of the server-managed configuration settings whose changes on the server shouldn't be reported as drift.",
+			},
+			paramOffsets: {
+				Type:        schema.TypeList,
 				Computed:    true,
-				ForceNew:    false,
-				Description: "The sensitive configuration settings to set (e.g., `\"gcs.credentials.config\" = \"**REDACTED***\"`). Should not be set for an import operation.",
+				Description: "The current offsets of the Connector.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramPartition: {
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed:    true,
+							Description: "The partition the offset belongs to, for example, `kafka_topic` and `kafka_partition` for sink connectors.",
+						},
+						paramOffset: {
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed:    true,
+							Description: "The offset within the partition, for example, `kafka_offset` for sink connectors.",
+						},
+					},
+				},
 			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(connectAPICreateTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff, resourceConnectorCustomizeDiff),
+	}
+}
+
+func resourceConnectorCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get(paramValidateConfig).(bool) {
+		return nil
+	}
+	// Skip existing connectors whose configs are not going to be updated
+	if diff.Id() != "" && !diff.HasChanges(paramNonSensitiveConfig, paramSensitiveConfig) {
+		return nil
+	}
+	// Skip the validation when some of the inputs are known only after apply, for example,
+	// when the Kafka cluster is created in the same run
+	c := meta.(*Client)
+	environmentId, ok := extractEnvironmentIdFromDiff(c, diff)
+	if !ok {
+		return nil
+	}
+	clusterIdKey := fmt.Sprintf("%s.0.%s", paramKafkaCluster, paramId)
+	for _, key := range []string{clusterIdKey, paramNonSensitiveConfig, paramSensitiveConfig} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+	if !isConnectorConfigWhollyKnown(diff) {
+		return nil
+	}
+	sensitiveConfig := convertToStringStringMap(diff.Get(paramSensitiveConfig).(map[string]interface{}))
+	nonsensitiveConfig := convertToStringStringMap(diff.Get(paramNonSensitiveConfig).(map[string]interface{}))
+	mergedConfig := lo.Assign(nonsensitiveConfig, sensitiveConfig)
+	for _, value := range mergedConfig {
+		// The values of redacted sensitive settings are not available during plan
+		if isRedactedConnectorConfigValue(value) {
+			return nil
+		}
+	}
+
+	// Display the config errors during `terraform plan` instead of failing during `terraform apply`
+	clusterId := diff.Get(clusterIdKey).(string)
+	if err := validateConnectorConfig(c.connectApiContext(ctx), c, mergedConfig, environmentId, clusterId); err != nil {
+		return fmt.Errorf("error validating Connector config: %s. Set %q to false to skip this validation during plan", createDescriptiveError(err), paramValidateConfig)
+	}
+	return nil
+}
+
+// isConnectorConfigWhollyKnown checks the raw config since config keys contain dots,
+// so diff.NewValueKnown can't address the values of the config maps
+func isConnectorConfigWhollyKnown(diff *schema.ResourceDiff) bool {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() {
+		return true
 	}
+	if !rawConfig.IsKnown() {
+		return false
+	}
+	return rawConfig.GetAttr(paramNonSensitiveConfig).IsWhollyKnown() && rawConfig.GetAttr(paramSensitiveConfig).IsWhollyKnown()
 }
 
 func connectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Connector: %s", createDescriptiveError(err))
+	}
 	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
 
 	mergedConfig, sensitiveConfig, nonsensitiveConfig := extractConnectorConfigs(d)
@@ -131,7 +266,7 @@ func connectorCreate(ctx context.Context, d *schema.ResourceData, meta interface
 
 	err = validateConnectorConfig(c.connectApiContext(ctx), c, mergedConfig, environmentId, clusterId)
 	if err != nil {
-		return diag.Errorf("error creating Connector: %s", createDescriptiveError(err))
+		return diag.Errorf("error creating Connector: error validating config: %s", createDescriptiveError(err))
 	}
 
 	createdConnector, _, err := executeConnectorCreate(c.connectApiContext(ctx), c, environmentId, clusterId, createConnectorRequest)
@@ -146,16 +281,30 @@ func connectorCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 	d.SetId(createdConnectorWithId.Id.GetId())
 
-	if err := waitForConnectorToProvision(c.connectApiContext(ctx), c, displayName, environmentId, clusterId); err != nil {
+	if err := waitForConnectorToProvision(ctx, c, displayName, environmentId, clusterId); err != nil {
 		return diag.Errorf("error waiting for Connector %q to provision: %s", displayName, createDescriptiveError(err))
 	}
 
+	// Connectors are always created in the RUNNING state
+	if status := d.Get(paramStatus).(string); status == statePaused {
+		if err := updateConnectorStatus(ctx, c, d.Id(), displayName, environmentId, clusterId, stateRunning, status); err != nil {
+			return diag.Errorf("error creating Connector %q: %s", displayName, createDescriptiveError(err))
+		}
+	}
+
 	_, err = json.Marshal(createdConnector)
 	if err != nil {
 		return diag.Errorf("error creating Connector %q: error marshaling %#v to json: %s", d.Id(), createdConnector, createDescriptiveError(err))
 	}
 
 	// Save sensitive configs
+	if d.Get(paramRedactSensitiveConfig).(bool) {
+		redactionKey, err := ensureConnectorRedactionKey(d)
+		if err != nil {
+			return diag.Errorf("error creating Connector %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		sensitiveConfig = redactSensitiveConnectorConfigs(redactionKey, sensitiveConfig)
+	}
 	if err := d.Set(paramSensitiveConfig, sensitiveConfig); err != nil {
 		return diag.FromErr(createDescriptiveError(err))
 	}
@@ -165,6 +314,33 @@ func connectorCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	return connectorRead(ctx, d, meta)
 }
 
+// updateConnectorStatus pauses or resumes a connector and waits for it to reach the target status
+func updateConnectorStatus(ctx context.Context, c *Client, connectorId, displayName, environmentId, clusterId, oldStatus, newStatus string) error {
+	shouldPauseConnector := (oldStatus == stateRunning) && (newStatus == statePaused)
+	shouldResumeConnector := (oldStatus == statePaused) && (newStatus == stateRunning)
+	if shouldPauseConnector {
+		tflog.Debug(ctx, fmt.Sprintf("Pausing Connector %q", connectorId), map[string]interface{}{connectorLoggingKey: connectorId})
+
+		req := c.connectClient.LifecycleV1Api.PauseConnectv1Connector(c.connectApiContext(ctx), displayName, environmentId, clusterId)
+		if _, err := req.Execute(); err != nil {
+			return err
+		}
+	} else if shouldResumeConnector {
+		tflog.Debug(ctx, fmt.Sprintf("Resuming Connector %q", connectorId), map[string]interface{}{connectorLoggingKey: connectorId})
+
+		req := c.connectClient.LifecycleV1Api.ResumeConnectv1Connector(c.connectApiContext(ctx), displayName, environmentId, clusterId)
+		if _, err := req.Execute(); err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("only %q->%q or %q->%q transitions are supported but %q->%q was attempted", statePaused, stateRunning, stateRunning, statePaused, oldStatus, newStatus)
+	}
+	if err := waitForConnectorToChangeStatus(ctx, c, displayName, environmentId, clusterId, oldStatus, newStatus); err != nil {
+		return fmt.Errorf("error waiting for Connector %q to be updated: %s", connectorId, createDescriptiveError(err))
+	}
+	return nil
+}
+
 func validateConnectorConfig(ctx context.Context, c *Client, config map[string]string, environmentId, clusterId string) error {
 	// defaults to MANAGED
 	connectorType := config[connectorConfigAttributeType]
@@ -173,23 +349,23 @@ func validateConnectorConfig(ctx context.Context, c *Client, config map[string]s
 		// connectorConfigAttributeClass is required for managed connectors
 		connectorClass := config[connectorConfigAttributeClass]
 		if connectorClass == "" {
-			return fmt.Errorf("error validating Connector config: %q attribute is missing in %q block", connectorConfigAttributeClass, paramNonSensitiveConfig)
+			return fmt.Errorf("%q attribute is missing in %q block", connectorConfigAttributeClass, paramNonSensitiveConfig)
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Validating new Connector's config"))
 		validationResponse, _, err := c.connectClient.PluginsV1Api.ValidateConnectv1ConnectorPlugin(c.connectApiContext(ctx), connectorClass, environmentId, clusterId).RequestBody(config).Execute()
 		if err != nil {
-			return fmt.Errorf("error creating Connector: error sending validation request: %s", createDescriptiveError(err))
+			return fmt.Errorf("error sending validation request: %s", createDescriptiveError(err))
 		}
 		if validationResponse.GetErrorCount() > 0 {
-			return fmt.Errorf("error creating Connector %q: error validating config: %s", connectorClass, createDescriptiveError(createConfigValidationError(validationResponse)))
+			return fmt.Errorf("config is invalid for connector class %q: %s", connectorClass, createDescriptiveError(createConfigValidationError(validationResponse)))
 		}
 	} else if connectorType == connectorTypeCustom {
 		// connectorConfigAttributePlugin is required for custom connectors
 		if _, ok := config[connectorConfigAttributePlugin]; !ok {
-			return fmt.Errorf("error validating Connector config: %q attribute is missing in %q block", connectorConfigAttributePlugin, paramNonSensitiveConfig)
+			return fmt.Errorf("%q attribute is missing in %q block", connectorConfigAttributePlugin, paramNonSensitiveConfig)
 		}
 	} else {
-		return fmt.Errorf("error validating Connector config: unexpected value for %s: %s", connectorConfigAttributeType, connectorType)
+		return fmt.Errorf("unexpected value for %s: %s", connectorConfigAttributeType, connectorType)
 	}
 	return nil
 }
@@ -220,6 +396,37 @@ func executeConnectorRead(ctx context.Context, c *Client, displayName, environme
 	return *connect.NewConnectV1ConnectorExpansionWithDefaults(), &http.Response{StatusCode: http.StatusNotFound}, fmt.Errorf("connector %q was not found", displayName)
 }
 
+type connectorOffsets struct {
+	Offsets []connectorOffset `json:"offsets"`
+}
+
+type connectorOffset struct {
+	Partition map[string]interface{} `json:"partition"`
+	Offset    map[string]interface{} `json:"offset"`
+}
+
+// executeConnectorOffsetsRead reads the offsets of a Connector.
+// The offsets endpoint isn't supported by the Connect SDK yet, so the request is sent using the SDK's configuration (endpoint, HTTP client, and credentials).
+func executeConnectorOffsetsRead(ctx context.Context, c *Client, displayName, environmentId, clusterId string) (connectorOffsets, *http.Response, error) {
+	offsets := connectorOffsets{}
+	cfg := c.connectClient.GetConfig()
+	serverUrl, err := cfg.ServerURLWithContext(ctx, "ConnectorsV1ApiService.ReadConnectv1Connector")
+	if err != nil {
+		return offsets, nil, err
+	}
+	auth, _ := ctx.Value(connect.ContextBasicAuth).(connect.BasicAuth)
+	offsetsPath := fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/offsets", url.PathEscape(environmentId), url.PathEscape(clusterId), url.PathEscape(displayName))
+	resp, err := executeApiRequest(ctx, apiRequestConfig{
+		serverUrl:     serverUrl,
+		userAgent:     cfg.UserAgent,
+		defaultHeader: cfg.DefaultHeader,
+		httpClient:    cfg.HTTPClient,
+		userName:      auth.UserName,
+		password:      auth.Password,
+	}, http.MethodGet, offsetsPath, nil, &offsets)
+	return offsets, resp, err
+}
+
 func connectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	displayName := d.Get(connectorConfigFullAttributeName).(string)
 	if displayName == "" {
@@ -233,6 +440,13 @@ func connectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 	if _, err := readConnectorAndSetAttributes(ctx, d, meta, displayName, environmentId, clusterId); err != nil {
 		return diag.FromErr(fmt.Errorf("error reading Connector %q: %s", displayName, createDescriptiveError(err)))
 	}
+	// Skip reading offsets of a Connector that was removed from TF state
+	if d.Id() == "" {
+		return nil
+	}
+	if err := readConnectorOffsetsAndSetAttributes(ctx, d, meta, displayName, environmentId, clusterId); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connector %q: %s", displayName, createDescriptiveError(err)))
+	}
 
 	return nil
 }
@@ -267,11 +481,53 @@ func readConnectorAndSetAttributes(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+func readConnectorOffsetsAndSetAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, displayName, environmentId, clusterId string) error {
+	c := meta.(*Client)
+
+	offsets, _, err := executeConnectorOffsetsRead(c.connectApiContext(ctx), c, displayName, environmentId, clusterId)
+	if err != nil {
+		// Offsets are informational only and aren't available for every Connector (for example, for a Connector that is still provisioning),
+		// so a failure to read them shouldn't fail reading the Connector
+		tflog.Warn(ctx, fmt.Sprintf("Error reading offsets of Connector %q: %s", d.Id(), createDescriptiveError(err)), map[string]interface{}{connectorLoggingKey: d.Id()})
+		return d.Set(paramOffsets, []interface{}{})
+	}
+	offsetsJson, err := json.Marshal(offsets)
+	if err != nil {
+		return fmt.Errorf("error reading offsets of Connector %q: error marshaling %#v to json: %s", displayName, offsets, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched offsets of Connector %q: %s", displayName, offsetsJson), map[string]interface{}{connectorLoggingKey: d.Id()})
+
+	return d.Set(paramOffsets, buildTfConnectorOffsets(offsets))
+}
+
+func buildTfConnectorOffsets(offsets connectorOffsets) []map[string]interface{} {
+	tfOffsets := make([]map[string]interface{}, len(offsets.Offsets))
+	for i, offset := range offsets.Offsets {
+		tfOffsets[i] = map[string]interface{}{
+			paramPartition: stringifyConnectorOffsetValues(offset.Partition),
+			paramOffset:    stringifyConnectorOffsetValues(offset.Offset),
+		}
+	}
+	return tfOffsets
+}
+
+func stringifyConnectorOffsetValues(values map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = fmt.Sprint(value)
+	}
+	return result
+}
+
 func setConnectorAttributes(d *schema.ResourceData, connector connect.ConnectV1ConnectorExpansion, environmentId, clusterId string) (*schema.ResourceData, error) {
 	// paramSensitiveConfig is set in connectorCreate()
 	config := connector.Info.GetConfig()
 	status := connector.Status.GetConnector()
-	if err := d.Set(paramNonSensitiveConfig, extractNonsensitiveConfigs(config)); err != nil {
+	// Changes made outside of Terraform (e.g., in the Cloud Console) are reported as drift unless the setting is ignored
+	ignoredConfigKeys := convertToStringSlice(d.Get(paramIgnoredConfigKeys).(*schema.Set).List())
+	stateConfig := convertToStringStringMap(d.Get(paramNonSensitiveConfig).(map[string]interface{}))
+	nonsensitiveConfig := preserveIgnoredConnectorConfigs(extractNonsensitiveConfigs(config), stateConfig, ignoredConfigKeys)
+	if err := d.Set(paramNonSensitiveConfig, nonsensitiveConfig); err != nil {
 		return nil, err
 	}
 	if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, environmentId, d); err != nil {
@@ -288,8 +544,8 @@ func setConnectorAttributes(d *schema.ResourceData, connector connect.ConnectV1C
 }
 
 func connectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramNonSensitiveConfig, paramSensitiveConfig, paramStatus) {
-		return diag.Errorf("error updating Connector %q: only %q attribute, %q and %q blocks can be updated for Connector", d.Id(), paramStatus, paramNonSensitiveConfig, paramSensitiveConfig)
+	if d.HasChangesExcept(paramNonSensitiveConfig, paramSensitiveConfig, paramStatus, paramValidateConfig, paramIgnoredConfigKeys, paramRedactSensitiveConfig) {
+		return diag.Errorf("error updating Connector %q: only %q, %q, %q, %q attributes, %q and %q blocks can be updated for Connector", d.Id(), paramStatus, paramValidateConfig, paramIgnoredConfigKeys, paramRedactSensitiveConfig, paramNonSensitiveConfig, paramSensitiveConfig)
 	}
 	c := meta.(*Client)
 	if d.HasChange(connectorConfigFullAttributeName) {
@@ -308,40 +564,18 @@ func connectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface
 	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
 	if d.HasChange(paramStatus) {
 		oldValue, newValue := d.GetChange(paramStatus)
-		oldStatus := oldValue.(string)
-		newStatus := newValue.(string)
-		shouldPauseConnector := (oldStatus == stateRunning) && (newStatus == statePaused)
-		shouldResumeConnector := (oldStatus == statePaused) && (newStatus == stateRunning)
-		if shouldPauseConnector {
-			tflog.Debug(ctx, fmt.Sprintf("Pausing Connector %q", d.Id()), map[string]interface{}{connectorLoggingKey: d.Id()})
-
-			req := c.connectClient.LifecycleV1Api.PauseConnectv1Connector(c.connectApiContext(ctx), displayName, environmentId, clusterId)
-			_, err := req.Execute()
-			if err != nil {
-				return diag.Errorf("error updating Connector %q: %s", d.Id(), createDescriptiveError(err))
-			}
-			if err := waitForConnectorToChangeStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, stateRunning, statePaused); err != nil {
-				return diag.Errorf("error waiting for Connector %q to be updated: %s", d.Id(), createDescriptiveError(err))
-			}
-		} else if shouldResumeConnector {
-			tflog.Debug(ctx, fmt.Sprintf("Resuming Connector %q", d.Id()), map[string]interface{}{connectorLoggingKey: d.Id()})
-
-			req := c.connectClient.LifecycleV1Api.ResumeConnectv1Connector(c.connectApiContext(ctx), displayName, environmentId, clusterId)
-			_, err := req.Execute()
-			if err != nil {
-				return diag.Errorf("error updating Connector %q: %s", d.Id(), createDescriptiveError(err))
-			}
-			if err := waitForConnectorToChangeStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, statePaused, stateRunning); err != nil {
-				return diag.Errorf("error waiting for Connector %q to be updated: %s", d.Id(), createDescriptiveError(err))
-			}
-		} else {
-			return diag.Errorf("error updating Connector %q: only %q->%q or %q->%q transitions are supported but %q->%q was attempted", d.Id(), statePaused, stateRunning, stateRunning, statePaused, oldStatus, newStatus)
+		if err := updateConnectorStatus(ctx, c, d.Id(), displayName, environmentId, clusterId, oldValue.(string), newValue.(string)); err != nil {
+			return diag.Errorf("error updating Connector %q: %s", d.Id(), createDescriptiveError(err))
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Finished updating Connector %q", d.Id()), map[string]interface{}{connectorLoggingKey: d.Id()})
 	}
 	if d.HasChanges(paramNonSensitiveConfig, paramSensitiveConfig) {
 		// Update doesn't require secret topic configuration values to be set
 		updatedConfig, _, nonsensitiveUpdatedConfig := extractConnectorConfigs(d)
+		// Unchanged redacted sensitive settings are left as is on the server since only their digests are known
+		updatedConfig = lo.OmitBy(updatedConfig, func(_ string, value string) bool {
+			return isRedactedConnectorConfigValue(value)
+		})
 
 		debugUpdatedConfigJson, err := json.Marshal(nonsensitiveUpdatedConfig)
 		if err != nil {
@@ -366,6 +600,16 @@ func connectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Finished updating Connector %q: %s", d.Id(), updatedConnectorJson), map[string]interface{}{connectorLoggingKey: d.Id()})
 	}
+	if d.Get(paramRedactSensitiveConfig).(bool) {
+		redactionKey, err := ensureConnectorRedactionKey(d)
+		if err != nil {
+			return diag.Errorf("error updating Connector %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		_, sensitiveConfig, _ := extractConnectorConfigs(d)
+		if err := d.Set(paramSensitiveConfig, redactSensitiveConnectorConfigs(redactionKey, sensitiveConfig)); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
 	return connectorRead(ctx, d, meta)
 }
 
@@ -414,9 +658,16 @@ func connectorImport(ctx context.Context, d *schema.ResourceData, meta interface
 	if _, err := readConnectorAndSetAttributes(ctx, d, meta, connectorName, environmentId, clusterId); err != nil {
 		return nil, fmt.Errorf("error importing Connector %q: %s", d.Id(), createDescriptiveError(err))
 	}
+	if err := readConnectorOffsetsAndSetAttributes(ctx, d, meta, connectorName, environmentId, clusterId); err != nil {
+		return nil, fmt.Errorf("error importing Connector %q: %s", d.Id(), createDescriptiveError(err))
+	}
 	if err := d.Set(paramSensitiveConfig, make(map[string]string)); err != nil {
 		return nil, createDescriptiveError(err)
 	}
+	// Explicitly set paramValidateConfig to the default value
+	if err := d.Set(paramValidateConfig, paramValidateConfigDefaultValue); err != nil {
+		return nil, createDescriptiveError(err)
+	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished importing Connector %q", d.Id()), map[string]interface{}{connectorLoggingKey: d.Id()})
 	return []*schema.ResourceData{d}, nil
 }
@@ -444,6 +695,71 @@ func extractNonsensitiveConfigs(configs map[string]string) map[string]string {
 	return nonsensitiveConfigs
 }
 
+// ensureConnectorRedactionKey returns the redaction key from TF state and generates a new one if there's none yet.
+// The key is stored next to the digests, so it doesn't make brute-forcing low-entropy secrets from TF state harder,
+// but it rules out precomputed lookups and makes the digests of the same secret differ across Connectors.
+func ensureConnectorRedactionKey(d *schema.ResourceData) (string, error) {
+	if redactionKey := d.Get(paramRedactionKey).(string); redactionKey != "" {
+		return redactionKey, nil
+	}
+	key := make([]byte, redactionKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("error generating %q: %s", paramRedactionKey, err)
+	}
+	redactionKey := hex.EncodeToString(key)
+	if err := d.Set(paramRedactionKey, redactionKey); err != nil {
+		return "", err
+	}
+	return redactionKey, nil
+}
+
+// redactSensitiveConnectorConfigs replaces the values of the sensitive config settings with their digests,
+// so that the secrets aren't persisted in TF state while their changes are still detected.
+func redactSensitiveConnectorConfigs(redactionKey string, configs map[string]string) map[string]string {
+	redactedConfigs := make(map[string]string, len(configs))
+	for name, value := range configs {
+		if isRedactedConnectorConfigValue(value) {
+			redactedConfigs[name] = value
+			continue
+		}
+		redactedConfigs[name] = redactConnectorConfigValue(redactionKey, value)
+	}
+	return redactedConfigs
+}
+
+func redactConnectorConfigValue(redactionKey, value string) string {
+	mac := hmac.New(sha256.New, []byte(redactionKey))
+	mac.Write([]byte(value))
+	return redactedConnectorConfigValuePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func isRedactedConnectorConfigValue(value string) bool {
+	return strings.HasPrefix(value, redactedConnectorConfigValuePrefix)
+}
+
+// suppressRedactedConnectorConfigDiff hides the diff between a sensitive config setting from TF configuration
+// and its digest from TF state, unless the setting has been changed.
+func suppressRedactedConnectorConfigDiff(k, old, new string, d *schema.ResourceData) bool {
+	redactionKey := d.Get(paramRedactionKey).(string)
+	if !d.Get(paramRedactSensitiveConfig).(bool) || redactionKey == "" {
+		return false
+	}
+	return isRedactedConnectorConfigValue(old) && hmac.Equal([]byte(old), []byte(redactConnectorConfigValue(redactionKey, new)))
+}
+
+// preserveIgnoredConnectorConfigs replaces the server values of the ignored config settings with the values
+// from TF state, so that the server-managed settings don't show up as drift in 'terraform plan'.
+func preserveIgnoredConnectorConfigs(serverConfigs, stateConfigs map[string]string, ignoredConfigKeys []string) map[string]string {
+	for _, key := range ignoredConfigKeys {
+		if stateValue, ok := stateConfigs[key]; ok {
+			serverConfigs[key] = stateValue
+		} else {
+			delete(serverConfigs, key)
+		}
+	}
+	return serverConfigs
+}
+
 func createConfigValidationError(validationResponse connect.InlineResponse2003) error {
 	var configValidationErrors strings.Builder
 	idx := 1
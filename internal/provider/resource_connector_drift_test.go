@@ -0,0 +1,135 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	connect "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceConnectorConfigDrift(t *testing.T) {
+	tests := []struct {
+		name              string
+		ignoredConfigKeys []interface{}
+		expectedDrift     map[string]string
+	}{
+		{
+			name: "externally modified and added settings are reported",
+			expectedDrift: map[string]string{
+				"config_nonsensitive.tasks.max":          "2",
+				"config_nonsensitive.max.poll.interval":  "300000",
+				"config_nonsensitive.output.data.format": "JSON",
+			},
+		},
+		{
+			name:              "ignored settings are not reported",
+			ignoredConfigKeys: []interface{}{"tasks.max", "max.poll.interval"},
+			expectedDrift: map[string]string{
+				"config_nonsensitive.output.data.format": "JSON",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				paramEnvironment:  []interface{}{map[string]interface{}{paramId: "env-1j3m9j"}},
+				paramKafkaCluster: []interface{}{map[string]interface{}{paramId: "lkc-vnwdjz"}},
+				paramNonSensitiveConfig: map[string]interface{}{
+					connectorConfigAttributeName:  "test_connector",
+					connectorConfigAttributeClass: "DatagenSourceInternal",
+					"output.data.format":          "AVRO",
+					"tasks.max":                   "1",
+				},
+				paramSensitiveConfig:   map[string]interface{}{},
+				paramValidateConfig:    false,
+				paramIgnoredConfigKeys: tt.ignoredConfigKeys,
+			}
+			d := schema.TestResourceDataRaw(t, connectorResource().Schema, raw)
+			d.SetId("lcc-abc123")
+
+			// The server returns the settings modified in the Cloud Console alongside sensitive and internal ones
+			serverConfig := map[string]string{
+				connectorConfigAttributeName:  "test_connector",
+				connectorConfigAttributeClass: "DatagenSourceInternal",
+				"output.data.format":          "JSON",
+				"tasks.max":                   "2",
+				"max.poll.interval":           "300000",
+				"kafka.api.secret":            "****************",
+				"kafka.endpoint":              "SASL_SSL://pkc-abc123.us-west-2.aws.confluent.cloud:9092",
+			}
+			connector := connect.ConnectV1ConnectorExpansion{
+				Id:   &connect.ConnectV1ConnectorExpansionId{Id: connect.PtrString("lcc-abc123")},
+				Info: &connect.ConnectV1ConnectorExpansionInfo{Config: &serverConfig},
+				Status: &connect.ConnectV1ConnectorExpansionStatus{
+					Connector: connect.ConnectV1ConnectorExpansionStatusConnector{State: stateRunning},
+				},
+			}
+			if _, err := setConnectorAttributes(d, connector, "env-1j3m9j", "lkc-vnwdjz"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			diff, err := connectorResource().Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), &Client{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff == nil {
+				t.Fatalf("expected drift to be reported, got an empty diff")
+			}
+			if diff.RequiresNew() {
+				t.Fatalf("expected drift to be fixed in place, got a diff that requires replacement")
+			}
+			driftedAttributes := make(map[string]string)
+			for attributeName, attributeDiff := range diff.Attributes {
+				// Only the settings themselves are relevant, computed attributes aren't set in this test
+				if !strings.HasPrefix(attributeName, paramNonSensitiveConfig+".") || attributeName == fmt.Sprintf("%s.%%", paramNonSensitiveConfig) {
+					continue
+				}
+				driftedAttributes[attributeName] = attributeDiff.Old
+			}
+			if len(driftedAttributes) != len(tt.expectedDrift) {
+				t.Fatalf("expected drift in %v, got %v", tt.expectedDrift, driftedAttributes)
+			}
+			for attributeName, expectedOldValue := range tt.expectedDrift {
+				if oldValue, ok := driftedAttributes[attributeName]; !ok || oldValue != expectedOldValue {
+					t.Fatalf("expected %q to drift from %q, got %v", attributeName, expectedOldValue, driftedAttributes)
+				}
+			}
+		})
+	}
+}
+
+func TestPreserveIgnoredConnectorConfigs(t *testing.T) {
+	serverConfigs := map[string]string{"tasks.max": "2", "max.poll.interval": "300000", "kafka.topic": "orders"}
+	stateConfigs := map[string]string{"tasks.max": "1", "kafka.topic": "orders"}
+
+	got := preserveIgnoredConnectorConfigs(serverConfigs, stateConfigs, []string{"tasks.max", "max.poll.interval"})
+
+	expected := map[string]string{"tasks.max": "1", "kafka.topic": "orders"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for key, value := range expected {
+		if got[key] != value {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
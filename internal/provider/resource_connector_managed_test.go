@@ -27,7 +27,6 @@ import (
 )
 
 const (
-	scenarioStateManagedConnectorHasBeenValidated   = "The new managed connector config has been just validated"
 	scenarioStateManagedConnectorHasBeenCreating    = "The new managed connector has been creating"
 	scenarioStateManagedConnectorFetchingId         = "The new managed connector is in provisioning state, list all connectors"
 	scenarioStateManagedConnectorIsProvisioning     = "The new managed connector is in provisioning state"
@@ -59,8 +58,6 @@ func TestAccManagedConnector(t *testing.T) {
 	validateConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/validate.json")
 	validateEnvStub := wiremock.Put(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connector-plugins/DatagenSourceInternal/config/validate")).
 		InScenario(connectorScenarioName).
-		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
-		WillSetStateTo(scenarioStateManagedConnectorHasBeenValidated).
 		WillReturn(
 			string(validateConnectorResponse),
 			contentTypeJSONHeader,
@@ -70,7 +67,7 @@ func TestAccManagedConnector(t *testing.T) {
 
 	createConnectorStub := wiremock.Post(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors")).
 		InScenario(connectorScenarioName).
-		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenValidated).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
 		WillSetStateTo(scenarioStateManagedConnectorHasBeenCreating).
 		WillReturn(
 			"",
@@ -139,6 +136,16 @@ func TestAccManagedConnector(t *testing.T) {
 		)
 	_ = wiremockClient.StubFor(readCreatedConnectorStub2)
 
+	// The offsets of a source connector don't change in this test
+	connectorOffsetsResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_connector_offsets.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector/offsets")).
+		InScenario(connectorScenarioName).
+		WillReturn(
+			string(connectorOffsetsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	updateConnectorStub := wiremock.Put(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector/config")).
 		InScenario(connectorScenarioName).
 		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenCreated).
@@ -214,6 +221,13 @@ func TestAccManagedConnector(t *testing.T) {
 					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramNonSensitiveConfig, "output.data.format"), "JSON"),
 					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramNonSensitiveConfig, "quickstart"), "ORDERS"),
 					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramNonSensitiveConfig, "tasks.max"), "1"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.#", paramOffsets), "1"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.0.%s.%%", paramOffsets, paramPartition), "1"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.0.%s.server", paramOffsets, paramPartition), "test_topic"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.0.%s.%%", paramOffsets, paramOffset), "3"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.0.%s.event", paramOffsets, paramOffset), "2"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.0.%s.file", paramOffsets, paramOffset), "mysql-bin.000600"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.0.%s.pos", paramOffsets, paramOffset), "9007199254740993"),
 					// Ensure these attributes (from ignoredConnectorConfigs) are not visible in the output
 					resource.TestCheckNoResourceAttr(fullConnectorResourceLabel, "cloud.environment"),
 					resource.TestCheckNoResourceAttr(fullConnectorResourceLabel, "cloud.provider"),
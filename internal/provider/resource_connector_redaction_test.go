@@ -0,0 +1,327 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+const testRedactionKey = "5f2b5c6e0d1a4b3c9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b7c6d"
+
+func TestAccManagedConnectorWithRedactedSensitiveConfig(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	validateConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/validate.json")
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connector-plugins/DatagenSourceInternal/config/validate")).
+		InScenario(connectorScenarioName).
+		WillReturn(
+			string(validateConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// The secret is still sent to the Connect API
+	createConnectorStub := wiremock.Post(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors")).
+		InScenario(connectorScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf("%q:%q", sensitiveAttributeKey, sensitiveAttributeValue))).
+		WillSetStateTo(scenarioStateManagedConnectorHasBeenCreating).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createConnectorStub)
+
+	createdConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_created_connectors.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors")).
+		WithQueryParam("expand", wiremock.EqualTo("info,status,id")).
+		InScenario(connectorScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenCreating).
+		WillSetStateTo(scenarioStateManagedConnectorFetchingId).
+		WillReturn(
+			string(createdConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	runningConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_running_connector.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector/status")).
+		InScenario(connectorScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorFetchingId).
+		WillSetStateTo(scenarioStateManagedConnectorHasBeenCreated).
+		WillReturn(
+			string(runningConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors")).
+		WithQueryParam("expand", wiremock.EqualTo("info,status,id")).
+		InScenario(connectorScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenCreated).
+		WillReturn(
+			string(createdConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	connectorOffsetsResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_connector_offsets.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector/offsets")).
+		InScenario(connectorScenarioName).
+		WillReturn(
+			string(connectorOffsetsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/delete_connector.json")
+	deleteConnectorStub := wiremock.Delete(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector")).
+		InScenario(connectorScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenCreated).
+		WillSetStateTo(scenarioStateManagedConnectorHasBeenDeleted).
+		WillReturn(
+			string(deleteConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteConnectorStub)
+
+	readDeletedConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_deleted_connector.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector")).
+		InScenario(connectorScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenDeleted).
+		WillReturn(
+			string(readDeletedConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	connectorResourceLabel := "test_connector_resource_label"
+	fullConnectorResourceLabel := fmt.Sprintf("confluent_connector.%s", connectorResourceLabel)
+	connectorDisplayName := "test_connector"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckManagedConnectorWithRedactedSensitiveConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(fullConnectorResourceLabel),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramRedactSensitiveConfig, "true"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%%", paramSensitiveConfig), "1"),
+					testAccCheckSecretIsRedacted(fullConnectorResourceLabel, sensitiveAttributeKey, sensitiveAttributeValue),
+					testAccCheckSecretIsNotInState(fullConnectorResourceLabel, sensitiveAttributeValue),
+				),
+			},
+			{
+				// The digest in TF state matches the secret in TF configuration, so there's nothing to update
+				Config:   testAccCheckManagedConnectorWithRedactedSensitiveConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createConnectorStub, "POST /connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteConnectorStub, "DELETE /connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector", expectedCountOne)
+}
+
+func TestResourceConnectorRedactedSensitiveConfigDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "lcc-abc123",
+		Attributes: map[string]string{
+			paramId:                               "lcc-abc123",
+			fmt.Sprintf("%s.#", paramEnvironment): "1",
+			fmt.Sprintf("%s.0.%s", paramEnvironment, paramId):      "env-1j3m9j",
+			fmt.Sprintf("%s.#", paramKafkaCluster):                 "1",
+			fmt.Sprintf("%s.0.%s", paramKafkaCluster, paramId):     "lkc-vnwdjz",
+			fmt.Sprintf("%s.%%", paramNonSensitiveConfig):          "1",
+			connectorConfigFullAttributeName:                       "test_connector",
+			fmt.Sprintf("%s.%%", paramSensitiveConfig):             "1",
+			fmt.Sprintf("%s.%s", paramSensitiveConfig, "password"): redactConnectorConfigValue(testRedactionKey, "secret"),
+			paramRedactSensitiveConfig:                             "true",
+			paramRedactionKey:                                      testRedactionKey,
+			paramValidateConfig:                                    "true",
+			paramStatus:                                            stateRunning,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		password       string
+		expectedChange bool
+	}{
+		{"unchanged secret", "secret", false},
+		{"rotated secret", "rotated secret", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				paramEnvironment:           []interface{}{map[string]interface{}{paramId: "env-1j3m9j"}},
+				paramKafkaCluster:          []interface{}{map[string]interface{}{paramId: "lkc-vnwdjz"}},
+				paramNonSensitiveConfig:    map[string]interface{}{connectorConfigAttributeName: "test_connector"},
+				paramSensitiveConfig:       map[string]interface{}{"password": tt.password},
+				paramRedactSensitiveConfig: true,
+				// The validation is covered by TestAccManagedConnectorWithMissingRequiredConfig
+				paramValidateConfig: false,
+			})
+			diff, err := connectorResource().Diff(context.Background(), state, config, &Client{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			attributeDiff, hasChange := diff.GetAttribute(fmt.Sprintf("%s.%s", paramSensitiveConfig, "password"))
+			if hasChange != tt.expectedChange {
+				t.Fatalf("expected change to be %t, got %t: %#v", tt.expectedChange, hasChange, attributeDiff)
+			}
+			if hasChange && attributeDiff.New != tt.password {
+				t.Fatalf("expected the rotated secret to be planned, got %q", attributeDiff.New)
+			}
+		})
+	}
+}
+
+func TestRedactSensitiveConnectorConfigs(t *testing.T) {
+	configs := map[string]string{
+		"password":          "secret",
+		"already.redacted":  redactConnectorConfigValue(testRedactionKey, "another secret"),
+		"kafka.api.secret":  "",
+		"aws.secret.access": "secret",
+	}
+
+	redactedConfigs := redactSensitiveConnectorConfigs(testRedactionKey, configs)
+
+	if len(redactedConfigs) != len(configs) {
+		t.Fatalf("expected %d config settings, got %d", len(configs), len(redactedConfigs))
+	}
+	for name, value := range redactedConfigs {
+		if !isRedactedConnectorConfigValue(value) {
+			t.Fatalf("expected %q to be redacted, got %q", name, value)
+		}
+		if strings.Contains(value, "secret") {
+			t.Fatalf("expected %q to not contain the secret, got %q", name, value)
+		}
+	}
+	if redactedConfigs["already.redacted"] != configs["already.redacted"] {
+		t.Fatalf("expected a redacted value to be kept as is, got %q", redactedConfigs["already.redacted"])
+	}
+	if redactedConfigs["password"] != redactedConfigs["aws.secret.access"] {
+		t.Fatalf("expected equal secrets to have equal digests")
+	}
+	if otherRedactedConfigs := redactSensitiveConnectorConfigs("another key", configs); otherRedactedConfigs["password"] == redactedConfigs["password"] {
+		t.Fatalf("expected the digests of the same secret to differ across redaction keys")
+	}
+}
+
+func TestEnsureConnectorRedactionKey(t *testing.T) {
+	d := connectorResource().TestResourceData()
+
+	redactionKey, err := ensureConnectorRedactionKey(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(redactionKey) != 2*redactionKeyLength {
+		t.Fatalf("expected a %d-byte hex-encoded key, got %q", redactionKeyLength, redactionKey)
+	}
+	if d.Get(paramRedactionKey).(string) != redactionKey {
+		t.Fatalf("expected the key to be stored in %q", paramRedactionKey)
+	}
+	if sameRedactionKey, _ := ensureConnectorRedactionKey(d); sameRedactionKey != redactionKey {
+		t.Fatalf("expected the stored key %q to be reused, got %q", redactionKey, sameRedactionKey)
+	}
+	if otherRedactionKey, _ := ensureConnectorRedactionKey(connectorResource().TestResourceData()); otherRedactionKey == redactionKey {
+		t.Fatalf("expected different Connectors to have different keys")
+	}
+}
+
+func testAccCheckSecretIsRedacted(n, key, secret string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("%s Connector has not been found", n)
+		}
+		expectedDigest := redactConnectorConfigValue(rs.Primary.Attributes[paramRedactionKey], secret)
+		if digest := rs.Primary.Attributes[fmt.Sprintf("%s.%s", paramSensitiveConfig, key)]; digest != expectedDigest {
+			return fmt.Errorf("expected %q of %s Connector to be %q, got %q", key, n, expectedDigest, digest)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSecretIsNotInState(n, secret string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("%s Connector has not been found", n)
+		}
+		for attributeName, attributeValue := range rs.Primary.Attributes {
+			if strings.Contains(attributeValue, secret) {
+				return fmt.Errorf("%q attribute of %s Connector contains the secret", attributeName, n)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckManagedConnectorWithRedactedSensitiveConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	resource "confluent_connector" "%s" {
+		environment {
+		  id = "env-1j3m9j"
+		}
+		kafka_cluster {
+		  id = "lkc-vnwdjz"
+		}
+		redact_sensitive_config = true
+		config_sensitive = {
+		  "%s"             = "%s"
+		}
+		config_nonsensitive = {
+		  "name"            = "%s"
+		  "connector.class" = "DatagenSourceInternal"
+		  "kafka.topic" = "test_topic"
+		  "output.data.format" = "JSON"
+		  "tasks.max" = "1"
+		  "quickstart" = "ORDERS"
+		}
+	}
+	`, mockServerUrl, connectorResourceLabel, sensitiveAttributeKey, sensitiveAttributeValue, connectorDisplayName)
+}
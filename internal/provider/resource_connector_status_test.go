@@ -0,0 +1,234 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	scenarioStateManagedConnectorHasBeenPaused  = "The new managed connector has been paused"
+	scenarioStateManagedConnectorHasBeenResumed = "The new managed connector has been resumed"
+	connectorStatusScenarioName                 = "confluent_connector Resource Status Lifecycle"
+
+	connectorsUrlPath      = "/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors"
+	connectorUrlPath       = "/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector"
+	connectorStatusUrlPath = "/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connectors/test_connector/status"
+)
+
+func TestAccManagedConnectorPauseAndResume(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	validateConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/validate.json")
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo("/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connector-plugins/DatagenSourceInternal/config/validate")).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(validateConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(connectorsUrlPath)).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	// The connector is running both after it has been created and after it has been resumed
+	runningConnectorsResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_created_connectors.json")
+	runningConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_running_connector.json")
+	for _, scenarioState := range []string{wiremock.ScenarioStateStarted, scenarioStateManagedConnectorHasBeenResumed} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectorsUrlPath)).
+			WithQueryParam("expand", wiremock.EqualTo("info,status,id")).
+			InScenario(connectorStatusScenarioName).
+			WhenScenarioStateIs(scenarioState).
+			WillReturn(
+				string(runningConnectorsResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectorStatusUrlPath)).
+			InScenario(connectorStatusScenarioName).
+			WhenScenarioStateIs(scenarioState).
+			WillReturn(
+				string(runningConnectorResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	pauseConnectorStub := wiremock.Put(wiremock.URLPathEqualTo(fmt.Sprintf("%s/pause", connectorUrlPath))).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateManagedConnectorHasBeenPaused).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusAccepted,
+		)
+	_ = wiremockClient.StubFor(pauseConnectorStub)
+
+	pausedConnectorsResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_paused_connectors.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectorsUrlPath)).
+		WithQueryParam("expand", wiremock.EqualTo("info,status,id")).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenPaused).
+		WillReturn(
+			string(pausedConnectorsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	pausedConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_paused_connector.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectorStatusUrlPath)).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenPaused).
+		WillReturn(
+			string(pausedConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resumeConnectorStub := wiremock.Put(wiremock.URLPathEqualTo(fmt.Sprintf("%s/resume", connectorUrlPath))).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenPaused).
+		WillSetStateTo(scenarioStateManagedConnectorHasBeenResumed).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusAccepted,
+		)
+	_ = wiremockClient.StubFor(resumeConnectorStub)
+
+	deleteConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/delete_connector.json")
+	deleteConnectorStub := wiremock.Delete(wiremock.URLPathEqualTo(connectorUrlPath)).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenResumed).
+		WillSetStateTo(scenarioStateManagedConnectorHasBeenDeleted).
+		WillReturn(
+			string(deleteConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteConnectorStub)
+
+	readDeletedConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/read_deleted_connector.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectorUrlPath)).
+		InScenario(connectorStatusScenarioName).
+		WhenScenarioStateIs(scenarioStateManagedConnectorHasBeenDeleted).
+		WillReturn(
+			string(readDeletedConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	connectorResourceLabel := "test_connector_resource_label"
+	fullConnectorResourceLabel := fmt.Sprintf("confluent_connector.%s", connectorResourceLabel)
+	connectorDisplayName := "test_connector"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckManagedConnectorWithStatusConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName, stateRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(fullConnectorResourceLabel),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramId, "lcc-abc123"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramStatus, stateRunning),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramSensitiveConfig, sensitiveAttributeKey), sensitiveAttributeValue),
+				),
+			},
+			{
+				Config: testAccCheckManagedConnectorWithStatusConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName, statePaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(fullConnectorResourceLabel),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramId, "lcc-abc123"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramStatus, statePaused),
+					// Pausing a connector doesn't affect its configs
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramSensitiveConfig, sensitiveAttributeKey), sensitiveAttributeValue),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramNonSensitiveConfig, "output.data.format"), "JSON"),
+				),
+			},
+			{
+				Config: testAccCheckManagedConnectorWithStatusConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName, stateRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(fullConnectorResourceLabel),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramId, "lcc-abc123"),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, paramStatus, stateRunning),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramSensitiveConfig, sensitiveAttributeKey), sensitiveAttributeValue),
+					resource.TestCheckResourceAttr(fullConnectorResourceLabel, fmt.Sprintf("%s.%s", paramNonSensitiveConfig, "output.data.format"), "JSON"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, pauseConnectorStub, fmt.Sprintf("PUT %s/pause", connectorUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, resumeConnectorStub, fmt.Sprintf("PUT %s/resume", connectorUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteConnectorStub, fmt.Sprintf("DELETE %s", connectorUrlPath), expectedCountOne)
+}
+
+func testAccCheckManagedConnectorWithStatusConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName, status string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	resource "confluent_connector" "%s" {
+		environment {
+		  id = "env-1j3m9j"
+		}
+		kafka_cluster {
+		  id = "lkc-vnwdjz"
+		}
+		status = "%s"
+		config_sensitive = {
+		  "%s"             = "%s"
+		}
+		config_nonsensitive = {
+		  "name"            = "%s"
+		  "connector.class" = "DatagenSourceInternal"
+		  "kafka.topic" = "test_topic"
+		  "output.data.format" = "JSON"
+		  "tasks.max" = "1"
+		  "quickstart" = "ORDERS"
+		}
+	}
+	`, mockServerUrl, connectorResourceLabel, status, sensitiveAttributeKey, sensitiveAttributeValue, connectorDisplayName)
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	connect "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	connectorValidationScenarioName = "confluent_connector Resource Config Validation"
+	validateConnectorConfigUrlPath  = "/connect/v1/environments/env-1j3m9j/clusters/lkc-vnwdjz/connector-plugins/DatagenSourceInternal/config/validate"
+)
+
+func TestAccManagedConnectorWithMissingRequiredConfig(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	validateConnectorResponse, _ := ioutil.ReadFile("../testdata/connector/managed/validate_missing_required_config.json")
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo(validateConnectorConfigUrlPath)).
+		InScenario(connectorValidationScenarioName).
+		WillReturn(
+			string(validateConnectorResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	createConnectorStub := wiremock.Post(wiremock.URLPathEqualTo(connectorsUrlPath)).
+		InScenario(connectorValidationScenarioName).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createConnectorStub)
+
+	connectorResourceLabel := "test_connector_resource_label"
+	connectorDisplayName := "test_connector"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The missing "kafka.topic" config should be reported during 'terraform plan'
+				Config:      testAccCheckManagedConnectorWithoutTopicConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName, true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"kafka.topic" is required`),
+			},
+			{
+				// The plan succeeds when the validation is disabled
+				Config:             testAccCheckManagedConnectorWithoutTopicConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createConnectorStub, fmt.Sprintf("POST %s", connectorsUrlPath), expectedCountZero)
+}
+
+func testAccCheckManagedConnectorWithoutTopicConfig(mockServerUrl, connectorResourceLabel, connectorDisplayName string, validateConfig bool) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	resource "confluent_connector" "%s" {
+		environment {
+		  id = "env-1j3m9j"
+		}
+		kafka_cluster {
+		  id = "lkc-vnwdjz"
+		}
+		validate_config = %t
+		config_nonsensitive = {
+		  "name"            = "%s"
+		  "connector.class" = "DatagenSourceInternal"
+		  "output.data.format" = "JSON"
+		  "tasks.max" = "1"
+		  "quickstart" = "ORDERS"
+		}
+	}
+	`, mockServerUrl, connectorResourceLabel, validateConfig, connectorDisplayName)
+}
+
+func TestConnectorCustomizeDiffSkipsUnknownConfig(t *testing.T) {
+	// connector.class is missing, so the validation fails without sending any requests whenever it runs
+	knownConfig := cty.MapVal(map[string]cty.Value{
+		connectorConfigAttributeName: cty.StringVal("test_connector"),
+		"kafka.topic":                cty.StringVal("orders"),
+	})
+	unknownConfig := cty.MapVal(map[string]cty.Value{
+		connectorConfigAttributeName: cty.StringVal("test_connector"),
+		"kafka.topic":                cty.UnknownVal(cty.String),
+	})
+
+	knownRawConfig := testConnectorResourceRawConfig(knownConfig)
+	_, err := connectorResource().SimpleDiff(context.Background(), testConnectorResourceState(knownRawConfig), testConnectorResourceConfig(knownRawConfig), &Client{})
+	if err == nil || !strings.Contains(err.Error(), connectorConfigAttributeClass) {
+		t.Fatalf("expected the validation to fail on the missing %q attribute, got %v", connectorConfigAttributeClass, err)
+	}
+	unknownRawConfig := testConnectorResourceRawConfig(unknownConfig)
+	if _, err := connectorResource().SimpleDiff(context.Background(), testConnectorResourceState(unknownRawConfig), testConnectorResourceConfig(unknownRawConfig), &Client{}); err != nil {
+		t.Fatalf("expected the validation to be skipped when a config value is known only after apply, got %s", err)
+	}
+}
+
+func TestConnectorCustomizeDiffWithoutEnvironmentBlock(t *testing.T) {
+	var validatePaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		validatePaths = append(validatePaths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "DatagenSourceInternal", "error_count": 0, "configs": []}`))
+	}))
+	defer server.Close()
+
+	cfg := connect.NewConfiguration()
+	cfg.Servers[0].URL = server.URL
+	rawConfig := testConnectorResourceRawConfig(cty.MapVal(map[string]cty.Value{
+		connectorConfigAttributeName:  cty.StringVal("test_connector"),
+		connectorConfigAttributeClass: cty.StringVal("DatagenSourceInternal"),
+	}))
+	rawConfigAttributes := rawConfig.AsValueMap()
+	rawConfigAttributes[paramEnvironment] = cty.NullVal(rawConfigAttributes[paramEnvironment].Type())
+	rawConfig = cty.ObjectVal(rawConfigAttributes)
+
+	// The validation is skipped when there's no Environment to validate the config against
+	c := &Client{connectClient: connect.NewAPIClient(cfg)}
+	if _, err := connectorResource().SimpleDiff(context.Background(), testConnectorResourceState(rawConfig), testConnectorResourceConfig(rawConfig), c); err != nil {
+		t.Fatalf("expected the validation to be skipped, got %s", err)
+	}
+	if len(validatePaths) != 0 {
+		t.Fatalf("expected no validation requests, got %q", validatePaths)
+	}
+
+	// provider.environment_id is used when the environment block is omitted
+	c.environmentId = "env-provider"
+	if _, err := connectorResource().SimpleDiff(context.Background(), testConnectorResourceState(rawConfig), testConnectorResourceConfig(rawConfig), c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedPath := "/connect/v1/environments/env-provider/clusters/lkc-vnwdjz/connector-plugins/DatagenSourceInternal/config/validate"
+	if len(validatePaths) != 1 || validatePaths[0] != expectedPath {
+		t.Fatalf("expected a single validation request to %q, got %q", expectedPath, validatePaths)
+	}
+}
+
+func testConnectorResourceConfig(rawConfig cty.Value) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigShimmed(rawConfig, connectorResource().CoreConfigSchema())
+}
+
+// testConnectorResourceState mimics the empty prior state that carries the raw config during terraform plan
+func testConnectorResourceState(rawConfig cty.Value) *terraform.InstanceState {
+	return &terraform.InstanceState{RawConfig: rawConfig}
+}
+
+func testConnectorResourceRawConfig(nonsensitiveConfig cty.Value) cty.Value {
+	attributes := map[string]cty.Value{}
+	for name, attributeType := range connectorResource().CoreConfigSchema().ImpliedType().AttributeTypes() {
+		attributes[name] = cty.NullVal(attributeType)
+	}
+	attributes[paramEnvironment] = cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{paramId: cty.StringVal("env-1j3m9j")})})
+	attributes[paramKafkaCluster] = cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{paramId: cty.StringVal("lkc-vnwdjz")})})
+	attributes[paramNonSensitiveConfig] = nonsensitiveConfig
+	return cty.ObjectVal(attributes)
+}
@@ -65,6 +65,8 @@ func TestAccCustomConnectorPlugin(t *testing.T) {
 
 	createCustomConnectorPluginResponse, _ := ioutil.ReadFile("../testdata/custom_connector_plugin/create_plugin.json")
 	createCustomConnectorPluginStub := wiremock.Post(wiremock.URLPathEqualTo("/connect/v1/custom-connector-plugins")).
+		// The plugin must be registered with the upload ID of the presigned URL the file was uploaded to
+		WithBodyPattern(wiremock.Contains(`"upload_id":"d7fa641f-7b17-4eb8-b326-6731b02aad11"`)).
 		InScenario(customConnectorPluginScenarioName).
 		WhenScenarioStateIs(scenarioStateCustomConnectorPluginPresignedUrlHasBeenCreated).
 		WillSetStateTo(scenarioStateCustomConnectorPluginHasBeenCreated).
@@ -182,6 +184,7 @@ func TestAccCustomConnectorPlugin(t *testing.T) {
 		},
 	})
 
+	checkStubCount(t, wiremockClient, createCustomConnectorPluginPresignedUrlStub, "POST /connect/v1/presigned-upload-url", expectedCountOne)
 	checkStubCount(t, wiremockClient, createCustomConnectorPluginStub, "POST /connect/v1/custom-connector-plugins", expectedCountOne)
 	checkStubCount(t, wiremockClient, patchCustomConnectorPluginStub, "PATCH /connect/v1/custom-connector-plugins/ccp-4rrw00", expectedCountOne)
 	checkStubCount(t, wiremockClient, deleteCustomConnectorPluginStub, "DELETE /connect/v1/custom-connector-plugins/ccp-4rrw00", expectedCountOne)
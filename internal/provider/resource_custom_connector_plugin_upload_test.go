@@ -0,0 +1,102 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUploadFileToPresignedUrl(t *testing.T) {
+	pluginContent := "plugin archive content"
+	filename := filepath.Join(t.TempDir(), "datagen.zip")
+	if err := os.WriteFile(filename, []byte(pluginContent), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var receivedFormFields map[string]string
+	var receivedFilename, receivedContent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Unexpected method: expected %s, got %s", http.MethodPost, r.Method)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Unexpected error parsing the multipart form: %v", err)
+			return
+		}
+		receivedFormFields = map[string]string{}
+		for key, values := range r.MultipartForm.Value {
+			receivedFormFields[key] = values[0]
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("Unexpected error reading the uploaded file: %v", err)
+			return
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		receivedFilename, receivedContent = header.Filename, string(content)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	formFields := map[string]any{
+		"key":    "bar",
+		"policy": "aar",
+	}
+	if err := uploadFile(server.URL, filename, formFields); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if receivedFormFields["key"] != "bar" || receivedFormFields["policy"] != "aar" {
+		t.Fatalf("Unexpected form fields: %v", receivedFormFields)
+	}
+	if receivedFilename != "datagen.zip" {
+		t.Fatalf("Unexpected filename: expected %q, got %q", "datagen.zip", receivedFilename)
+	}
+	if receivedContent != pluginContent {
+		t.Fatalf("Unexpected file content: expected %q, got %q", pluginContent, receivedContent)
+	}
+}
+
+func TestUploadFileToPresignedUrlFailure(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "datagen.zip")
+	if err := os.WriteFile(filename, []byte("plugin archive content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	if err := uploadFile(server.URL, filename, map[string]any{}); err == nil {
+		t.Fatal("Expected an error for a rejected upload, got nil")
+	}
+	if err := uploadFile(server.URL, filepath.Join(t.TempDir(), "missing.zip"), map[string]any{}); err == nil {
+		t.Fatal("Expected an error for a missing file, got nil")
+	}
+}
+
+func TestUploadCustomConnectorPluginRejectsUnsupportedExtension(t *testing.T) {
+	_, err := uploadCustomConnectorPlugin(context.Background(), &Client{}, "datagen.tar.gz", "AWS")
+	if err == nil || err.Error() != `error uploading Custom Connector Plugin: only file extensions ".jar" and ".zip" are allowed` {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
 	"strings"
@@ -61,6 +62,7 @@ func dnsForwarderResource() *schema.Resource {
 			paramGateway:      requiredGateway(),
 			paramEnvironment:  environmentSchema(),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff, validateReferencedGatewayCustomizeDiff),
 	}
 }
 
@@ -110,7 +112,10 @@ func dnsForwarderCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	displayName := d.Get(paramDisplayName).(string)
 	gatewayId := extractStringValueFromBlock(d, paramGateway, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating DNS Forwarder: %s", createDescriptiveError(err))
+	}
 	domains := convertToStringSlice(d.Get(paramDomains).(*schema.Set).List())
 
 	isForwardViaIp := len(d.Get(paramForwardViaIp).([]interface{})) > 0
@@ -148,7 +153,7 @@ func dnsForwarderCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	d.SetId(createdDnsForwarder.GetId())
 
-	if err := waitForDnsForwarderToProvision(c.netDnsApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForDnsForwarderToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for DNS Forwarder %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -228,8 +233,8 @@ func dnsForwarderDelete(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func dnsForwarderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangeExcept(paramDisplayName) {
-		return diag.Errorf("error updating DNS Forwarder %q: only %q attribute can be updated for DNS Forwarder", d.Id(), paramDisplayName)
+	if d.HasChangesExcept(paramDisplayName, paramDomains) {
+		return diag.Errorf("error updating DNS Forwarder %q: only %q, %q attributes can be updated for DNS Forwarder", d.Id(), paramDisplayName, paramDomains)
 	}
 
 	c := meta.(*Client)
@@ -238,6 +243,9 @@ func dnsForwarderUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	updateDnsForwarderRequest := dns.NewNetworkingV1DnsForwarderUpdate()
 	updateSpec := dns.NewNetworkingV1DnsForwarderSpecUpdate()
 	updateSpec.SetDisplayName(updatedDisplayName)
+	if d.HasChange(paramDomains) {
+		updateSpec.SetDomains(convertToStringSlice(d.Get(paramDomains).(*schema.Set).List()))
+	}
 	updateSpec.SetEnvironment(dns.ObjectReference{Id: environmentId})
 	updateDnsForwarderRequest.SetSpec(*updateSpec)
 	updateDnsForwarderRequestJson, err := json.Marshal(updateDnsForwarderRequest)
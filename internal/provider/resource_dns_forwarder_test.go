@@ -27,6 +27,7 @@ import (
 const (
 	scenarioStateDnsForwarderIsProvisioning = "The new dns forwarder is provisioning"
 	scenarioStateDnsForwarderHasBeenCreated = "The new dns forwarder has been created"
+	scenarioStateDnsForwarderHasBeenUpdated = "The new dns forwarder's domains have been updated"
 	dnsForwarderScenarioName                = "confluent_dns_forwarder Resource Lifecycle"
 
 	dnsForwarderUrlPath       = "/networking/v1/dns-forwarders"
@@ -84,6 +85,29 @@ func TestAccDnsForwarder(t *testing.T) {
 			http.StatusOK,
 		))
 
+	readUpdatedDnsForwarderResponse, _ := ioutil.ReadFile("../testdata/network_dns_forwarder/read_updated_dnsf.json")
+	updateDnsForwarderStub := wiremock.Patch(wiremock.URLPathEqualTo(dnsForwarderReadUrlPath)).
+		WithBodyPattern(wiremock.Contains("example.internal")).
+		InScenario(dnsForwarderScenarioName).
+		WhenScenarioStateIs(scenarioStateDnsForwarderHasBeenCreated).
+		WillSetStateTo(scenarioStateDnsForwarderHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedDnsForwarderResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateDnsForwarderStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(dnsForwarderReadUrlPath)).
+		InScenario(dnsForwarderScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo("env-xxx")).
+		WhenScenarioStateIs(scenarioStateDnsForwarderHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedDnsForwarderResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(dnsForwarderReadUrlPath)).
 		InScenario(dnsForwarderScenarioName).
 		WithQueryParam("environment", wiremock.EqualTo("env-xxx")).
@@ -115,11 +139,28 @@ func TestAccDnsForwarder(t *testing.T) {
 					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "forward_via_ip.0.dns_server_ips.0", "10.200.0.0"),
 					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "forward_via_ip.0.dns_server_ips.1", "10.200.0.1")),
 			},
+			{
+				Config: testAccCheckResourceDnsForwarderWithDomains(mockServerUrl, `["example.com", "domainname.com", "example.internal"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "id", "dnsf-xxx"),
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "display_name", "dns1"),
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "domains.#", "3"),
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "domains.0", "domainname.com"),
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "domains.1", "example.com"),
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "domains.2", "example.internal"),
+					resource.TestCheckResourceAttr(dnsForwarderResourceLabel, "forward_via_ip.0.dns_server_ips.#", "2")),
+			},
 		},
 	})
+
+	checkStubCount(t, wiremockClient, updateDnsForwarderStub, fmt.Sprintf("PATCH %s", dnsForwarderReadUrlPath), expectedCountOne)
 }
 
 func testAccCheckResourceDnsForwarderWithIdSet(mockServerUrl string) string {
+	return testAccCheckResourceDnsForwarderWithDomains(mockServerUrl, `["example.com", "domainname.com"]`)
+}
+
+func testAccCheckResourceDnsForwarderWithDomains(mockServerUrl, domains string) string {
 	return fmt.Sprintf(`
     provider "confluent" {
         endpoint = "%s"
@@ -130,7 +171,7 @@ func testAccCheckResourceDnsForwarderWithIdSet(mockServerUrl string) string {
 		environment {
 			id = "env-xxx"
 		}
-		domains = ["example.com", "domainname.com"]
+		domains = %s
 		gateway {
 			id = "gw-xxx"
 		}
@@ -138,5 +179,5 @@ func testAccCheckResourceDnsForwarderWithIdSet(mockServerUrl string) string {
 			dns_server_ips = ["10.200.0.0", "10.200.0.1"]
 		}
 	}
-	`, mockServerUrl)
+	`, mockServerUrl, domains)
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	netap "github.com/confluentinc/ccloud-sdk-go-v2/networking-access-point/v1"
@@ -60,6 +61,10 @@ func dnsRecordResource() *schema.Resource {
 			paramGateway:                requiredGateway(),
 			paramEnvironment:            environmentSchema(),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff, validateReferencedGatewayCustomizeDiff),
 	}
 }
 
@@ -87,7 +92,10 @@ func dnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	displayName := d.Get(paramDisplayName).(string)
 	domain := d.Get(paramDomain).(string)
 	gatewayId := extractStringValueFromBlock(d, paramGateway, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating DNS Record: %s", createDescriptiveError(err))
+	}
 
 	isPrivateLinkAccessPoint := len(d.Get(paramPrivateLinkAccessPoint).([]interface{})) > 0
 
@@ -124,7 +132,7 @@ func dnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 	d.SetId(createdDnsRecord.GetId())
 
-	if err := waitForDnsRecordToProvision(c.netAPApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForDnsRecordToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for DNS Record %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -197,7 +205,7 @@ func dnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.Errorf("error deleting DNS Record %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForDnsRecordToBeDeleted(c.netAPApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForDnsRecordToBeDeleted(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for DNS Record %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
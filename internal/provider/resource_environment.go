@@ -24,10 +24,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
+	"time"
 )
 
 const (
 	paramStreamGovernance = "stream_governance"
+
+	environmentAPIUpdateTimeout = 20 * time.Minute
 )
 
 func environmentResource() *schema.Resource {
@@ -52,13 +55,21 @@ func environmentResource() *schema.Resource {
 				Computed:    true,
 				Description: "The Confluent Resource Name of the Environment.",
 			},
+			paramDeletionProtection: deletionProtectionSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(environmentAPIUpdateTimeout),
 		},
 	}
 }
 
 func environmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramDisplayName, paramStreamGovernance) {
-		return diag.Errorf("error updating Environment %q: only %q or %q attributes can be updated for Environment", d.Id(), paramDisplayName, paramStreamGovernance)
+	if d.HasChangesExcept(paramDisplayName, paramStreamGovernance, paramDeletionProtection) {
+		return diag.Errorf("error updating Environment %q: only %q, %q or %q attributes can be updated for Environment", d.Id(), paramDisplayName, paramStreamGovernance, paramDeletionProtection)
+	}
+	// deletion_protection isn't sent to Confluent Cloud API
+	if !d.HasChangesExcept(paramDeletionProtection) {
+		return environmentRead(ctx, d, meta)
 	}
 
 	updateEnvironmentRequest := org.NewOrgV2Environment()
@@ -79,12 +90,21 @@ func environmentUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	tflog.Debug(ctx, fmt.Sprintf("Updating Environment %q: %s", d.Id(), updateEnvironmentRequestJson), map[string]interface{}{environmentLoggingKey: d.Id()})
 
 	c := meta.(*Client)
+	defer c.responseCache.invalidate(environmentCacheKey(d.Id()))
 	updatedEnvironment, _, err := c.orgClient.EnvironmentsOrgV2Api.UpdateOrgV2Environment(c.orgApiContext(ctx), d.Id()).OrgV2Environment(*updateEnvironmentRequest).Execute()
 
 	if err != nil {
 		return diag.Errorf("error updating Environment %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
+	// Stream Governance package upgrades and downgrades are applied asynchronously
+	if d.HasChange(getNestedStreamGovernancePackageKey()) {
+		updatedPackage := extractStringValueFromBlock(d, paramStreamGovernance, paramPackage)
+		if err := waitForEnvironmentStreamGovernancePackageToBeUpdated(ctx, c, d.Id(), updatedPackage, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Stream Governance package of Environment %q to be updated: %s", d.Id(), createDescriptiveError(err))
+		}
+	}
+
 	updatedEnvironmentJson, err := json.Marshal(updatedEnvironment)
 	if err != nil {
 		return diag.Errorf("error updating Environment %q: error marshaling %#v to json: %s", d.Id(), updatedEnvironment, createDescriptiveError(err))
@@ -132,7 +152,11 @@ func executeEnvironmentCreate(ctx context.Context, c *Client, environment *org.O
 
 func environmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Environment %q", d.Id()), map[string]interface{}{environmentLoggingKey: d.Id()})
+	if diags := checkDeletionProtection(d, "Environment"); diags != nil {
+		return diags
+	}
 	c := meta.(*Client)
+	defer c.responseCache.invalidate(environmentCacheKey(d.Id()))
 
 	req := c.orgClient.EnvironmentsOrgV2Api.DeleteOrgV2Environment(c.orgApiContext(ctx), d.Id())
 	_, err := req.Execute()
@@ -151,6 +175,21 @@ func executeEnvironmentRead(ctx context.Context, c *Client, environmentId string
 	return req.Execute()
 }
 
+// executeCachedEnvironmentRead is meant for lookups of an Environment that other objects reference;
+// it shouldn't be used for refreshing the Environment resource itself or for polling.
+func executeCachedEnvironmentRead(ctx context.Context, c *Client, environmentId string) (org.OrgV2Environment, error) {
+	cacheKey := environmentCacheKey(environmentId)
+	if cachedEnvironment, ok := c.responseCache.get(cacheKey); ok {
+		return cachedEnvironment.(org.OrgV2Environment), nil
+	}
+	environment, _, err := executeEnvironmentRead(ctx, c, environmentId)
+	if err != nil {
+		return environment, err
+	}
+	c.responseCache.set(cacheKey, environment)
+	return environment, nil
+}
+
 func environmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Environment %q", d.Id()), map[string]interface{}{environmentLoggingKey: d.Id()})
 	c := meta.(*Client)
@@ -176,6 +215,9 @@ func environmentRead(ctx context.Context, d *schema.ResourceData, meta interface
 	if _, err := setEnvironmentAttributes(d, environment); err != nil {
 		return diag.FromErr(createDescriptiveError(err))
 	}
+	if err := setDeletionProtectionDefault(d); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Environment %q", d.Id()), map[string]interface{}{environmentLoggingKey: d.Id()})
 
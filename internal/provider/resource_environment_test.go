@@ -27,14 +27,18 @@ import (
 )
 
 const (
-	scenarioStateEnvHasBeenCreated     = "The new environment has been just created"
-	scenarioStateEnvNameHasBeenUpdated = "The new environment's name has been just updated"
-	scenarioStateEnvHasBeenDeleted     = "The new environment has been deleted"
-	envScenarioName                    = "confluent_environment Resource Lifecycle"
-	envScenarioNoSgName                = "confluent_environment Resource Lifecycle Without Stream Governance"
-	expectedCountZero                  = int64(0)
-	expectedCountOne                   = int64(1)
-	expectedCountTwo                   = int64(2)
+	scenarioStateEnvHasBeenCreated        = "The new environment has been just created"
+	scenarioStateEnvNameHasBeenUpdated    = "The new environment's name has been just updated"
+	scenarioStateEnvHasBeenDeleted        = "The new environment has been deleted"
+	scenarioStateEnvPackageIsUpdating     = "The new environment's Stream Governance package is being updated"
+	scenarioStateEnvPackageHasBeenUpdated = "The new environment's Stream Governance package has been updated"
+	envScenarioName                       = "confluent_environment Resource Lifecycle"
+	envScenarioNoSgName                   = "confluent_environment Resource Lifecycle Without Stream Governance"
+	envScenarioPackageUpdateName          = "confluent_environment Resource Stream Governance Package Update"
+	expectedCountZero                     = int64(0)
+	expectedCountOne                      = int64(1)
+	expectedCountTwo                      = int64(2)
+	expectedCountThree                    = int64(3)
 )
 
 var contentTypeJSONHeader = map[string]string{"Content-Type": "application/json"}
@@ -170,6 +174,132 @@ func TestAccEnvironment(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteEnvStub, "DELETE /org/v2/environments/env-1jrymj", expectedCountOne)
 }
 
+func TestAccEnvironmentStreamGovernancePackageUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createEnvResponse, _ := ioutil.ReadFile("../testdata/environment/create_env.json")
+	createEnvStub := wiremock.Post(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(envScenarioPackageUpdateName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateEnvHasBeenCreated).
+		WillReturn(
+			string(createEnvResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createEnvStub)
+
+	readCreatedEnvResponse, _ := ioutil.ReadFile("../testdata/environment/read_created_env.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments/env-1jrymj")).
+		InScenario(envScenarioPackageUpdateName).
+		WhenScenarioStateIs(scenarioStateEnvHasBeenCreated).
+		WillReturn(
+			string(readCreatedEnvResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	patchEnvStub := wiremock.Patch(wiremock.URLPathEqualTo("/org/v2/environments/env-1jrymj")).
+		InScenario(envScenarioPackageUpdateName).
+		WithBodyPattern(wiremock.Contains(`"package":"ADVANCED"`)).
+		WhenScenarioStateIs(scenarioStateEnvHasBeenCreated).
+		WillSetStateTo(scenarioStateEnvPackageIsUpdating).
+		WillReturn(
+			string(readCreatedEnvResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(patchEnvStub)
+
+	// The environment still reports the previous package right after the update request
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments/env-1jrymj")).
+		InScenario(envScenarioPackageUpdateName).
+		WhenScenarioStateIs(scenarioStateEnvPackageIsUpdating).
+		WillSetStateTo(scenarioStateEnvPackageHasBeenUpdated).
+		WillReturn(
+			string(readCreatedEnvResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedEnvResponse, _ := ioutil.ReadFile("../testdata/environment/read_updated_package_env.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments/env-1jrymj")).
+		InScenario(envScenarioPackageUpdateName).
+		WhenScenarioStateIs(scenarioStateEnvPackageHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedEnvResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteEnvStub := wiremock.Delete(wiremock.URLPathEqualTo("/org/v2/environments/env-1jrymj")).
+		InScenario(envScenarioPackageUpdateName).
+		WhenScenarioStateIs(scenarioStateEnvPackageHasBeenUpdated).
+		WillSetStateTo(scenarioStateEnvHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteEnvStub)
+
+	readDeletedEnvResponse, _ := ioutil.ReadFile("../testdata/environment/read_deleted_env.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments/env-1jrymj")).
+		InScenario(envScenarioPackageUpdateName).
+		WhenScenarioStateIs(scenarioStateEnvHasBeenDeleted).
+		WillReturn(
+			string(readDeletedEnvResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	environmentDisplayName := "test_env_display_name"
+	environmentResourceLabel := "test_env_resource_label"
+	fullEnvironmentResourceLabel := fmt.Sprintf("confluent_environment.%s", environmentResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckEnvironmentConfig(mockServerUrl, environmentResourceLabel, environmentDisplayName, "ESSENTIALS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(fullEnvironmentResourceLabel),
+					resource.TestCheckResourceAttr(fullEnvironmentResourceLabel, "id", testEnvironmentId),
+					resource.TestCheckResourceAttr(fullEnvironmentResourceLabel, getNestedStreamGovernancePackageKey(), "ESSENTIALS"),
+				),
+			},
+			{
+				// Upgrading the Stream Governance package doesn't recreate the environment
+				Config: testAccCheckEnvironmentConfig(mockServerUrl, environmentResourceLabel, environmentDisplayName, "ADVANCED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(fullEnvironmentResourceLabel),
+					resource.TestCheckResourceAttr(fullEnvironmentResourceLabel, "id", testEnvironmentId),
+					resource.TestCheckResourceAttr(fullEnvironmentResourceLabel, "display_name", environmentDisplayName),
+					resource.TestCheckResourceAttr(fullEnvironmentResourceLabel, getNestedStreamGovernancePackageKey(), "ADVANCED"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createEnvStub, "POST /org/v2/environments", expectedCountOne)
+	checkStubCount(t, wiremockClient, patchEnvStub, "PATCH /org/v2/environments/env-1jrymj", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteEnvStub, "DELETE /org/v2/environments/env-1jrymj", expectedCountOne)
+}
+
 func TestAccEnvironmentWithoutSg(t *testing.T) {
 	ctx := context.Background()
 
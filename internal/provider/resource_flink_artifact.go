@@ -0,0 +1,373 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramContentFormat = "content_format"
+	paramArtifactFile  = "artifact_file"
+	paramVersions      = "versions"
+
+	artifactPresignedUrlPath = "/artifact/v1/presigned-upload-url"
+	flinkArtifactsPath       = "/artifact/v1/flink-artifacts"
+
+	flinkArtifactContentFormatJar = "JAR"
+	flinkArtifactContentFormatZip = "ZIP"
+)
+
+var acceptedFlinkArtifactContentFormats = []string{flinkArtifactContentFormatJar, flinkArtifactContentFormatZip}
+
+// artifactPresignedUrlRequest represents artifact.v1.PresignedUrlRequest since ccloud-sdk-go-v2 doesn't have an Artifact SDK yet
+type artifactPresignedUrlRequest struct {
+	ContentFormat string `json:"content_format"`
+	Cloud         string `json:"cloud"`
+	Region        string `json:"region"`
+	Environment   string `json:"environment"`
+}
+
+type artifactPresignedUrl struct {
+	UploadId       string         `json:"upload_id"`
+	UploadUrl      string         `json:"upload_url"`
+	UploadFormData map[string]any `json:"upload_form_data"`
+}
+
+// artifactFlinkArtifact represents artifact.v1.FlinkArtifact
+type artifactFlinkArtifact struct {
+	ApiVersion    string                         `json:"api_version,omitempty"`
+	Kind          string                         `json:"kind,omitempty"`
+	Id            string                         `json:"id,omitempty"`
+	DisplayName   string                         `json:"display_name,omitempty"`
+	Description   string                         `json:"description,omitempty"`
+	Cloud         string                         `json:"cloud,omitempty"`
+	Region        string                         `json:"region,omitempty"`
+	Environment   string                         `json:"environment,omitempty"`
+	ContentFormat string                         `json:"content_format,omitempty"`
+	UploadSource  *artifactUploadSource          `json:"upload_source,omitempty"`
+	Versions      []artifactFlinkArtifactVersion `json:"versions,omitempty"`
+}
+
+type artifactUploadSource struct {
+	Location string `json:"location"`
+	UploadId string `json:"upload_id"`
+}
+
+type artifactFlinkArtifactVersion struct {
+	Version string `json:"version"`
+}
+
+func flinkArtifactResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: flinkArtifactCreate,
+		ReadContext:   flinkArtifactRead,
+		DeleteContext: flinkArtifactDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flinkArtifactImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The display name of the Flink Artifact.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A free-form description of the Flink Artifact.",
+			},
+			paramCloud: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The cloud service provider that runs the Flink Artifact.",
+				ValidateFunc: validation.StringInSlice(acceptedCloudProviders, false),
+			},
+			paramRegion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The cloud service provider region that hosts the Flink Artifact.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramContentFormat: {
+				Type:     schema.TypeString,
+				Optional: true,
+				// Computed when it's inferred from the extension of artifact_file
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Archive format of the Flink Artifact.",
+				ValidateFunc: validation.StringInSlice(acceptedFlinkArtifactContentFormats, false),
+			},
+			paramArtifactFile: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The artifact file for the Flink Artifact.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramVersions: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the Flink Artifact.",
+						},
+					},
+				},
+				Description: "List of versions for the Flink Artifact.",
+			},
+			paramEnvironment: environmentSchema(),
+		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
+	}
+}
+
+func flinkArtifactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Flink Artifact: %s", createDescriptiveError(err))
+	}
+	displayName := d.Get(paramDisplayName).(string)
+	cloud := d.Get(paramCloud).(string)
+	region := d.Get(paramRegion).(string)
+	artifactFile := d.Get(paramArtifactFile).(string)
+	contentFormat, err := extractFlinkArtifactContentFormat(d.Get(paramContentFormat).(string), artifactFile)
+	if err != nil {
+		return diag.Errorf("error creating Flink Artifact: %s", createDescriptiveError(err))
+	}
+
+	// Part 1: Upload the artifact file to a presigned URL
+	uploadId, err := uploadFlinkArtifact(ctx, c, artifactFile, contentFormat, cloud, region, environmentId)
+	if err != nil {
+		return diag.Errorf("error creating Flink Artifact: %s", createDescriptiveError(err))
+	}
+
+	// Part 2: Create the Flink Artifact from the uploaded file
+	createFlinkArtifactRequest := &artifactFlinkArtifact{
+		DisplayName:   displayName,
+		Description:   d.Get(paramDescription).(string),
+		Cloud:         cloud,
+		Region:        region,
+		Environment:   environmentId,
+		ContentFormat: contentFormat,
+		UploadSource: &artifactUploadSource{
+			Location: presignedUrlLocation,
+			UploadId: uploadId,
+		},
+	}
+	createFlinkArtifactRequestJson, err := json.Marshal(createFlinkArtifactRequest)
+	if err != nil {
+		return diag.Errorf("error creating Flink Artifact: error marshaling %#v to json: %s", createFlinkArtifactRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Flink Artifact: %s", createFlinkArtifactRequestJson))
+
+	var createdFlinkArtifact artifactFlinkArtifact
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodPost, flinkArtifactsCreatePath(cloud, region), createFlinkArtifactRequest, &createdFlinkArtifact); err != nil {
+		return diag.Errorf("error creating Flink Artifact %q: %s", displayName, createDescriptiveError(err))
+	}
+	d.SetId(createdFlinkArtifact.Id)
+
+	createdFlinkArtifactJson, err := json.Marshal(createdFlinkArtifact)
+	if err != nil {
+		return diag.Errorf("error creating Flink Artifact %q: error marshaling %#v to json: %s", d.Id(), createdFlinkArtifact, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Flink Artifact %q: %s", d.Id(), createdFlinkArtifactJson), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+
+	return flinkArtifactRead(ctx, d, meta)
+}
+
+func uploadFlinkArtifact(ctx context.Context, c *Client, artifactFile, contentFormat, cloud, region, environmentId string) (string, error) {
+	createPresignedUrlRequest := &artifactPresignedUrlRequest{
+		ContentFormat: contentFormat,
+		Cloud:         cloud,
+		Region:        region,
+		Environment:   environmentId,
+	}
+	var createdPresignedUrl artifactPresignedUrl
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodPost, artifactPresignedUrlPath, createPresignedUrlRequest, &createdPresignedUrl); err != nil {
+		return "", fmt.Errorf("error uploading Flink Artifact: error fetching presigned upload URL: %s", createDescriptiveError(err))
+	}
+
+	if err := uploadFile(createdPresignedUrl.UploadUrl, artifactFile, createdPresignedUrl.UploadFormData); err != nil {
+		return "", fmt.Errorf("error uploading Flink Artifact: error uploading a file: %s", err)
+	}
+	return createdPresignedUrl.UploadId, nil
+}
+
+// extractFlinkArtifactContentFormat infers the content format from the extension of the artifact file unless it's set explicitly
+func extractFlinkArtifactContentFormat(contentFormat, artifactFile string) (string, error) {
+	if contentFormat != "" {
+		return contentFormat, nil
+	}
+	extension := strings.ToUpper(strings.TrimPrefix(filepath.Ext(artifactFile), "."))
+	if extension != flinkArtifactContentFormatJar && extension != flinkArtifactContentFormatZip {
+		return "", fmt.Errorf("could not infer %q from %q: only file extensions \".jar\" and \".zip\" are allowed, set %q explicitly otherwise", paramContentFormat, artifactFile, paramContentFormat)
+	}
+	return extension, nil
+}
+
+func executeFlinkArtifactRead(ctx context.Context, c *Client, environmentId, cloud, region, flinkArtifactId string) (artifactFlinkArtifact, *http.Response, error) {
+	var flinkArtifact artifactFlinkArtifact
+	resp, err := executeCloudApiRequest(ctx, c, http.MethodGet, flinkArtifactPath(environmentId, cloud, region, flinkArtifactId), nil, &flinkArtifact)
+	return flinkArtifact, resp, err
+}
+
+func flinkArtifactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Flink Artifact %q", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	cloud := d.Get(paramCloud).(string)
+	region := d.Get(paramRegion).(string)
+	artifactFile := d.Get(paramArtifactFile).(string)
+	if _, err := readFlinkArtifactAndSetAttributes(ctx, d, meta, environmentId, cloud, region, d.Id(), artifactFile); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Flink Artifact %q: %s", d.Id(), createDescriptiveError(err)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Flink Artifact %q", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+
+	return nil
+}
+
+func readFlinkArtifactAndSetAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentId, cloud, region, flinkArtifactId, artifactFile string) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	flinkArtifact, resp, err := executeFlinkArtifactRead(ctx, c, environmentId, cloud, region, flinkArtifactId)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Flink Artifact %q: %s", flinkArtifactId, createDescriptiveError(err)), map[string]interface{}{flinkArtifactLoggingKey: flinkArtifactId})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Flink Artifact %q in TF state because Flink Artifact could not be found on the server", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+			d.SetId("")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	flinkArtifactJson, err := json.Marshal(flinkArtifact)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Flink Artifact %q: error marshaling %#v to json: %s", flinkArtifactId, flinkArtifact, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Flink Artifact %q: %s", flinkArtifactId, flinkArtifactJson), map[string]interface{}{flinkArtifactLoggingKey: flinkArtifactId})
+
+	if _, err := setFlinkArtifactAttributes(d, flinkArtifact, environmentId, artifactFile); err != nil {
+		return nil, createDescriptiveError(err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func flinkArtifactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Flink Artifact %q", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	cloud := d.Get(paramCloud).(string)
+	region := d.Get(paramRegion).(string)
+
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodDelete, flinkArtifactPath(environmentId, cloud, region, d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting Flink Artifact %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Flink Artifact %q", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+
+	return nil
+}
+
+func flinkArtifactImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Flink Artifact %q", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+
+	importId := d.Id()
+	parts := strings.Split(importId, "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("error importing Flink Artifact: invalid format: expected '<env ID>/<cloud>/<region>/<Flink Artifact ID>'")
+	}
+	environmentId, cloud, region, flinkArtifactId := parts[0], parts[1], parts[2], parts[3]
+	d.SetId(flinkArtifactId)
+
+	// The artifact file can't be read back from the server
+	artifactFile := getEnv("IMPORT_FLINK_ARTIFACT_FILE", "")
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readFlinkArtifactAndSetAttributes(ctx, d, meta, environmentId, cloud, region, flinkArtifactId, artifactFile); err != nil {
+		return nil, fmt.Errorf("error importing Flink Artifact %q: %s", d.Id(), err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Flink Artifact %q", d.Id()), map[string]interface{}{flinkArtifactLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func setFlinkArtifactAttributes(d *schema.ResourceData, flinkArtifact artifactFlinkArtifact, environmentId, artifactFile string) (*schema.ResourceData, error) {
+	if err := d.Set(paramDisplayName, flinkArtifact.DisplayName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramDescription, flinkArtifact.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramCloud, flinkArtifact.Cloud); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramRegion, flinkArtifact.Region); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramContentFormat, flinkArtifact.ContentFormat); err != nil {
+		return nil, err
+	}
+	versions := make([]map[string]interface{}, len(flinkArtifact.Versions))
+	for i, version := range flinkArtifact.Versions {
+		versions[i] = map[string]interface{}{
+			paramVersion: version.Version,
+		}
+	}
+	if err := d.Set(paramVersions, versions); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramArtifactFile, artifactFile); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, environmentId, d); err != nil {
+		return nil, err
+	}
+	d.SetId(flinkArtifact.Id)
+	return d, nil
+}
+
+func flinkArtifactsCreatePath(cloud, region string) string {
+	return fmt.Sprintf("%s?%s", flinkArtifactsPath, url.Values{"cloud": {cloud}, "region": {region}}.Encode())
+}
+
+func flinkArtifactPath(environmentId, cloud, region, flinkArtifactId string) string {
+	return fmt.Sprintf("%s/%s?%s", flinkArtifactsPath, url.PathEscape(flinkArtifactId), url.Values{"cloud": {cloud}, "region": {region}, "environment": {environmentId}}.Encode())
+}
@@ -0,0 +1,253 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateFlinkArtifactPresignedUrlHasBeenCreated = "The new Flink Artifact's presigned URL has been just created"
+	scenarioStateFlinkArtifactHasBeenCreated             = "The new Flink Artifact has been just created"
+	scenarioStateFlinkArtifactHasBeenDeleted             = "The new Flink Artifact has been deleted"
+	flinkArtifactScenarioName                            = "confluent_flink_artifact Resource Lifecycle"
+
+	flinkArtifactId            = "cfa-ld6g2q"
+	flinkArtifactVersion       = "ver-1y2x3z"
+	flinkArtifactDisplayName   = "flink_udf"
+	flinkArtifactDescription   = "Flink user-defined functions"
+	flinkArtifactCloud         = "AWS"
+	flinkArtifactRegion        = "us-east-2"
+	flinkArtifactEnvironmentId = "env-00000"
+	flinkArtifactFile          = "udf.jar"
+	flinkArtifactUploadId      = "e53bb2e8-8de3-49fa-9fb1-4e3fd9a16b66"
+	flinkArtifactResourceLabel = "test_flink_artifact_resource_label"
+)
+
+var flinkArtifactUrlPath = fmt.Sprintf("/artifact/v1/flink-artifacts/%s", flinkArtifactId)
+
+func TestAccFlinkArtifact(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createPresignedUrlResponse, _ := ioutil.ReadFile("../testdata/flink_artifact/read_presigned_url.json")
+	createPresignedUrlStub := wiremock.Post(wiremock.URLPathEqualTo("/artifact/v1/presigned-upload-url")).
+		InScenario(flinkArtifactScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(`"content_format":"JAR"`)).
+		WillSetStateTo(scenarioStateFlinkArtifactPresignedUrlHasBeenCreated).
+		WillReturn(
+			string(createPresignedUrlResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(createPresignedUrlStub)
+
+	createFlinkArtifactResponse, _ := ioutil.ReadFile("../testdata/flink_artifact/create_flink_artifact.json")
+	createFlinkArtifactStub := wiremock.Post(wiremock.URLPathEqualTo("/artifact/v1/flink-artifacts")).
+		InScenario(flinkArtifactScenarioName).
+		WhenScenarioStateIs(scenarioStateFlinkArtifactPresignedUrlHasBeenCreated).
+		WithQueryParam("cloud", wiremock.EqualTo(flinkArtifactCloud)).
+		WithQueryParam("region", wiremock.EqualTo(flinkArtifactRegion)).
+		// The Flink Artifact must be created from the file uploaded to the presigned URL
+		WithBodyPattern(wiremock.Contains(flinkArtifactUploadId)).
+		WillSetStateTo(scenarioStateFlinkArtifactHasBeenCreated).
+		WillReturn(
+			string(createFlinkArtifactResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(createFlinkArtifactStub)
+
+	readCreatedFlinkArtifactResponse, _ := ioutil.ReadFile("../testdata/flink_artifact/read_created_flink_artifact.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(flinkArtifactUrlPath)).
+		InScenario(flinkArtifactScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkArtifactEnvironmentId)).
+		WithQueryParam("cloud", wiremock.EqualTo(flinkArtifactCloud)).
+		WithQueryParam("region", wiremock.EqualTo(flinkArtifactRegion)).
+		WhenScenarioStateIs(scenarioStateFlinkArtifactHasBeenCreated).
+		WillReturn(
+			string(readCreatedFlinkArtifactResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedFlinkArtifactResponse, _ := ioutil.ReadFile("../testdata/flink_artifact/read_deleted_flink_artifact.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(flinkArtifactUrlPath)).
+		InScenario(flinkArtifactScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkArtifactEnvironmentId)).
+		WithQueryParam("cloud", wiremock.EqualTo(flinkArtifactCloud)).
+		WithQueryParam("region", wiremock.EqualTo(flinkArtifactRegion)).
+		WhenScenarioStateIs(scenarioStateFlinkArtifactHasBeenDeleted).
+		WillReturn(
+			string(readDeletedFlinkArtifactResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteFlinkArtifactStub := wiremock.Delete(wiremock.URLPathEqualTo(flinkArtifactUrlPath)).
+		InScenario(flinkArtifactScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkArtifactEnvironmentId)).
+		WithQueryParam("cloud", wiremock.EqualTo(flinkArtifactCloud)).
+		WithQueryParam("region", wiremock.EqualTo(flinkArtifactRegion)).
+		WhenScenarioStateIs(scenarioStateFlinkArtifactHasBeenCreated).
+		WillSetStateTo(scenarioStateFlinkArtifactHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteFlinkArtifactStub)
+
+	fullFlinkArtifactResourceLabel := fmt.Sprintf("confluent_flink_artifact.%s", flinkArtifactResourceLabel)
+
+	// The artifact file can't be read back from the server
+	_ = os.Setenv("IMPORT_FLINK_ARTIFACT_FILE", flinkArtifactFile)
+	defer func() {
+		_ = os.Unsetenv("IMPORT_FLINK_ARTIFACT_FILE")
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckFlinkArtifactDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckFlinkArtifactConfig(mockServerUrl, "udf.tar.gz"),
+				ExpectError: regexp.MustCompile("only file extensions \".jar\" and \".zip\" are allowed"),
+			},
+			{
+				Config: testAccCheckFlinkArtifactConfig(mockServerUrl, flinkArtifactFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "id", flinkArtifactId),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "display_name", flinkArtifactDisplayName),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "description", flinkArtifactDescription),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "cloud", flinkArtifactCloud),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "region", flinkArtifactRegion),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "content_format", "JAR"),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "artifact_file", flinkArtifactFile),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "versions.#", "1"),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "versions.0.version", flinkArtifactVersion),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "environment.#", "1"),
+					resource.TestCheckResourceAttr(fullFlinkArtifactResourceLabel, "environment.0.id", flinkArtifactEnvironmentId),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullFlinkArtifactResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.RootModule().Resources
+					attributes := resources[fullFlinkArtifactResourceLabel].Primary.Attributes
+					return fmt.Sprintf("%s/%s/%s/%s", attributes["environment.0.id"], attributes["cloud"], attributes["region"], resources[fullFlinkArtifactResourceLabel].Primary.ID), nil
+				},
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createPresignedUrlStub, "POST /artifact/v1/presigned-upload-url", expectedCountOne)
+	checkStubCount(t, wiremockClient, createFlinkArtifactStub, "POST /artifact/v1/flink-artifacts", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteFlinkArtifactStub, fmt.Sprintf("DELETE %s", flinkArtifactUrlPath), expectedCountOne)
+}
+
+func TestExtractFlinkArtifactContentFormat(t *testing.T) {
+	tests := []struct {
+		name          string
+		contentFormat string
+		artifactFile  string
+		expected      string
+		expectError   bool
+	}{
+		{name: "jar", contentFormat: "", artifactFile: "udf.jar", expected: "JAR"},
+		{name: "zip in upper case", contentFormat: "", artifactFile: "/tmp/UDF.ZIP", expected: "ZIP"},
+		{name: "explicit content format", contentFormat: "ZIP", artifactFile: "udf", expected: "ZIP"},
+		{name: "unsupported extension", contentFormat: "", artifactFile: "udf.tar.gz", expectError: true},
+		{name: "no extension", contentFormat: "", artifactFile: "udf", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := extractFlinkArtifactContentFormat(tt.contentFormat, tt.artifactFile)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Fatalf("Unexpected result: expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func testAccCheckFlinkArtifactDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each Flink Artifact is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_flink_artifact" {
+			continue
+		}
+		deletedFlinkArtifact, response, err := executeFlinkArtifactRead(context.Background(), c, rs.Primary.Attributes["environment.0.id"], rs.Primary.Attributes["cloud"], rs.Primary.Attributes["region"], rs.Primary.ID)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedFlinkArtifact.Id == rs.Primary.ID {
+			return fmt.Errorf("Flink Artifact (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckFlinkArtifactConfig(mockServerUrl, artifactFile string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_flink_artifact" "%s" {
+		display_name = "%s"
+		description = "%s"
+		cloud = "%s"
+		region = "%s"
+		artifact_file = "%s"
+		environment {
+			id = "%s"
+		}
+	}
+	`, mockServerUrl, flinkArtifactResourceLabel, flinkArtifactDisplayName, flinkArtifactDescription, flinkArtifactCloud, flinkArtifactRegion, artifactFile, flinkArtifactEnvironmentId)
+}
@@ -21,6 +21,7 @@ import (
 	fcpm "github.com/confluentinc/ccloud-sdk-go-v2/flink/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -32,10 +33,12 @@ const (
 	paramMaxCfu = "max_cfu"
 
 	fcpmAPICreateTimeout = 1 * time.Hour
+	fcpmAPIUpdateTimeout = 1 * time.Hour
 	fcpmAPIDeleteTimeout = 1 * time.Hour
 )
 
 var acceptedComputePoolTypes = []string{paramStandardCluster}
+var acceptedMaxCfuValues = []int{5, 10, 20, 30, 40, 50}
 
 func computePoolResource() *schema.Resource {
 	return &schema.Resource{
@@ -75,10 +78,11 @@ func computePoolResource() *schema.Resource {
 				ForceNew:     true,
 			},
 			paramMaxCfu: {
-				Type:        schema.TypeInt,
-				Description: "Maximum number of Confluent Flink Units (CFUs) that the Flink compute pool should auto-scale to.",
-				Optional:    true,
-				Computed:    true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of Confluent Flink Units (CFUs) that the Flink compute pool should auto-scale to.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice(acceptedMaxCfuValues),
 			},
 			paramEnvironment: environmentSchema(),
 			paramApiVersion: {
@@ -97,8 +101,10 @@ func computePoolResource() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(fcpmAPICreateTimeout),
+			Update: schema.DefaultTimeout(fcpmAPIUpdateTimeout),
 			Delete: schema.DefaultTimeout(fcpmAPIDeleteTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -111,7 +117,10 @@ func computePoolCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	// Non-zero value means maxCfu has been set
 	maxCfu := d.Get(paramMaxCfu).(int)
 
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Flink Compute Pool: %s", createDescriptiveError(err))
+	}
 
 	spec := fcpm.NewFcpmV2ComputePoolSpec()
 	spec.SetDisplayName(displayName)
@@ -133,7 +142,7 @@ func computePoolCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.SetId(createdComputePool.GetId())
 
-	if err := waitForComputePoolToProvision(c.fcpmApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForComputePoolToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Flink Compute Pool %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -279,6 +288,13 @@ func computePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error updating Flink Compute Pool %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
+	// Changing max_cfu moves the Flink Compute Pool back to PROVISIONING
+	if d.HasChange(paramMaxCfu) {
+		if err := waitForComputePoolToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Flink Compute Pool %q to be updated: %s", d.Id(), createDescriptiveError(err))
+		}
+	}
+
 	updatedComputePoolJson, err := json.Marshal(updatedComputePool)
 	if err != nil {
 		return diag.Errorf("error updating Flink Compute Pool %q: error marshaling %#v to json: %s", d.Id(), updatedComputePool, createDescriptiveError(err))
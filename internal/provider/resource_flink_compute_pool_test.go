@@ -20,6 +20,7 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -31,6 +32,8 @@ const (
 	scenarioStateComputePoolIsProvisioning = "The new compute pool is in provisioning state"
 	scenarioStateComputePoolHasBeenCreated = "The new compute pool has been just created"
 	scenarioStateComputePoolHasBeenDeleted = "The new compute pool has been deleted"
+	scenarioStateComputePoolIsUpdating     = "The new compute pool is being updated"
+	scenarioStateComputePoolHasBeenUpdated = "The new compute pool has been updated"
 	flinkComputePoolScenarioName           = "confluent_flink_compute_pool Resource Lifecycle"
 	flinkComputePoolCloud                  = "AWS"
 	flinkComputePoolRegion                 = "us-east-2"
@@ -39,6 +42,8 @@ const (
 	flinkComputePoolId                     = "lfcp-abc123"
 	flinkComputePoolDisplayName            = "flink_compute_pool_0"
 	flinkComputePoolDefaultMaxCfu          = 5
+	flinkComputePoolUpdatedMaxCfu          = 10
+	flinkComputePoolInvalidMaxCfu          = 7
 	flinkComputePoolApiVersion             = "fcpm/v2"
 	flinkComputePoolKind                   = "ComputePool"
 	flinkComputePoolRestEndpoint           = "https://flink.us-east-2.aws.confluent.cloud/sql/v1alpha1/environments/env-gz903"
@@ -180,6 +185,152 @@ func TestAccComputePool(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteComputePoolStub, fmt.Sprintf("DELETE %s?environment=%s", flinkComputePoolUrlPath, flinkComputePoolEnvironmentId), expectedCountOne)
 }
 
+func TestAccComputePoolMaxCfuUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createComputePoolResponse, _ := ioutil.ReadFile("../testdata/compute_pool/create_compute_pool.json")
+	createComputePoolStub := wiremock.Post(wiremock.URLPathEqualTo("/fcpm/v2/compute-pools")).
+		InScenario(flinkComputePoolScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateComputePoolHasBeenCreated).
+		WillReturn(
+			string(createComputePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createComputePoolStub)
+
+	readCreatedComputePoolResponse, _ := ioutil.ReadFile("../testdata/compute_pool/read_created_compute_pool.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(flinkComputePoolUrlPath)).
+		InScenario(flinkComputePoolScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkComputePoolEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateComputePoolHasBeenCreated).
+		WillReturn(
+			string(readCreatedComputePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatingComputePoolResponse, _ := ioutil.ReadFile("../testdata/compute_pool/read_updating_compute_pool.json")
+	updateComputePoolStub := wiremock.Patch(wiremock.URLPathEqualTo(flinkComputePoolUrlPath)).
+		InScenario(flinkComputePoolScenarioName).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"max_cfu":%d`, flinkComputePoolUpdatedMaxCfu))).
+		WhenScenarioStateIs(scenarioStateComputePoolHasBeenCreated).
+		WillSetStateTo(scenarioStateComputePoolIsUpdating).
+		WillReturn(
+			string(readUpdatingComputePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateComputePoolStub)
+
+	// The compute pool is provisioning right after max_cfu has been updated
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(flinkComputePoolUrlPath)).
+		InScenario(flinkComputePoolScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkComputePoolEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateComputePoolIsUpdating).
+		WillSetStateTo(scenarioStateComputePoolHasBeenUpdated).
+		WillReturn(
+			string(readUpdatingComputePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedComputePoolResponse, _ := ioutil.ReadFile("../testdata/compute_pool/read_updated_compute_pool.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(flinkComputePoolUrlPath)).
+		InScenario(flinkComputePoolScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkComputePoolEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateComputePoolHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedComputePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteComputePoolStub := wiremock.Delete(wiremock.URLPathEqualTo(flinkComputePoolUrlPath)).
+		InScenario(flinkComputePoolScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkComputePoolEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateComputePoolHasBeenUpdated).
+		WillSetStateTo(scenarioStateComputePoolHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteComputePoolStub)
+
+	readDeletedComputePoolResponse, _ := ioutil.ReadFile("../testdata/compute_pool/read_deleted_compute_pool.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(flinkComputePoolUrlPath)).
+		InScenario(flinkComputePoolScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(flinkComputePoolEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateComputePoolHasBeenDeleted).
+		WillReturn(
+			string(readDeletedComputePoolResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	flinkComputePoolResourceLabel := "test"
+	fullComputePoolResourceLabel := fmt.Sprintf("confluent_flink_compute_pool.%s", flinkComputePoolResourceLabel)
+	createdComputePoolId := ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Unsupported max_cfu values are rejected during 'terraform plan'
+				Config:      testAccCheckComputePoolConfigWithMaxCfu(mockServerUrl, flinkComputePoolResourceLabel, flinkComputePoolInvalidMaxCfu),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf("expected %s to be one of", paramMaxCfu)),
+			},
+			{
+				Config: testAccCheckComputePoolConfigWithMaxCfu(mockServerUrl, flinkComputePoolResourceLabel, flinkComputePoolDefaultMaxCfu),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputePoolExists(fullComputePoolResourceLabel),
+					resource.TestCheckResourceAttr(fullComputePoolResourceLabel, paramMaxCfu, strconv.Itoa(flinkComputePoolDefaultMaxCfu)),
+					func(s *terraform.State) error {
+						createdComputePoolId = s.RootModule().Resources[fullComputePoolResourceLabel].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckComputePoolConfigWithMaxCfu(mockServerUrl, flinkComputePoolResourceLabel, flinkComputePoolUpdatedMaxCfu),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputePoolExists(fullComputePoolResourceLabel),
+					resource.TestCheckResourceAttr(fullComputePoolResourceLabel, paramMaxCfu, strconv.Itoa(flinkComputePoolUpdatedMaxCfu)),
+					// The compute pool is updated in place
+					func(s *terraform.State) error {
+						if updatedComputePoolId := s.RootModule().Resources[fullComputePoolResourceLabel].Primary.ID; updatedComputePoolId != createdComputePoolId {
+							return fmt.Errorf("expected compute pool ID to remain %q after updating %q, got %q", createdComputePoolId, paramMaxCfu, updatedComputePoolId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createComputePoolStub, fmt.Sprintf("POST %s", flinkComputePoolUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateComputePoolStub, fmt.Sprintf("PATCH %s", flinkComputePoolUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteComputePoolStub, fmt.Sprintf("DELETE %s?environment=%s", flinkComputePoolUrlPath, flinkComputePoolEnvironmentId), expectedCountOne)
+}
+
 func testAccCheckComputePoolDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 	// Loop through the resources in state, verifying each compute pool is destroyed
@@ -204,6 +355,10 @@ func testAccCheckComputePoolDestroy(s *terraform.State) error {
 }
 
 func testAccCheckComputePoolConfig(mockServerUrl, resourceLabel string) string {
+	return testAccCheckComputePoolConfigWithMaxCfu(mockServerUrl, resourceLabel, flinkComputePoolDefaultMaxCfu)
+}
+
+func testAccCheckComputePoolConfigWithMaxCfu(mockServerUrl, resourceLabel string, maxCfu int) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
  		endpoint = "%s"
@@ -217,7 +372,7 @@ func testAccCheckComputePoolConfig(mockServerUrl, resourceLabel string) string {
 	    }
         max_cfu = %d
 	}
-	`, mockServerUrl, resourceLabel, flinkComputePoolDisplayName, flinkComputePoolCloud, flinkComputePoolRegion, flinkComputePoolEnvironmentId, flinkComputePoolDefaultMaxCfu)
+	`, mockServerUrl, resourceLabel, flinkComputePoolDisplayName, flinkComputePoolCloud, flinkComputePoolRegion, flinkComputePoolEnvironmentId, maxCfu)
 }
 
 func testAccCheckComputePoolConfigWithoutMaxCfu(mockServerUrl, resourceLabel string) string {
@@ -155,7 +155,7 @@ func flinkStatementCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.SetId(createFlinkStatementId(flinkRestClient.environmentId, createdFlinkStatement.Spec.GetComputePoolId(), createdFlinkStatement.GetName()))
 
-	if err := waitForFlinkStatementToProvision(flinkRestClient.apiContext(ctx), flinkRestClient, createdFlinkStatement.GetName(), meta.(*Client).isAcceptanceTestMode); err != nil {
+	if err := waitForFlinkStatementToProvision(ctx, flinkRestClient, createdFlinkStatement.GetName(), d.Timeout(schema.TimeoutCreate), meta.(*Client).isAcceptanceTestMode); err != nil {
 		return diag.Errorf("error waiting for Flink Statement %q to provision: %s", createdFlinkStatement.GetName(), createDescriptiveError(err))
 	}
 
@@ -274,7 +274,7 @@ func flinkStatementUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return diag.Errorf("error updating Flink Statement 123 %q: %s", statementName, createDescriptiveError(err))
 		}
-		if err := waitForFlinkStatementToBeStopped(flinkRestClient.apiContext(ctx), flinkRestClient, statementName, meta.(*Client).isAcceptanceTestMode); err != nil {
+		if err := waitForFlinkStatementToBeStopped(ctx, flinkRestClient, statementName, meta.(*Client).isAcceptanceTestMode); err != nil {
 			return diag.Errorf("error waiting for Flink Statement %q to be stopped: %s", statementName, createDescriptiveError(err))
 		}
 	}
@@ -382,7 +382,7 @@ func flinkStatementDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("error deleting Flink Statement %q: %s", statementName, createDescriptiveError(err))
 	}
 
-	if err := waitForFlinkStatementToBeDeleted(flinkRestClient.apiContext(ctx), flinkRestClient, statementName, meta.(*Client).isAcceptanceTestMode); err != nil {
+	if err := waitForFlinkStatementToBeDeleted(ctx, flinkRestClient, statementName, meta.(*Client).isAcceptanceTestMode); err != nil {
 		return diag.Errorf("error waiting for Flink Statement %q to be deleted: %s", statementName, createDescriptiveError(err))
 	}
 
@@ -78,6 +78,7 @@ func TestAccFlinkStatement(t *testing.T) {
 		))
 
 	updateFlinkStatementResponse := wiremock.Put(wiremock.URLPathEqualTo(readFlinkStatementPath)).
+		WithBodyPattern(wiremock.Contains(`"stopped":true`)).
 		InScenario(statementScenarioName).
 		WhenScenarioStateIs(scenarioStateStatementHasBeenCreated).
 		WillSetStateTo(scenarioStateStatementIsUpdating).
@@ -241,6 +242,7 @@ func TestAccFlinkStatement(t *testing.T) {
 	})
 
 	checkStubCount(t, wiremockClient, createFlinkStatementStub, fmt.Sprintf("POST %s", createFlinkStatementPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateFlinkStatementResponse, fmt.Sprintf("PUT %s", readFlinkStatementPath), expectedCountOne)
 	checkStubCount(t, wiremockClient, deleteFlinkStatementStub, fmt.Sprintf("DELETE %s", readFlinkStatementPath), expectedCountOne)
 }
 
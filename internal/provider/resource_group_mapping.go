@@ -26,6 +26,10 @@ import (
 	"net/http"
 )
 
+const (
+	paramState = "state"
+)
+
 func groupMappingResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: groupMappingCreate,
@@ -39,13 +43,13 @@ func groupMappingResource() *schema.Resource {
 			paramDisplayName: {
 				Type:         schema.TypeString,
 				Required:     true,
+				Description:  "A human-readable name for the Group Mapping.",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			paramFilter: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
-				Description:  "A human-readable name for the Group Mapping.",
+				Description:  "A CEL expression that matches the groups from the identity provider.",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			paramDescription: {
@@ -53,13 +57,23 @@ func groupMappingResource() *schema.Resource {
 				Optional:    true,
 				Description: "A free-form description of the Group Mapping.",
 			},
+			paramPrincipal: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The principal that matched users are assigned, for example, `group-w4vP`.",
+			},
+			paramState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current state of the Group Mapping, for example, `ENABLED`.",
+			},
 		},
 	}
 }
 
 func groupMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramDisplayName, paramDescription) {
-		return diag.Errorf("error updating Group Mapping %q: only %q, %q attributes can be updated for Group Mapping", d.Id(), paramDisplayName, paramDescription)
+	if d.HasChangesExcept(paramDisplayName, paramDescription, paramFilter) {
+		return diag.Errorf("error updating Group Mapping %q: only %q, %q, %q attributes can be updated for Group Mapping", d.Id(), paramDisplayName, paramDescription, paramFilter)
 	}
 
 	updateGroupMappingRequest := sso.NewIamV2SsoGroupMapping()
@@ -72,6 +86,10 @@ func groupMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		updatedDescription := d.Get(paramDescription).(string)
 		updateGroupMappingRequest.SetDescription(updatedDescription)
 	}
+	if d.HasChange(paramFilter) {
+		updatedFilter := d.Get(paramFilter).(string)
+		updateGroupMappingRequest.SetFilter(updatedFilter)
+	}
 
 	updateGroupMappingRequestJson, err := json.Marshal(updateGroupMappingRequest)
 	if err != nil {
@@ -205,6 +223,12 @@ func setGroupMappingAttributes(d *schema.ResourceData, groupMapping sso.IamV2Sso
 	if err := d.Set(paramDescription, groupMapping.GetDescription()); err != nil {
 		return nil, createDescriptiveError(err)
 	}
+	if err := d.Set(paramPrincipal, groupMapping.GetPrincipal()); err != nil {
+		return nil, createDescriptiveError(err)
+	}
+	if err := d.Set(paramState, groupMapping.GetState()); err != nil {
+		return nil, createDescriptiveError(err)
+	}
 	d.SetId(groupMapping.GetId())
 	return d, nil
 }
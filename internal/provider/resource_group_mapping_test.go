@@ -37,6 +37,8 @@ const (
 	groupMappingDisplayName   = "Default"
 	groupMappingFilter        = "\"engineering\" in groups"
 	groupMappingDescription   = "Permission for all users in everyone group"
+	groupMappingPrincipal     = "group-w4vP"
+	groupMappingState         = "ENABLED"
 )
 
 func TestAccGroupMapping(t *testing.T) {
@@ -79,6 +81,7 @@ func TestAccGroupMapping(t *testing.T) {
 
 	readUpdatedGroupMappingResponse, _ := ioutil.ReadFile("../testdata/group_mapping/read_updated_group_mapping.json")
 	patchGroupMappingStub := wiremock.Patch(wiremock.URLPathEqualTo("/iam/v2/sso/group-mappings/group-w4vP")).
+		WithBodyPattern(wiremock.Contains("platform")).
 		InScenario(groupMappingScenarioName).
 		WhenScenarioStateIs(scenarioStateGroupMappingHasBeenCreated).
 		WillSetStateTo(scenarioStateGroupMappingDescriptionHaveBeenUpdated).
@@ -122,6 +125,7 @@ func TestAccGroupMapping(t *testing.T) {
 	// in order to test tf update (step #3)
 	groupMappingUpdatedDisplayName := "Default updated"
 	groupMappingUpdatedDescription := "Permission for all users in everyone group updated"
+	groupMappingUpdatedFilter := "\"engineering\" in groups || \"platform\" in groups"
 	fullGroupMappingResourceLabel := fmt.Sprintf("confluent_group_mapping.%s", groupMappingResourceLabel)
 
 	resource.Test(t, resource.TestCase{
@@ -139,6 +143,8 @@ func TestAccGroupMapping(t *testing.T) {
 					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "display_name", groupMappingDisplayName),
 					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "filter", groupMappingFilter),
 					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "description", groupMappingDescription),
+					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "principal", groupMappingPrincipal),
+					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "state", groupMappingState),
 				),
 			},
 			{
@@ -148,13 +154,15 @@ func TestAccGroupMapping(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccCheckGroupMappingConfig(mockServerUrl, groupMappingResourceLabel, groupMappingUpdatedDisplayName, groupMappingFilter, groupMappingUpdatedDescription),
+				Config: testAccCheckGroupMappingConfig(mockServerUrl, groupMappingResourceLabel, groupMappingUpdatedDisplayName, groupMappingUpdatedFilter, groupMappingUpdatedDescription),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGroupMappingExists(fullGroupMappingResourceLabel),
 					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "id", groupMappingId),
 					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "display_name", groupMappingUpdatedDisplayName),
-					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "filter", groupMappingFilter),
+					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "filter", groupMappingUpdatedFilter),
 					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "description", groupMappingUpdatedDescription),
+					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "principal", groupMappingPrincipal),
+					resource.TestCheckResourceAttr(fullGroupMappingResourceLabel, "state", groupMappingState),
 				),
 			},
 			{
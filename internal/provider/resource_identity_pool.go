@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	oidc "github.com/confluentinc/ccloud-sdk-go-v2/identity-provider/v2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,13 +61,13 @@ func identityPoolResource() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				Description:  "A JWT claim to extract the authenticating principal to Confluent resources.",
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			paramFilter: {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "A filter expression that must be evaluated to be true to use this identity pool.",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "A filter expression that must be evaluated to be true to use this identity pool.",
+				ValidateDiagFunc: identityPoolFilterValidate,
 			},
 		},
 	}
@@ -104,9 +105,12 @@ func identityPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	c := meta.(*Client)
 	identityProviderId := extractStringValueFromBlock(d, paramIdentityProvider, paramId)
-	updatedIdentityPool, _, err := c.oidcClient.IdentityPoolsIamV2Api.UpdateIamV2IdentityPool(c.oidcApiContext(ctx), identityProviderId, d.Id()).IamV2IdentityPool(*updateIdentityPoolRequest).Execute()
+	updatedIdentityPool, resp, err := c.oidcClient.IdentityPoolsIamV2Api.UpdateIamV2IdentityPool(c.oidcApiContext(ctx), identityProviderId, d.Id()).IamV2IdentityPool(*updateIdentityPoolRequest).Execute()
 
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusBadRequest {
+			return diag.Errorf("error updating Identity Pool %q: the request was rejected, check that %q = %q and %q = %q are valid: %s", d.Id(), paramIdentityClaim, d.Get(paramIdentityClaim).(string), paramFilter, d.Get(paramFilter).(string), createDescriptiveError(err))
+		}
 		return diag.Errorf("error updating Identity Pool %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -139,8 +143,11 @@ func identityPoolCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Creating new Identity Pool: %s", createIdentityPoolRequestJson))
 
-	createdIdentityPool, _, err := executeIdentityPoolCreate(c.oidcApiContext(ctx), c, createIdentityPoolRequest, identityProviderId)
+	createdIdentityPool, resp, err := executeIdentityPoolCreate(c.oidcApiContext(ctx), c, createIdentityPoolRequest, identityProviderId)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusBadRequest {
+			return diag.Errorf("error creating Identity Pool: the request was rejected, check that %q = %q and %q = %q are valid: %s", paramIdentityClaim, identityClaim, paramFilter, filter, createDescriptiveError(err))
+		}
 		return diag.Errorf("error creating Identity Pool: %s", createDescriptiveError(err))
 	}
 	d.SetId(createdIdentityPool.GetId())
@@ -154,6 +161,57 @@ func identityPoolCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	return identityPoolRead(ctx, d, meta)
 }
 
+// identityPoolFilterValidate does a basic syntactic check of a CEL filter so that typos are caught during plan
+// rather than being rejected by the backend on apply. The backend remains the source of truth for the CEL semantics.
+func identityPoolFilterValidate(v interface{}, path cty.Path) diag.Diagnostics {
+	if err := validateIdentityPoolFilter(v.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func validateIdentityPoolFilter(filter string) error {
+	if strings.TrimSpace(filter) == "" {
+		return fmt.Errorf("invalid filter: expected a non-empty CEL expression")
+	}
+	var openParentheses []int
+	for i := 0; i < len(filter); i++ {
+		switch filter[i] {
+		case '"', '\'':
+			end := indexOfClosingQuote(filter, i)
+			if end == -1 {
+				return fmt.Errorf("invalid filter %q: unterminated string literal starting at position %d", filter, i)
+			}
+			i = end
+		case '(':
+			openParentheses = append(openParentheses, i)
+		case ')':
+			if len(openParentheses) == 0 {
+				return fmt.Errorf("invalid filter %q: unexpected ')' at position %d", filter, i)
+			}
+			openParentheses = openParentheses[:len(openParentheses)-1]
+		}
+	}
+	if len(openParentheses) > 0 {
+		return fmt.Errorf("invalid filter %q: unclosed '(' at position %d", filter, openParentheses[len(openParentheses)-1])
+	}
+	return nil
+}
+
+// indexOfClosingQuote returns the index of the quote that terminates the string literal starting at start, or -1.
+func indexOfClosingQuote(s string, start int) int {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
 func executeIdentityPoolCreate(ctx context.Context, c *Client, identityPool *oidc.IamV2IdentityPool, identityProviderId string) (oidc.IamV2IdentityPool, *http.Response, error) {
 	req := c.oidcClient.IdentityPoolsIamV2Api.CreateIamV2IdentityPool(c.oidcApiContext(ctx), identityProviderId).IamV2IdentityPool(*identityPool)
 	return req.Execute()
@@ -189,9 +189,20 @@ func invitationDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	c := meta.(*Client)
 
-	if d.Get(paramAllowDeletion).(bool) == true && d.Get(paramStatus).(string) == statusAccepted {
-		tflog.Debug(ctx, fmt.Sprintf("Deleted accepted Invitation %q from TF state since allow_deletion is set to true", invitationId), map[string]interface{}{invitationloggingKey: invitationId})
-		return nil
+	// An accepted invitation can't be deleted on the server, so it's only removed from TF state
+	if d.Get(paramStatus).(string) == statusAccepted {
+		if d.Get(paramAllowDeletion).(bool) == true {
+			tflog.Debug(ctx, fmt.Sprintf("Deleted accepted Invitation %q from TF state since allow_deletion is set to true", invitationId), map[string]interface{}{invitationloggingKey: invitationId})
+			return nil
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Deleted accepted Invitation %q from TF state only since it has already been accepted", invitationId), map[string]interface{}{invitationloggingKey: invitationId})
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Invitation %q has already been accepted", invitationId),
+				Detail:   fmt.Sprintf("Invitation %q was removed from TF state only, user %q remains a member of the organization. Use the Confluent Cloud Console or CLI to remove the user. Set %s = true to suppress this warning.", invitationId, extractStringValueFromBlock(d, paramUser, paramId), paramAllowDeletion),
+			},
+		}
 	}
 
 	req := c.iamClient.InvitationsIamV2Api.DeleteIamV2Invitation(c.iamApiContext(ctx), invitationId)
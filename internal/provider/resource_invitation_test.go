@@ -27,6 +27,7 @@ import (
 const (
 	invitationResourceScenarioName        = "confluent_invitation Data Source Lifecycle"
 	scenarioStateInvitationHasBeenCreated = "A new invitation has been just created"
+	scenarioStateInvitationHasBeenDeleted = "The invitation has been deleted"
 	invitationEmail                       = "zli00000000@confluent.io"
 	invitationResourceLabel               = "confluent_invitation.inv"
 
@@ -71,14 +72,16 @@ func TestAccInvitation(t *testing.T) {
 			http.StatusCreated,
 		))
 
-	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(readCreatedInvitationUrlPath)).
+	deleteInvitationStub := wiremock.Delete(wiremock.URLPathEqualTo(readCreatedInvitationUrlPath)).
 		InScenario(invitationResourceScenarioName).
 		WhenScenarioStateIs(scenarioStateInvitationHasBeenCreated).
+		WillSetStateTo(scenarioStateInvitationHasBeenDeleted).
 		WillReturn(
 			"",
 			contentTypeJSONHeader,
 			http.StatusNoContent,
-		))
+		)
+	_ = wiremockClient.StubFor(deleteInvitationStub)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -99,6 +102,77 @@ func TestAccInvitation(t *testing.T) {
 			},
 		},
 	})
+
+	// The pending invitation is deleted on the server
+	checkStubCount(t, wiremockClient, deleteInvitationStub, fmt.Sprintf("DELETE %s", readCreatedInvitationUrlPath), expectedCountOne)
+}
+
+func TestAccAcceptedInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createInvitationResponse, _ := ioutil.ReadFile("../testdata/invitation/create_invitation.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(createInvitationUrlPath)).
+		InScenario(invitationResourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateInvitationHasBeenCreated).
+		WillReturn(
+			string(createInvitationResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	readAcceptedInvitationResponse, _ := ioutil.ReadFile("../testdata/invitation/read_accepted_invitation.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedInvitationUrlPath)).
+		InScenario(invitationResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateInvitationHasBeenCreated).
+		WillReturn(
+			string(readAcceptedInvitationResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteInvitationStub := wiremock.Delete(wiremock.URLPathEqualTo(readCreatedInvitationUrlPath)).
+		InScenario(invitationResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateInvitationHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteInvitationStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceInvitationWithIdSet(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(invitationResourceLabel, "id", "i-7od91"),
+					resource.TestCheckResourceAttr(invitationResourceLabel, "user.0.id", "u-r0jy59"),
+					resource.TestCheckResourceAttr(invitationResourceLabel, "status", "INVITE_STATUS_ACCEPTED"),
+					resource.TestCheckResourceAttr(invitationResourceLabel, "accepted_at", "2023-01-05T10:15:00.123456Z"),
+				),
+			},
+		},
+	})
+
+	// Accepted invitations are only removed from TF state
+	checkStubCount(t, wiremockClient, deleteInvitationStub, fmt.Sprintf("DELETE %s", readCreatedInvitationUrlPath), expectedCountZero)
 }
 
 func testAccCheckResourceInvitationWithIdSet(mockServerUrl string) string {
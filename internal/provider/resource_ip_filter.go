@@ -0,0 +1,257 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	iam "github.com/confluentinc/ccloud-sdk-go-v2/iam/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramFilterName      = "filter_name"
+	paramResourceGroup   = "resource_group"
+	paramIpGroups        = "ip_groups"
+	paramOperationGroups = "operation_groups"
+
+	ipFiltersPath = "/iam/v2/ip-filters"
+)
+
+var acceptedResourceGroups = []string{"management", "multiple"}
+
+// iamIpFilter represents iam.v2.IpFilter since ccloud-sdk-go-v2/iam doesn't support IP Filters yet
+type iamIpFilter struct {
+	ApiVersion      string                `json:"api_version,omitempty"`
+	Kind            string                `json:"kind,omitempty"`
+	Id              string                `json:"id,omitempty"`
+	FilterName      string                `json:"filter_name,omitempty"`
+	ResourceGroup   string                `json:"resource_group,omitempty"`
+	IpGroups        []iamIpGroupReference `json:"ip_groups"`
+	OperationGroups []string              `json:"operation_groups,omitempty"`
+}
+
+type iamIpGroupReference struct {
+	Id string `json:"id"`
+}
+
+func ipFilterResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipFilterCreate,
+		ReadContext:   ipFilterRead,
+		UpdateContext: ipFilterUpdate,
+		DeleteContext: ipFilterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ipFilterImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramFilterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A human-readable name for the IP Filter.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramResourceGroup: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Scope of resources covered by the IP Filter.",
+				ValidateFunc: validation.StringInSlice(acceptedResourceGroups, false),
+			},
+			paramIpGroups: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of IDs of IP Groups.",
+			},
+			paramOperationGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Scope of resources covered by the IP Filter when resource_group is set to \"multiple\".",
+			},
+		},
+	}
+}
+
+func ipFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	createIpFilterRequest := buildIpFilter(d)
+	createIpFilterRequestJson, err := json.Marshal(createIpFilterRequest)
+	if err != nil {
+		return diag.Errorf("error creating IP Filter: error marshaling %#v to json: %s", createIpFilterRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new IP Filter: %s", createIpFilterRequestJson))
+
+	var createdIpFilter iamIpFilter
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPost, ipFiltersPath, createIpFilterRequest, &createdIpFilter); err != nil {
+		return diag.Errorf("error creating IP Filter %q: %s", createIpFilterRequest.FilterName, createDescriptiveError(err))
+	}
+	d.SetId(createdIpFilter.Id)
+
+	createdIpFilterJson, err := json.Marshal(createdIpFilter)
+	if err != nil {
+		return diag.Errorf("error creating IP Filter %q: error marshaling %#v to json: %s", d.Id(), createdIpFilter, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating IP Filter %q: %s", d.Id(), createdIpFilterJson), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+	return ipFilterRead(ctx, d, meta)
+}
+
+func ipFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading IP Filter %q", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	var ipFilter iamIpFilter
+	resp, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodGet, ipFilterPath(d.Id()), nil, &ipFilter)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading IP Filter %q: %s", d.Id(), createDescriptiveError(err)), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing IP Filter %q in TF state because IP Filter could not be found on the server", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	ipFilterJson, err := json.Marshal(ipFilter)
+	if err != nil {
+		return diag.Errorf("error reading IP Filter %q: error marshaling %#v to json: %s", d.Id(), ipFilter, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched IP Filter %q: %s", d.Id(), ipFilterJson), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+	if _, err := setIpFilterAttributes(d, ipFilter); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading IP Filter %q", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+	return nil
+}
+
+func ipFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	updateIpFilterRequest := buildIpFilter(d)
+	updateIpFilterRequestJson, err := json.Marshal(updateIpFilterRequest)
+	if err != nil {
+		return diag.Errorf("error updating IP Filter %q: error marshaling %#v to json: %s", d.Id(), updateIpFilterRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updating IP Filter %q: %s", d.Id(), updateIpFilterRequestJson), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+	c := meta.(*Client)
+	var updatedIpFilter iamIpFilter
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPatch, ipFilterPath(d.Id()), updateIpFilterRequest, &updatedIpFilter); err != nil {
+		return diag.Errorf("error updating IP Filter %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	updatedIpFilterJson, err := json.Marshal(updatedIpFilter)
+	if err != nil {
+		return diag.Errorf("error updating IP Filter %q: error marshaling %#v to json: %s", d.Id(), updatedIpFilter, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating IP Filter %q: %s", d.Id(), updatedIpFilterJson), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+	return ipFilterRead(ctx, d, meta)
+}
+
+func ipFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting IP Filter %q", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodDelete, ipFilterPath(d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting IP Filter %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting IP Filter %q", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+
+	return nil
+}
+
+func ipFilterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing IP Filter %q", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if diagnostics := ipFilterRead(ctx, d, meta); diagnostics != nil {
+		return nil, fmt.Errorf("error importing IP Filter %q: %s", d.Id(), diagnostics[0].Summary)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing IP Filter %q", d.Id()), map[string]interface{}{ipFilterLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildIpFilter(d *schema.ResourceData) *iamIpFilter {
+	ipGroupIds := convertToStringSlice(d.Get(paramIpGroups).(*schema.Set).List())
+	ipGroups := make([]iamIpGroupReference, len(ipGroupIds))
+	for i, ipGroupId := range ipGroupIds {
+		ipGroups[i] = iamIpGroupReference{Id: ipGroupId}
+	}
+	return &iamIpFilter{
+		FilterName:      d.Get(paramFilterName).(string),
+		ResourceGroup:   d.Get(paramResourceGroup).(string),
+		IpGroups:        ipGroups,
+		OperationGroups: convertToStringSlice(d.Get(paramOperationGroups).(*schema.Set).List()),
+	}
+}
+
+func setIpFilterAttributes(d *schema.ResourceData, ipFilter iamIpFilter) (*schema.ResourceData, error) {
+	if err := d.Set(paramFilterName, ipFilter.FilterName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramResourceGroup, ipFilter.ResourceGroup); err != nil {
+		return nil, err
+	}
+	ipGroupIds := make([]string, len(ipFilter.IpGroups))
+	for i, ipGroup := range ipFilter.IpGroups {
+		ipGroupIds[i] = ipGroup.Id
+	}
+	if err := d.Set(paramIpGroups, ipGroupIds); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramOperationGroups, ipFilter.OperationGroups); err != nil {
+		return nil, err
+	}
+	d.SetId(ipFilter.Id)
+	return d, nil
+}
+
+func ipFilterPath(ipFilterId string) string {
+	return fmt.Sprintf("%s/%s", ipFiltersPath, url.PathEscape(ipFilterId))
+}
+
+// executeIamRequest sends a request to IAM API directly for resources that ccloud-sdk-go-v2/iam doesn't support yet
+func executeIamRequest(ctx context.Context, c *Client, method, path string, requestBody, responseBody interface{}) (*http.Response, error) {
+	cfg := c.iamClient.GetConfig()
+	serverUrl, err := cfg.ServerURLWithContext(ctx, "ServiceAccountsIamV2ApiService.GetIamV2ServiceAccount")
+	if err != nil {
+		return nil, err
+	}
+	auth, _ := ctx.Value(iam.ContextBasicAuth).(iam.BasicAuth)
+	return executeApiRequest(ctx, apiRequestConfig{
+		serverUrl:     serverUrl,
+		userAgent:     cfg.UserAgent,
+		defaultHeader: cfg.DefaultHeader,
+		httpClient:    cfg.HTTPClient,
+		userName:      auth.UserName,
+		password:      auth.Password,
+	}, method, path, requestBody, responseBody)
+}
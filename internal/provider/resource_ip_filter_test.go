@@ -0,0 +1,207 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateIpFilterHasBeenCreated = "The new IP Filter has been just created"
+	scenarioStateIpFilterHasBeenUpdated = "The new IP Filter has been updated"
+	scenarioStateIpFilterHasBeenDeleted = "The new IP Filter has been deleted"
+	ipFilterScenarioName                = "confluent_ip_filter Resource Lifecycle"
+
+	ipFilterId          = "ipf-abc123"
+	ipFilterName        = "Management API Rules"
+	ipFilterFirstGroup  = "ipg-12345"
+	ipFilterSecondGroup = "ipg-67890"
+)
+
+var ipFilterUrlPath = fmt.Sprintf("/iam/v2/ip-filters/%s", ipFilterId)
+
+func TestAccIpFilter(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createIpFilterResponse, _ := ioutil.ReadFile("../testdata/ip_filter/create_ip_filter.json")
+	createIpFilterStub := wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/ip-filters")).
+		InScenario(ipFilterScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateIpFilterHasBeenCreated).
+		WillReturn(
+			string(createIpFilterResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createIpFilterStub)
+
+	readCreatedIpFilterResponse, _ := ioutil.ReadFile("../testdata/ip_filter/read_created_ip_filter.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipFilterUrlPath)).
+		InScenario(ipFilterScenarioName).
+		WhenScenarioStateIs(scenarioStateIpFilterHasBeenCreated).
+		WillReturn(
+			string(readCreatedIpFilterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedIpFilterResponse, _ := ioutil.ReadFile("../testdata/ip_filter/read_updated_ip_filter.json")
+	patchIpFilterStub := wiremock.Patch(wiremock.URLPathEqualTo(ipFilterUrlPath)).
+		InScenario(ipFilterScenarioName).
+		WhenScenarioStateIs(scenarioStateIpFilterHasBeenCreated).
+		WithBodyPattern(wiremock.Contains(ipFilterSecondGroup)).
+		WillSetStateTo(scenarioStateIpFilterHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedIpFilterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(patchIpFilterStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipFilterUrlPath)).
+		InScenario(ipFilterScenarioName).
+		WhenScenarioStateIs(scenarioStateIpFilterHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedIpFilterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedIpFilterResponse, _ := ioutil.ReadFile("../testdata/ip_filter/read_deleted_ip_filter.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipFilterUrlPath)).
+		InScenario(ipFilterScenarioName).
+		WhenScenarioStateIs(scenarioStateIpFilterHasBeenDeleted).
+		WillReturn(
+			string(readDeletedIpFilterResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteIpFilterStub := wiremock.Delete(wiremock.URLPathEqualTo(ipFilterUrlPath)).
+		InScenario(ipFilterScenarioName).
+		WhenScenarioStateIs(scenarioStateIpFilterHasBeenUpdated).
+		WillSetStateTo(scenarioStateIpFilterHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteIpFilterStub)
+
+	ipFilterResourceLabel := "test_ip_filter_resource_label"
+	fullIpFilterResourceLabel := fmt.Sprintf("confluent_ip_filter.%s", ipFilterResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIpFilterDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIpFilterConfig(mockServerUrl, ipFilterResourceLabel, fmt.Sprintf("%q", ipFilterFirstGroup)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "id", ipFilterId),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "filter_name", ipFilterName),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "resource_group", "management"),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "ip_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fullIpFilterResourceLabel, "ip_groups.*", ipFilterFirstGroup),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "operation_groups.#", "0"),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullIpFilterResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckIpFilterConfig(mockServerUrl, ipFilterResourceLabel, fmt.Sprintf("%q, %q", ipFilterFirstGroup, ipFilterSecondGroup)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "id", ipFilterId),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "filter_name", ipFilterName),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "resource_group", "management"),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "ip_groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullIpFilterResourceLabel, "ip_groups.*", ipFilterFirstGroup),
+					resource.TestCheckTypeSetElemAttr(fullIpFilterResourceLabel, "ip_groups.*", ipFilterSecondGroup),
+					resource.TestCheckResourceAttr(fullIpFilterResourceLabel, "operation_groups.#", "0"),
+				),
+			},
+			{
+				ResourceName:      fullIpFilterResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createIpFilterStub, "POST /iam/v2/ip-filters", expectedCountOne)
+	checkStubCount(t, wiremockClient, patchIpFilterStub, fmt.Sprintf("PATCH %s", ipFilterUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteIpFilterStub, fmt.Sprintf("DELETE %s", ipFilterUrlPath), expectedCountOne)
+}
+
+func testAccCheckIpFilterDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each IP Filter is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_ip_filter" {
+			continue
+		}
+		deletedIpFilterId := rs.Primary.ID
+		var deletedIpFilter iamIpFilter
+		response, err := executeIamRequest(c.iamApiContext(context.Background()), c, http.MethodGet, ipFilterPath(deletedIpFilterId), nil, &deletedIpFilter)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedIpFilter.Id == rs.Primary.ID {
+			return fmt.Errorf("IP Filter (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckIpFilterConfig(mockServerUrl, ipFilterResourceLabel, ipGroups string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_ip_filter" "%s" {
+		filter_name    = "%s"
+		resource_group = "management"
+		ip_groups      = [%s]
+	}
+	`, mockServerUrl, ipFilterResourceLabel, ipFilterName, ipGroups)
+}
@@ -0,0 +1,206 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramGroupName  = "group_name"
+	paramCidrBlocks = "cidr_blocks"
+
+	ipGroupsPath = "/iam/v2/ip-groups"
+)
+
+// iamIpGroup represents iam.v2.IpGroup since ccloud-sdk-go-v2/iam doesn't support IP Groups yet
+type iamIpGroup struct {
+	ApiVersion string   `json:"api_version,omitempty"`
+	Kind       string   `json:"kind,omitempty"`
+	Id         string   `json:"id,omitempty"`
+	GroupName  string   `json:"group_name,omitempty"`
+	CidrBlocks []string `json:"cidr_blocks"`
+}
+
+func ipGroupResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipGroupCreate,
+		ReadContext:   ipGroupRead,
+		UpdateContext: ipGroupUpdate,
+		DeleteContext: ipGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ipGroupImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramGroupName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A human-readable name for the IP Group.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramCidrBlocks: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "A list of CIDRs (e.g., `192.168.0.0/24`).",
+			},
+		},
+	}
+}
+
+func ipGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	createIpGroupRequest := buildIpGroup(d)
+	createIpGroupRequestJson, err := json.Marshal(createIpGroupRequest)
+	if err != nil {
+		return diag.Errorf("error creating IP Group: error marshaling %#v to json: %s", createIpGroupRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new IP Group: %s", createIpGroupRequestJson))
+
+	var createdIpGroup iamIpGroup
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPost, ipGroupsPath, createIpGroupRequest, &createdIpGroup); err != nil {
+		return diag.Errorf("error creating IP Group %q: %s", createIpGroupRequest.GroupName, createDescriptiveError(err))
+	}
+	d.SetId(createdIpGroup.Id)
+
+	createdIpGroupJson, err := json.Marshal(createdIpGroup)
+	if err != nil {
+		return diag.Errorf("error creating IP Group %q: error marshaling %#v to json: %s", d.Id(), createdIpGroup, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating IP Group %q: %s", d.Id(), createdIpGroupJson), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+	return ipGroupRead(ctx, d, meta)
+}
+
+func executeIpGroupRead(ctx context.Context, c *Client, ipGroupId string) (iamIpGroup, *http.Response, error) {
+	var ipGroup iamIpGroup
+	resp, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodGet, ipGroupPath(ipGroupId), nil, &ipGroup)
+	return ipGroup, resp, err
+}
+
+func ipGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading IP Group %q", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	ipGroup, resp, err := executeIpGroupRead(ctx, c, d.Id())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading IP Group %q: %s", d.Id(), createDescriptiveError(err)), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing IP Group %q in TF state because IP Group could not be found on the server", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	ipGroupJson, err := json.Marshal(ipGroup)
+	if err != nil {
+		return diag.Errorf("error reading IP Group %q: error marshaling %#v to json: %s", d.Id(), ipGroup, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched IP Group %q: %s", d.Id(), ipGroupJson), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+	if _, err := setIpGroupAttributes(d, ipGroup); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading IP Group %q", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+	return nil
+}
+
+func ipGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	updateIpGroupRequest := buildIpGroup(d)
+	updateIpGroupRequestJson, err := json.Marshal(updateIpGroupRequest)
+	if err != nil {
+		return diag.Errorf("error updating IP Group %q: error marshaling %#v to json: %s", d.Id(), updateIpGroupRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updating IP Group %q: %s", d.Id(), updateIpGroupRequestJson), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+	c := meta.(*Client)
+	var updatedIpGroup iamIpGroup
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodPatch, ipGroupPath(d.Id()), updateIpGroupRequest, &updatedIpGroup); err != nil {
+		return diag.Errorf("error updating IP Group %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	updatedIpGroupJson, err := json.Marshal(updatedIpGroup)
+	if err != nil {
+		return diag.Errorf("error updating IP Group %q: error marshaling %#v to json: %s", d.Id(), updatedIpGroup, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating IP Group %q: %s", d.Id(), updatedIpGroupJson), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+	return ipGroupRead(ctx, d, meta)
+}
+
+func ipGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting IP Group %q", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	if _, err := executeIamRequest(c.iamApiContext(ctx), c, http.MethodDelete, ipGroupPath(d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting IP Group %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting IP Group %q", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+
+	return nil
+}
+
+func ipGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing IP Group %q", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if diagnostics := ipGroupRead(ctx, d, meta); diagnostics != nil {
+		return nil, fmt.Errorf("error importing IP Group %q: %s", d.Id(), diagnostics[0].Summary)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing IP Group %q", d.Id()), map[string]interface{}{ipGroupLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildIpGroup(d *schema.ResourceData) *iamIpGroup {
+	return &iamIpGroup{
+		GroupName:  d.Get(paramGroupName).(string),
+		CidrBlocks: convertToStringSlice(d.Get(paramCidrBlocks).(*schema.Set).List()),
+	}
+}
+
+func setIpGroupAttributes(d *schema.ResourceData, ipGroup iamIpGroup) (*schema.ResourceData, error) {
+	if err := d.Set(paramGroupName, ipGroup.GroupName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramCidrBlocks, ipGroup.CidrBlocks); err != nil {
+		return nil, err
+	}
+	d.SetId(ipGroup.Id)
+	return d, nil
+}
+
+func ipGroupPath(ipGroupId string) string {
+	return fmt.Sprintf("%s/%s", ipGroupsPath, url.PathEscape(ipGroupId))
+}
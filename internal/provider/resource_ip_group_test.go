@@ -0,0 +1,235 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateIpGroupHasBeenCreated = "The new IP Group has been just created"
+	scenarioStateIpGroupHasBeenUpdated = "The new IP Group has been updated"
+	scenarioStateIpGroupHasBeenDeleted = "The new IP Group has been deleted"
+	ipGroupScenarioName                = "confluent_ip_group Resource Lifecycle"
+
+	ipGroupId           = "ipg-abc123"
+	ipGroupName         = "CorpNet"
+	ipGroupFirstCidr    = "192.168.0.0/24"
+	ipGroupSecondCidr   = "192.168.7.0/24"
+	ipGroupAddedCidr    = "10.0.0.0/16"
+	ipGroupInvalidCidr  = "192.168.0.0/33"
+	ipGroupResourceName = "test_ip_group_resource_label"
+)
+
+var ipGroupUrlPath = fmt.Sprintf("/iam/v2/ip-groups/%s", ipGroupId)
+
+func TestAccIpGroup(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createIpGroupResponse, _ := ioutil.ReadFile("../testdata/ip_group/create_ip_group.json")
+	createIpGroupStub := wiremock.Post(wiremock.URLPathEqualTo("/iam/v2/ip-groups")).
+		InScenario(ipGroupScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(ipGroupSecondCidr)).
+		WillSetStateTo(scenarioStateIpGroupHasBeenCreated).
+		WillReturn(
+			string(createIpGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createIpGroupStub)
+
+	readCreatedIpGroupResponse, _ := ioutil.ReadFile("../testdata/ip_group/read_created_ip_group.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipGroupUrlPath)).
+		InScenario(ipGroupScenarioName).
+		WhenScenarioStateIs(scenarioStateIpGroupHasBeenCreated).
+		WillReturn(
+			string(readCreatedIpGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedIpGroupResponse, _ := ioutil.ReadFile("../testdata/ip_group/read_updated_ip_group.json")
+	patchIpGroupStub := wiremock.Patch(wiremock.URLPathEqualTo(ipGroupUrlPath)).
+		InScenario(ipGroupScenarioName).
+		WhenScenarioStateIs(scenarioStateIpGroupHasBeenCreated).
+		WithBodyPattern(wiremock.Contains(ipGroupAddedCidr)).
+		WillSetStateTo(scenarioStateIpGroupHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedIpGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(patchIpGroupStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipGroupUrlPath)).
+		InScenario(ipGroupScenarioName).
+		WhenScenarioStateIs(scenarioStateIpGroupHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedIpGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedIpGroupResponse, _ := ioutil.ReadFile("../testdata/ip_group/read_deleted_ip_group.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ipGroupUrlPath)).
+		InScenario(ipGroupScenarioName).
+		WhenScenarioStateIs(scenarioStateIpGroupHasBeenDeleted).
+		WillReturn(
+			string(readDeletedIpGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteIpGroupStub := wiremock.Delete(wiremock.URLPathEqualTo(ipGroupUrlPath)).
+		InScenario(ipGroupScenarioName).
+		WhenScenarioStateIs(scenarioStateIpGroupHasBeenUpdated).
+		WillSetStateTo(scenarioStateIpGroupHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteIpGroupStub)
+
+	fullIpGroupResourceLabel := fmt.Sprintf("confluent_ip_group.%s", ipGroupResourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIpGroupDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIpGroupConfig(mockServerUrl, fmt.Sprintf("%q, %q", ipGroupFirstCidr, ipGroupInvalidCidr)),
+				ExpectError: regexp.MustCompile("to be a valid IPv4 Value"),
+			},
+			{
+				Config: testAccCheckIpGroupConfig(mockServerUrl, fmt.Sprintf("%q, %q", ipGroupFirstCidr, ipGroupSecondCidr)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullIpGroupResourceLabel, "id", ipGroupId),
+					resource.TestCheckResourceAttr(fullIpGroupResourceLabel, "group_name", ipGroupName),
+					resource.TestCheckResourceAttr(fullIpGroupResourceLabel, "cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupResourceLabel, "cidr_blocks.*", ipGroupFirstCidr),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupResourceLabel, "cidr_blocks.*", ipGroupSecondCidr),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullIpGroupResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckIpGroupConfig(mockServerUrl, fmt.Sprintf("%q, %q, %q", ipGroupFirstCidr, ipGroupSecondCidr, ipGroupAddedCidr)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullIpGroupResourceLabel, "id", ipGroupId),
+					resource.TestCheckResourceAttr(fullIpGroupResourceLabel, "group_name", ipGroupName),
+					resource.TestCheckResourceAttr(fullIpGroupResourceLabel, "cidr_blocks.#", "3"),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupResourceLabel, "cidr_blocks.*", ipGroupFirstCidr),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupResourceLabel, "cidr_blocks.*", ipGroupSecondCidr),
+					resource.TestCheckTypeSetElemAttr(fullIpGroupResourceLabel, "cidr_blocks.*", ipGroupAddedCidr),
+				),
+			},
+			{
+				ResourceName:      fullIpGroupResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createIpGroupStub, "POST /iam/v2/ip-groups", expectedCountOne)
+	checkStubCount(t, wiremockClient, patchIpGroupStub, fmt.Sprintf("PATCH %s", ipGroupUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteIpGroupStub, fmt.Sprintf("DELETE %s", ipGroupUrlPath), expectedCountOne)
+}
+
+func testAccCheckIpGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each IP Group is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_ip_group" {
+			continue
+		}
+		deletedIpGroup, response, err := executeIpGroupRead(context.Background(), c, rs.Primary.ID)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedIpGroup.Id == rs.Primary.ID {
+			return fmt.Errorf("IP Group (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckIpGroupConfig(mockServerUrl, cidrBlocks string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_ip_group" "%s" {
+		group_name  = "%s"
+		cidr_blocks = [%s]
+	}
+	`, mockServerUrl, ipGroupResourceName, ipGroupName, cidrBlocks)
+}
+
+func TestResourceIpGroupCidrBlocksValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		cidrBlocks []interface{}
+		isValid    bool
+	}{
+		{name: "multiple valid CIDRs", cidrBlocks: []interface{}{ipGroupFirstCidr, ipGroupSecondCidr, ipGroupAddedCidr}, isValid: true},
+		{name: "invalid prefix length", cidrBlocks: []interface{}{ipGroupFirstCidr, ipGroupInvalidCidr}, isValid: false},
+		{name: "IP address without prefix length", cidrBlocks: []interface{}{"192.168.0.1"}, isValid: false},
+		{name: "no CIDRs", cidrBlocks: []interface{}{}, isValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := ipGroupResource().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				paramGroupName:  ipGroupName,
+				paramCidrBlocks: tt.cidrBlocks,
+			}))
+			if diags.HasError() == tt.isValid {
+				t.Fatalf("Unexpected validation result for %v: %v", tt.cidrBlocks, diags)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,23 +46,64 @@ var acceptedResourceTypes = []string{"UNKNOWN", "ANY", "TOPIC", "GROUP", "CLUSTE
 var acceptedPatternTypes = []string{"UNKNOWN", "ANY", "MATCH", "LITERAL", "PREFIXED"}
 var acceptedOperations = []string{"UNKNOWN", "ANY", "ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"}
 var acceptedPermissions = []string{"UNKNOWN", "ANY", "DENY", "ALLOW"}
+var acceptedPrincipalResourceIdPrefixes = []string{"sa-", "u-", "pool-", "group-"}
+
+// Resource IDs that can be used as a principal without the "User:" prefix
+var acceptedBarePrincipalResourceIdPrefixes = []string{"sa-", "u-"}
 
 func extractAcl(d *schema.ResourceData) (Acl, error) {
 	resourceType, err := stringToAclResourceType(d.Get(paramResourceType).(string))
 	if err != nil {
 		return Acl{}, err
 	}
+	principal, err := normalizePrincipal(d.Get(paramPrincipal).(string))
+	if err != nil {
+		return Acl{}, err
+	}
 	return Acl{
 		ResourceType: resourceType,
 		ResourceName: d.Get(paramResourceName).(string),
 		PatternType:  d.Get(paramPatternType).(string),
-		Principal:    d.Get(paramPrincipal).(string),
+		Principal:    principal,
 		Host:         d.Get(paramHost).(string),
 		Operation:    d.Get(paramOperation).(string),
 		Permission:   d.Get(paramPermission).(string),
 	}, nil
 }
 
+// Converts a bare service account or user resource ID (sa-abc123) to a principal (User:sa-abc123)
+// and returns fully-qualified principals (User:sa-abc123, User:*) as is.
+func normalizePrincipal(principal string) (string, error) {
+	if principal == principalPrefix+"*" {
+		return principal, nil
+	}
+	if strings.HasPrefix(principal, principalPrefix) {
+		if hasAnyPrefix(strings.TrimPrefix(principal, principalPrefix), acceptedPrincipalResourceIdPrefixes) {
+			return principal, nil
+		}
+	} else if hasAnyPrefix(principal, acceptedBarePrincipalResourceIdPrefixes) {
+		return principalPrefix + principal, nil
+	}
+	return "", fmt.Errorf("invalid principal %q: the principal must be a service account or user ID (for example, 'sa-abc123' or 'u-abc123') "+
+		"or start with 'User:sa-' or 'User:u-' or 'User:pool-' or 'User:group-' or be 'User:*'", principal)
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func principalValidate(v interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := normalizePrincipal(v.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
 func kafkaAclResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: kafkaAclCreate,
@@ -94,10 +136,16 @@ func kafkaAclResource() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(acceptedPatternTypes, false),
 			},
 			paramPrincipal: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The principal for the ACL.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The principal for the ACL.",
+				ValidateDiagFunc: principalValidate,
+				// Suppress the diff between a bare resource ID (sa-abc123) and the principal (User:sa-abc123) stored in TF state
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					normalizedNew, err := normalizePrincipal(new)
+					return err == nil && old == normalizedNew
+				},
 			},
 			paramHost: {
 				Type:        schema.TypeString,
@@ -0,0 +1,437 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramAcl = "acl"
+)
+
+func kafkaAclsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kafkaAclsCreate,
+		ReadContext:   kafkaAclsRead,
+		UpdateContext: kafkaAclsUpdate,
+		DeleteContext: kafkaAclsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: kafkaAclsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockSchema(),
+			paramAcl: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The set of Kafka ACLs.",
+				Elem:        kafkaAclsAclSchema(),
+				Set:         kafkaAclsAclHash,
+			},
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+		},
+	}
+}
+
+func kafkaAclsAclSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			paramResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The type of the resource.",
+				ValidateFunc: validation.StringInSlice(acceptedResourceTypes, false),
+			},
+			paramResourceName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The resource name for the ACL.",
+			},
+			paramPatternType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The pattern type for the ACL.",
+				ValidateFunc: validation.StringInSlice(acceptedPatternTypes, false),
+			},
+			paramPrincipal: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The principal for the ACL.",
+				ValidateDiagFunc: principalValidate,
+				// Suppress the diff between a bare resource ID (sa-abc123) and the principal (User:sa-abc123) stored in TF state
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					normalizedNew, err := normalizePrincipal(new)
+					return err == nil && old == normalizedNew
+				},
+			},
+			paramHost: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host for the ACL.",
+			},
+			paramOperation: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The operation type for the ACL.",
+				ValidateFunc: validation.StringInSlice(acceptedOperations, false),
+			},
+			paramPermission: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The permission for the ACL.",
+				ValidateFunc: validation.StringInSlice(acceptedPermissions, false),
+			},
+		},
+	}
+}
+
+// kafkaAclsAclHash hashes an ACL with its normalized principal so that a bare resource ID (sa-abc123)
+// and the principal (User:sa-abc123) stored in TF state refer to the same set element
+func kafkaAclsAclHash(v interface{}) int {
+	tfAclMap := v.(map[string]interface{})
+	normalizedTfAclMap := make(map[string]interface{}, len(tfAclMap))
+	for key, value := range tfAclMap {
+		normalizedTfAclMap[key] = value
+	}
+	if principal, ok := tfAclMap[paramPrincipal].(string); ok {
+		if normalizedPrincipal, err := normalizePrincipal(principal); err == nil {
+			normalizedTfAclMap[paramPrincipal] = normalizedPrincipal
+		}
+	}
+	return schema.HashResource(kafkaAclsAclSchema())(normalizedTfAclMap)
+}
+
+func extractAcls(tfAcls []interface{}) ([]Acl, error) {
+	acls := make([]Acl, len(tfAcls))
+	for i, tfAcl := range tfAcls {
+		tfAclMap := tfAcl.(map[string]interface{})
+		resourceType, err := stringToAclResourceType(tfAclMap[paramResourceType].(string))
+		if err != nil {
+			return nil, err
+		}
+		principal, err := normalizePrincipal(tfAclMap[paramPrincipal].(string))
+		if err != nil {
+			return nil, err
+		}
+		acls[i] = Acl{
+			ResourceType: resourceType,
+			ResourceName: tfAclMap[paramResourceName].(string),
+			PatternType:  tfAclMap[paramPatternType].(string),
+			Principal:    principal,
+			Host:         tfAclMap[paramHost].(string),
+			Operation:    tfAclMap[paramOperation].(string),
+			Permission:   tfAclMap[paramPermission].(string),
+		}
+	}
+	return acls, nil
+}
+
+// createKafkaAclsId generates an ID from the Kafka cluster ID and a random suffix since Kafka ACLs of a cluster
+// don't have an ID of their own. The ID is set once and doesn't change when Kafka ACLs are added or removed.
+func createKafkaAclsId(clusterId string) string {
+	return fmt.Sprintf("%s/%s", clusterId, uuid.New().String())
+}
+
+func buildTfAcl(acl Acl) map[string]interface{} {
+	return map[string]interface{}{
+		paramResourceType: string(acl.ResourceType),
+		paramResourceName: acl.ResourceName,
+		paramPatternType:  acl.PatternType,
+		paramPrincipal:    acl.Principal,
+		paramHost:         acl.Host,
+		paramOperation:    acl.Operation,
+		paramPermission:   acl.Permission,
+	}
+}
+
+func kafkaAclsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+	acls, err := extractAcls(d.Get(paramAcl).(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	if err := executeKafkaAclsBatchCreate(ctx, kafkaRestClient, acls); err != nil {
+		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	d.SetId(createKafkaAclsId(kafkaRestClient.clusterId))
+
+	// https://github.com/confluentinc/terraform-provider-confluentcloud/issues/40#issuecomment-1048782379
+	SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating %d Kafka ACLs %q", len(acls), d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	return kafkaAclsRead(ctx, d, meta)
+}
+
+func executeKafkaAclsBatchCreate(ctx context.Context, c *KafkaRestClient, acls []Acl) error {
+	createAclRequests := make([]kafkarestv3.CreateAclRequestData, len(acls))
+	for i, acl := range acls {
+		createAclRequests[i] = kafkarestv3.CreateAclRequestData{
+			ResourceType: acl.ResourceType,
+			ResourceName: acl.ResourceName,
+			PatternType:  acl.PatternType,
+			Principal:    acl.Principal,
+			Host:         acl.Host,
+			Operation:    acl.Operation,
+			Permission:   acl.Permission,
+		}
+	}
+	createAclsRequest := kafkarestv3.NewCreateAclRequestDataList(createAclRequests)
+	createAclsRequestJson, err := json.Marshal(createAclsRequest)
+	if err != nil {
+		return fmt.Errorf("error marshaling %#v to json: %s", createAclsRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Kafka ACLs: %s", createAclsRequestJson), map[string]interface{}{kafkaClusterLoggingKey: c.clusterId})
+
+	_, err = c.apiClient.ACLV3Api.BatchCreateKafkaAcls(c.apiContext(ctx), c.clusterId).CreateAclRequestDataList(*createAclsRequest).Execute()
+	return err
+}
+
+func kafkaAclsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+	acls, err := extractAcls(d.Get(paramAcl).(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	if err := readAclsAndSetAttributes(ctx, d, kafkaRestClient, acls); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	return nil
+}
+
+func readAclsAndSetAttributes(ctx context.Context, d *schema.ResourceData, kafkaRestClient *KafkaRestClient, acls []Acl) error {
+	// Only keep the ACLs that still exist so that missing ones get recreated on the next apply
+	var tfAcls []interface{}
+	for _, acl := range acls {
+		remoteAcls, resp, err := executeKafkaAclRead(ctx, kafkaRestClient, acl)
+		if err != nil {
+			if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+				continue
+			}
+			return fmt.Errorf("error reading Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		if len(remoteAcls.GetData()) == 0 {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Kafka ACL %q in TF state because Kafka ACL could not be found on the server", createKafkaAclId(kafkaRestClient.clusterId, acl)), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+			continue
+		}
+		tfAcls = append(tfAcls, buildTfAcl(acl))
+	}
+
+	if len(tfAcls) == 0 {
+		if !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Kafka ACLs %q in TF state because none of Kafka ACLs could be found on the server", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Kafka ACLs %q: no Kafka ACLs were matched", d.Id())
+	}
+
+	if err := d.Set(paramAcl, tfAcls); err != nil {
+		return err
+	}
+	if !kafkaRestClient.isClusterIdSetInProviderBlock {
+		if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, kafkaRestClient.clusterId, d); err != nil {
+			return err
+		}
+	}
+	if !kafkaRestClient.isMetadataSetInProviderBlock {
+		if err := setKafkaCredentials(kafkaRestClient.clusterApiKey, kafkaRestClient.clusterApiSecret, d); err != nil {
+			return err
+		}
+		if err := d.Set(paramRestEndpoint, kafkaRestClient.restEndpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func kafkaAclsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	clusterId, acls, err := parseKafkaAclsImportId(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error importing Kafka ACLs: %s", err)
+	}
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, true)
+	if err != nil {
+		return nil, fmt.Errorf("error importing Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, true)
+	if err != nil {
+		return nil, fmt.Errorf("error importing Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+
+	d.SetId(createKafkaAclsId(clusterId))
+	// Mark resource as new to avoid d.Set("") when none of Kafka ACLs could be found
+	d.MarkNewResource()
+	if err := readAclsAndSetAttributes(ctx, d, kafkaRestClient, acls); err != nil {
+		return nil, fmt.Errorf("error importing Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseKafkaAclsImportId(clusterIdAndSerializedAcls string) (string, []Acl, error) {
+	parts := strings.SplitN(clusterIdAndSerializedAcls, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("invalid format: expected '<Kafka cluster ID>/<resource type>#<resource name>#<pattern type>#<principal>#<host>#<operation>#<permission>;...', got %q", clusterIdAndSerializedAcls)
+	}
+	serializedAcls := strings.Split(parts[1], ";")
+	acls := make([]Acl, len(serializedAcls))
+	for i, serializedAcl := range serializedAcls {
+		acl, err := deserializeAcl(serializedAcl)
+		if err != nil {
+			return "", nil, err
+		}
+		if acl.Principal, err = normalizePrincipal(acl.Principal); err != nil {
+			return "", nil, err
+		}
+		acls[i] = acl
+	}
+	return parts[0], acls, nil
+}
+
+func kafkaAclsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramAcl, paramCredentials) {
+		return diag.Errorf("error updating Kafka ACLs %q: only %q and %q blocks can be updated for Kafka ACLs", d.Id(), paramAcl, paramCredentials)
+	}
+	if !d.HasChange(paramAcl) {
+		return kafkaAclsRead(ctx, d, meta)
+	}
+
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error updating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error updating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error updating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+
+	oldAclsSet, newAclsSet := d.GetChange(paramAcl)
+	removedAcls, err := extractAcls(oldAclsSet.(*schema.Set).Difference(newAclsSet.(*schema.Set)).List())
+	if err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	addedAcls, err := extractAcls(newAclsSet.(*schema.Set).Difference(oldAclsSet.(*schema.Set)).List())
+	if err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	for _, acl := range removedAcls {
+		if _, _, err := executeKafkaAclDelete(ctx, kafkaRestClient, acl); err != nil {
+			return diag.Errorf("error updating Kafka ACLs %q: error deleting Kafka ACL %q: %s", d.Id(), createKafkaAclId(kafkaRestClient.clusterId, acl), createDescriptiveError(err))
+		}
+	}
+	if len(addedAcls) > 0 {
+		if err := executeKafkaAclsBatchCreate(ctx, kafkaRestClient, addedAcls); err != nil {
+			return diag.Errorf("error updating Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating Kafka ACLs %q: deleted %d and created %d Kafka ACLs", d.Id(), len(removedAcls), len(addedAcls)), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	return kafkaAclsRead(ctx, d, meta)
+}
+
+func kafkaAclsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+	acls, err := extractAcls(d.Get(paramAcl).(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	for _, acl := range acls {
+		if _, _, err := executeKafkaAclDelete(ctx, kafkaRestClient, acl); err != nil {
+			return diag.Errorf("error deleting Kafka ACL %q: %s", createKafkaAclId(kafkaRestClient.clusterId, acl), createDescriptiveError(err))
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+
+	return nil
+}
@@ -0,0 +1,348 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	aclsScenarioName       = "confluent_kafka_acls Resource Lifecycle"
+	aclsResourceLabel      = "test_acls_resource_label"
+	aclsTopicResourceType  = "TOPIC"
+	aclsFirstResourceName  = "orders"
+	aclsSecondResourceName = "payments"
+	aclsThirdResourceName  = "shipments"
+)
+
+var fullAclsResourceLabel = fmt.Sprintf("confluent_kafka_acls.%s", aclsResourceLabel)
+var batchCreateKafkaAclsPath = fmt.Sprintf("/kafka/v3/clusters/%s/acls:batch", clusterId)
+
+func TestAccKafkaAcls(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockAclTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockAclTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	// Set fake values for secrets since those are required for importing
+	_ = os.Setenv("IMPORT_KAFKA_API_KEY", kafkaApiKey)
+	_ = os.Setenv("IMPORT_KAFKA_API_SECRET", kafkaApiSecret)
+	_ = os.Setenv("IMPORT_KAFKA_REST_ENDPOINT", mockAclTestServerUrl)
+	defer func() {
+		_ = os.Unsetenv("IMPORT_KAFKA_API_KEY")
+		_ = os.Unsetenv("IMPORT_KAFKA_API_SECRET")
+		_ = os.Unsetenv("IMPORT_KAFKA_REST_ENDPOINT")
+	}()
+
+	batchCreateAclsStub := wiremock.Post(wiremock.URLPathEqualTo(batchCreateKafkaAclsPath)).
+		WithBodyPattern(wiremock.Contains(aclsFirstResourceName)).
+		WithBodyPattern(wiremock.Contains(aclsSecondResourceName)).
+		WithBodyPattern(wiremock.Contains(aclsThirdResourceName)).
+		InScenario(aclsScenarioName).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(batchCreateAclsStub)
+
+	readDeletedAclResponse, _ := os.ReadFile("../testdata/kafka_acl/delete_kafka_acls.json")
+	deleteAclStubs := map[string]*wiremock.StubRule{}
+	for _, resourceName := range []string{aclsFirstResourceName, aclsSecondResourceName, aclsThirdResourceName} {
+		readAclResponse, _ := os.ReadFile(fmt.Sprintf("../testdata/kafka_acl/search_created_kafka_topic_acls_%s.json", resourceName))
+		_ = wiremockClient.StubFor(kafkaAclsStubWithQueryParams(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)), resourceName).
+			InScenario(aclsScenarioName).
+			WillReturn(
+				string(readAclResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+
+		deleteAclStub := kafkaAclsStubWithQueryParams(wiremock.Delete(wiremock.URLPathEqualTo(createKafkaAclPath)), resourceName).
+			InScenario(aclsScenarioName).
+			WillReturn(
+				string(readDeletedAclResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			)
+		_ = wiremockClient.StubFor(deleteAclStub)
+		deleteAclStubs[resourceName] = deleteAclStub
+	}
+
+	var aclsId string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKafkaAclsConfig(confluentCloudBaseUrl, mockAclTestServerUrl, []string{aclsFirstResourceName, aclsSecondResourceName, aclsThirdResourceName}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(fullAclsResourceLabel, "id", regexp.MustCompile(fmt.Sprintf("^%s/", clusterId))),
+					testAccCheckKafkaAclsIdIsUnchanged(fullAclsResourceLabel, &aclsId),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "acl.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullAclsResourceLabel, "acl.*", kafkaAclsExpectedAttributes(aclsFirstResourceName)),
+					resource.TestCheckTypeSetElemNestedAttrs(fullAclsResourceLabel, "acl.*", kafkaAclsExpectedAttributes(aclsSecondResourceName)),
+					resource.TestCheckTypeSetElemNestedAttrs(fullAclsResourceLabel, "acl.*", kafkaAclsExpectedAttributes(aclsThirdResourceName)),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "credentials.#", "1"),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "credentials.0.key", kafkaApiKey),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "credentials.0.secret", kafkaApiSecret),
+				),
+			},
+			{
+				// Removing the middle ACL should only delete that ACL
+				Config: testAccCheckKafkaAclsConfig(confluentCloudBaseUrl, mockAclTestServerUrl, []string{aclsFirstResourceName, aclsThirdResourceName}),
+				Check: resource.ComposeTestCheckFunc(
+					// Updating Kafka ACLs shouldn't change the ID
+					testAccCheckKafkaAclsIdIsUnchanged(fullAclsResourceLabel, &aclsId),
+					resource.TestCheckResourceAttr(fullAclsResourceLabel, "acl.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullAclsResourceLabel, "acl.*", kafkaAclsExpectedAttributes(aclsFirstResourceName)),
+					resource.TestCheckTypeSetElemNestedAttrs(fullAclsResourceLabel, "acl.*", kafkaAclsExpectedAttributes(aclsThirdResourceName)),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:  fullAclsResourceLabel,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s/%s", clusterId, kafkaAclsSerializedAcls(aclsFirstResourceName, aclsThirdResourceName)),
+				// The imported Kafka ACLs get a new ID, so ImportStateVerify can't match them with the existing ones
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+					if !strings.HasPrefix(states[0].ID, clusterId+"/") {
+						return fmt.Errorf("expected ID %q to start with %q", states[0].ID, clusterId+"/")
+					}
+					if acls := states[0].Attributes["acl.#"]; acls != "2" {
+						return fmt.Errorf("expected 2 imported Kafka ACLs, got %s", acls)
+					}
+					return nil
+				},
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, batchCreateAclsStub, fmt.Sprintf("POST %s", batchCreateKafkaAclsPath), expectedCountOne)
+	// The middle ACL is deleted on update, the remaining ones on destroy
+	for resourceName, deleteAclStub := range deleteAclStubs {
+		checkStubCount(t, wiremockClient, deleteAclStub, fmt.Sprintf("DELETE %s?resource_name=%s", createKafkaAclPath, resourceName), expectedCountOne)
+	}
+}
+
+func kafkaAclsStubWithQueryParams(stub *wiremock.StubRule, resourceName string) *wiremock.StubRule {
+	return stub.
+		WithQueryParam("host", wiremock.EqualTo(aclHost)).
+		WithQueryParam("operation", wiremock.EqualTo(aclOperation)).
+		WithQueryParam("pattern_type", wiremock.EqualTo(aclPatternType)).
+		WithQueryParam("permission", wiremock.EqualTo(aclPermission)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithResourceId)).
+		WithQueryParam("resource_name", wiremock.EqualTo(resourceName)).
+		WithQueryParam("resource_type", wiremock.EqualTo(aclsTopicResourceType))
+}
+
+func kafkaAclsExpectedAttributes(resourceName string) map[string]string {
+	return map[string]string{
+		"resource_type": aclsTopicResourceType,
+		"resource_name": resourceName,
+		"pattern_type":  aclPatternType,
+		"principal":     aclPrincipalWithResourceId,
+		"host":          aclHost,
+		"operation":     aclOperation,
+		"permission":    aclPermission,
+	}
+}
+
+func kafkaAclsExpectedAcls(resourceNames ...string) []Acl {
+	acls := make([]Acl, len(resourceNames))
+	for i, resourceName := range resourceNames {
+		acls[i] = Acl{
+			ResourceType: aclsTopicResourceType,
+			ResourceName: resourceName,
+			PatternType:  aclPatternType,
+			Principal:    aclPrincipalWithResourceId,
+			Host:         aclHost,
+			Operation:    aclOperation,
+			Permission:   aclPermission,
+		}
+	}
+	return acls
+}
+
+func kafkaAclsSerializedAcls(resourceNames ...string) string {
+	serializedAcls := make([]string, len(resourceNames))
+	for i, acl := range kafkaAclsExpectedAcls(resourceNames...) {
+		serializedAcls[i] = strings.TrimPrefix(createKafkaAclId(clusterId, acl), clusterId+"/")
+	}
+	return strings.Join(serializedAcls, ";")
+}
+
+func testAccCheckKafkaAclsConfig(confluentCloudBaseUrl, mockServerUrl string, resourceNames []string) string {
+	var aclBlocks strings.Builder
+	for _, resourceName := range resourceNames {
+		aclBlocks.WriteString(fmt.Sprintf(`
+	  acl {
+	    resource_type = "%s"
+	    resource_name = "%s"
+	    pattern_type = "%s"
+	    principal = "%s"
+	    host = "%s"
+	    operation = "%s"
+	    permission = "%s"
+	  }
+`, aclsTopicResourceType, resourceName, aclPatternType, aclPrincipalWithResourceId, aclHost, aclOperation, aclPermission))
+	}
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_kafka_acls" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+	  %s
+	  rest_endpoint = "%s"
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, aclsResourceLabel, clusterId, aclBlocks.String(), mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
+
+func testAccCheckKafkaAclsIdIsUnchanged(resourceLabel string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceLabel]
+		if !ok {
+			return fmt.Errorf("%s Kafka ACLs have not been found", resourceLabel)
+		}
+		if *id == "" {
+			*id = rs.Primary.ID
+		} else if rs.Primary.ID != *id {
+			return fmt.Errorf("expected ID of %s Kafka ACLs to be %q, got %q", resourceLabel, *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func TestCreateKafkaAclsId(t *testing.T) {
+	id := createKafkaAclsId(clusterId)
+	if !strings.HasPrefix(id, clusterId+"/") {
+		t.Fatalf("expected %q to start with %q", id, clusterId+"/")
+	}
+	if otherId := createKafkaAclsId(clusterId); otherId == id {
+		t.Fatalf("expected different Kafka ACLs resources of the same Kafka cluster to have different IDs, got %q for both", id)
+	}
+}
+
+func TestParseKafkaAclsImportId(t *testing.T) {
+	gotClusterId, gotAcls, err := parseKafkaAclsImportId(fmt.Sprintf("%s/%s", clusterId, kafkaAclsSerializedAcls(aclsFirstResourceName, aclsThirdResourceName)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gotClusterId != clusterId {
+		t.Fatalf("expected cluster ID %q, got %q", clusterId, gotClusterId)
+	}
+	if wantAcls := kafkaAclsExpectedAcls(aclsFirstResourceName, aclsThirdResourceName); !reflect.DeepEqual(gotAcls, wantAcls) {
+		t.Fatalf("expected %#v, got %#v", wantAcls, gotAcls)
+	}
+
+	// A bare resource ID is normalized the same way as in confluent_kafka_acl
+	_, gotAcls, err = parseKafkaAclsImportId(fmt.Sprintf("%s/TOPIC#orders#LITERAL#sa-abc123#*#READ#ALLOW", clusterId))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gotAcls[0].Principal != aclPrincipalWithResourceId {
+		t.Fatalf("expected principal %q, got %q", aclPrincipalWithResourceId, gotAcls[0].Principal)
+	}
+
+	for _, importId := range []string{
+		clusterId,
+		fmt.Sprintf("%s/", clusterId),
+		fmt.Sprintf("%s/TOPIC#orders#LITERAL", clusterId),
+		fmt.Sprintf("%s/TOPIC#orders#LITERAL#User:12345#*#READ#ALLOW", clusterId),
+	} {
+		if _, _, err := parseKafkaAclsImportId(importId); err == nil {
+			t.Fatalf("expected an error for %q", importId)
+		}
+	}
+}
+
+func TestKafkaAclsAclHash(t *testing.T) {
+	tfAcl := buildTfAcl(kafkaAclsExpectedAcls(aclsFirstResourceName)[0])
+	tfAclWithBarePrincipal := buildTfAcl(kafkaAclsExpectedAcls(aclsFirstResourceName)[0])
+	tfAclWithBarePrincipal[paramPrincipal] = strings.TrimPrefix(aclPrincipalWithResourceId, principalPrefix)
+
+	if kafkaAclsAclHash(tfAcl) != kafkaAclsAclHash(tfAclWithBarePrincipal) {
+		t.Fatalf("expected %q and %q principals to hash the same", tfAcl[paramPrincipal], tfAclWithBarePrincipal[paramPrincipal])
+	}
+	if tfAclWithBarePrincipal[paramPrincipal] == aclPrincipalWithResourceId {
+		t.Fatalf("expected the hash function not to modify its input")
+	}
+	if kafkaAclsAclHash(tfAcl) == kafkaAclsAclHash(buildTfAcl(kafkaAclsExpectedAcls(aclsSecondResourceName)[0])) {
+		t.Fatalf("expected different Kafka ACLs to hash differently")
+	}
+}
+
+func TestKafkaAclsResourceBarePrincipalHasNoDiff(t *testing.T) {
+	tfAcl := buildTfAcl(kafkaAclsExpectedAcls(aclsFirstResourceName)[0])
+	d := schema.TestResourceDataRaw(t, kafkaAclsResource().Schema, map[string]interface{}{
+		paramKafkaCluster: []interface{}{map[string]interface{}{paramId: clusterId}},
+		paramAcl:          []interface{}{tfAcl},
+	})
+	d.SetId(createKafkaAclsId(clusterId))
+
+	tfAclWithBarePrincipal := buildTfAcl(kafkaAclsExpectedAcls(aclsFirstResourceName)[0])
+	tfAclWithBarePrincipal[paramPrincipal] = strings.TrimPrefix(aclPrincipalWithResourceId, principalPrefix)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramKafkaCluster: []interface{}{map[string]interface{}{paramId: clusterId}},
+		paramAcl:          []interface{}{tfAclWithBarePrincipal},
+	})
+
+	diff, err := kafkaAclsResource().Diff(context.Background(), d.State(), config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected no diff, got %#v", diff.Attributes)
+	}
+}
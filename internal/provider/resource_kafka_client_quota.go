@@ -21,6 +21,7 @@ import (
 	quotas "github.com/confluentinc/ccloud-sdk-go-v2/kafka-quotas/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -69,10 +70,11 @@ func kafkaClientQuotaResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				MinItems:    1,
 				Required:    true,
-				Description: "A list of service accounts and identity pools. Special name \"default\" can be used to represent the default quota for all users and service accounts.",
+				Description: "A list of service accounts and identity pools. Special name \"<default>\" can be used to represent the default quota for all users and service accounts.",
 			},
 			paramThroughput: throughputSchema(),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -134,7 +136,10 @@ func kafkaClientQuotaCreate(ctx context.Context, d *schema.ResourceData, meta in
 	displayName := d.Get(paramDisplayName).(string)
 	description := d.Get(paramDescription).(string)
 	kafkaClusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Client Quota: %s", createDescriptiveError(err))
+	}
 	ingressByteRate := extractStringValueFromBlock(d, paramThroughput, paramIngressByteRate)
 	egressByteRate := extractStringValueFromBlock(d, paramThroughput, paramEgressByteRate)
 
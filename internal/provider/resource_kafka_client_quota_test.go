@@ -31,6 +31,7 @@ import (
 const (
 	scenarioStateKafkaClientQuotaHasBeenCreated             = "The new Kafka Client Quota has been just created"
 	scenarioStateKafkaClientQuotaDescriptionHaveBeenUpdated = "The new Kafka Client Quota has been just updated"
+	scenarioStateKafkaClientQuotaPrincipalHasBeenAdded      = "A principal has been just added to the new Kafka Client Quota"
 	scenarioStateKafkaClientQuotaHasBeenDeleted             = "The new Kafka Client Quota has been deleted"
 	kafkaClientQuotaScenarioName                            = "confluent_kafka_client_quota Resource Lifecycle"
 
@@ -108,6 +109,28 @@ func TestAccKafkaClientQuota(t *testing.T) {
 			http.StatusOK,
 		))
 
+	readUpdatedPrincipalsKafkaClientQuotaResponse, _ := ioutil.ReadFile("../testdata/kafka_client_quota/read_updated_principals_kafka_client_quota.json")
+	patchPrincipalsKafkaClientQuotaStub := wiremock.Patch(wiremock.URLPathEqualTo(kafkaClientQuotaUrlPath)).
+		InScenario(kafkaClientQuotaScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaClientQuotaDescriptionHaveBeenUpdated).
+		WithBodyPattern(wiremock.Contains("default")).
+		WillSetStateTo(scenarioStateKafkaClientQuotaPrincipalHasBeenAdded).
+		WillReturn(
+			string(readUpdatedPrincipalsKafkaClientQuotaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(patchPrincipalsKafkaClientQuotaStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaClientQuotaUrlPath)).
+		InScenario(kafkaClientQuotaScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaClientQuotaPrincipalHasBeenAdded).
+		WillReturn(
+			string(readUpdatedPrincipalsKafkaClientQuotaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	readDeletedKafkaClientQuotaResponse, _ := ioutil.ReadFile("../testdata/kafka_client_quota/read_deleted_kafka_client_quota.json")
 	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/kafka-quotas/v1/client-quotas/cq-e857e")).
 		InScenario(kafkaClientQuotaScenarioName).
@@ -120,7 +143,7 @@ func TestAccKafkaClientQuota(t *testing.T) {
 
 	deleteKafkaClientQuotaStub := wiremock.Delete(wiremock.URLPathEqualTo(kafkaClientQuotaUrlPath)).
 		InScenario(kafkaClientQuotaScenarioName).
-		WhenScenarioStateIs(scenarioStateKafkaClientQuotaDescriptionHaveBeenUpdated).
+		WhenScenarioStateIs(scenarioStateKafkaClientQuotaPrincipalHasBeenAdded).
 		WillSetStateTo(scenarioStateKafkaClientQuotaHasBeenDeleted).
 		WillReturn(
 			"",
@@ -135,6 +158,8 @@ func TestAccKafkaClientQuota(t *testing.T) {
 	kafkaClientQuotaUpdatedPrincipals := []string{"sa-rv1vo7"}
 	kafkaClientQuotaUpdatedIngressByteRate := "12280"
 	kafkaClientQuotaUpdatedEgressByteRate := "12281"
+	// in order to test adding a principal (step #5)
+	kafkaClientQuotaAddedPrincipals := []string{"sa-rv1vo7", "<default>"}
 	fullKafkaClientQuotaResourceLabel := fmt.Sprintf("confluent_kafka_client_quota.%s", kafkaClientQuotaResourceLabel)
 
 	resource.Test(t, resource.TestCase{
@@ -194,11 +219,25 @@ func TestAccKafkaClientQuota(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccCheckKafkaClientQuotaConfig(mockServerUrl, kafkaClientQuotaResourceLabel, kafkaClientQuotaUpdatedDisplayName, kafkaClientQuotaUpdatedDescription, kafkaClientQuotaAddedPrincipals, kafkaClientQuotaUpdatedIngressByteRate, kafkaClientQuotaUpdatedEgressByteRate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKafkaClientQuotaExists(fullKafkaClientQuotaResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotaResourceLabel, paramId, "cq-e857e"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotaResourceLabel, paramDisplayName, kafkaClientQuotaUpdatedDisplayName),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotaResourceLabel, fmt.Sprintf("%s.0.%s", paramThroughput, paramIngressByteRate), kafkaClientQuotaUpdatedIngressByteRate),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotaResourceLabel, fmt.Sprintf("%s.0.%s", paramThroughput, paramEgressByteRate), kafkaClientQuotaUpdatedEgressByteRate),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotaResourceLabel, fmt.Sprintf("%s.#", paramPrincipals), strconv.Itoa(len(kafkaClientQuotaAddedPrincipals))),
+					resource.TestCheckTypeSetElemAttr(fullKafkaClientQuotaResourceLabel, fmt.Sprintf("%s.*", paramPrincipals), kafkaClientQuotaAddedPrincipals[0]),
+					resource.TestCheckTypeSetElemAttr(fullKafkaClientQuotaResourceLabel, fmt.Sprintf("%s.*", paramPrincipals), kafkaClientQuotaAddedPrincipals[1]),
+				),
+			},
 		},
 	})
 
 	checkStubCount(t, wiremockClient, createKafkaClientQuotaStub, "POST /kafka-quotas/v1/client-quotas", expectedCountOne)
 	checkStubCount(t, wiremockClient, patchKafkaClientQuotaStub, "PATCH /kafka-quotas/v1/client-quotas/cq-e857e", expectedCountOne)
+	checkStubCount(t, wiremockClient, patchPrincipalsKafkaClientQuotaStub, "PATCH /kafka-quotas/v1/client-quotas/cq-e857e", expectedCountOne)
 	checkStubCount(t, wiremockClient, deleteKafkaClientQuotaStub, "DELETE /kafka-quotas/v1/client-quotas/cq-e857e", expectedCountOne)
 }
 
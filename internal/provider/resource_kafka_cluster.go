@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 	"net/http"
 	"regexp"
 	"strings"
@@ -55,6 +56,7 @@ const (
 	stateDone       = "DONE"
 
 	stateFailed        = "FAILED"
+	stateError         = "ERROR"
 	stateUnknown       = "UNKNOWN"
 	stateUnexpected    = "UNEXEPCTED"
 	stateProvisioned   = "PROVISIONED"
@@ -74,6 +76,7 @@ var acceptedCloudProviders = []string{"AWS", "AZURE", "GCP"}
 var acceptedClusterTypes = []string{paramBasicCluster, paramStandardCluster, paramDedicatedCluster, paramEnterpriseCluster, paramFreightCluster}
 var paramDedicatedCku = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramCku)
 var paramDedicatedEncryptionKey = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramEncryptionKey)
+var paramDedicatedZones = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramZones)
 
 func kafkaResource() *schema.Resource {
 	return &schema.Resource{
@@ -145,8 +148,9 @@ func kafkaResource() *schema.Resource {
 			},
 			paramEnvironment:          environmentSchema(),
 			paramConfluentCustomerKey: byokSchema(),
+			paramDeletionProtection:   deletionProtectionSchema(),
 		},
-		CustomizeDiff: customdiff.Sequence(resourceKafkaCustomizeDiff),
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff, resourceKafkaCustomizeDiff),
 		Timeouts: &schema.ResourceTimeout{
 			// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#provisioning-time
 			Create: schema.DefaultTimeout(getTimeoutFor(kafkaClusterTypeDedicated)),
@@ -172,12 +176,30 @@ func resourceKafkaCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 	// * Standard -> Basic
 	// * Basic -> Dedicated
 	// * Standard -> Dedicated
+	// * Standard -> Enterprise
 	// * etc.
-	isForbiddenStandardBasicUpdate := newClusterType == kafkaClusterTypeBasic && diff.HasChange(paramBasicCluster) && diff.HasChange(paramStandardCluster) && !diff.HasChange(paramDedicatedCluster)
-	isForbiddenDedicatedUpdate := diff.HasChange(paramDedicatedCluster) && (diff.HasChange(paramBasicCluster) || diff.HasChange(paramStandardCluster))
+	if diff.Id() != "" {
+		oldClusterType := extractOldClusterTypeResourceDiff(diff)
+		isClusterTypeUpdate := oldClusterType != "" && newClusterType != "" && oldClusterType != newClusterType
+		isBasicStandardUpdate := oldClusterType == kafkaClusterTypeBasic && newClusterType == kafkaClusterTypeStandard
+		if isClusterTypeUpdate && !isBasicStandardUpdate {
+			return fmt.Errorf("error updating Kafka Cluster %q: clusters can only be upgraded from 'Basic' to 'Standard', an update from %q to %q is not supported", diff.Id(), oldClusterType, newClusterType)
+		}
+	}
 
-	if isForbiddenStandardBasicUpdate || isForbiddenDedicatedUpdate {
-		return fmt.Errorf("error updating Kafka Cluster %q: clusters can only be upgraded from 'Basic' to 'Standard'", diff.Id())
+	if newClusterType == kafkaClusterTypeDedicated && diff.NewValueKnown(paramDedicatedZones) && diff.NewValueKnown(paramAvailability) {
+		zones := convertToStringSlice(diff.Get(paramDedicatedZones).([]interface{}))
+		// Zones are only known after apply when they're omitted in the config of a new cluster
+		if len(zones) > 0 {
+			if diff.Id() == "" {
+				if err := zonesCheck(zones, diff.Get(paramAvailability).(string)); err != nil {
+					return fmt.Errorf("error creating Kafka Cluster: %s", err)
+				}
+			} else if diff.HasChange(paramDedicatedZones) {
+				oldZones, _ := diff.GetChange(paramDedicatedZones)
+				return fmt.Errorf("error updating Kafka Cluster %q: %q can't be updated after the cluster is created, it's %v", diff.Id(), paramZones, convertToStringSlice(oldZones.([]interface{})))
+			}
+		}
 	}
 
 	return nil
@@ -188,6 +210,7 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	displayName := d.Get(paramDisplayName).(string)
 	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	defer c.responseCache.invalidate(kafkaClusterCacheKey(environmentId, d.Id()))
 	clusterType := extractClusterType(d)
 	// Non-zero value means CKU has been set
 	cku := extractCku(d)
@@ -247,6 +270,10 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.Errorf("error updating Kafka Cluster %q: error marshaling %#v to json: %s", d.Id(), updatedCluster, createDescriptiveError(err))
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Updated Kafka Cluster %q: %s", d.Id(), updatedClusterJson), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
+
+		if err := waitForKafkaClusterTypeUpdateToComplete(ctx, c, environmentId, d.Id(), kafkaClusterTypeStandard); err != nil {
+			return diag.Errorf("error waiting for Kafka Cluster %q to be upgraded to %q: %s", d.Id(), kafkaClusterTypeStandard, createDescriptiveError(err))
+		}
 	} else if isForbiddenStandardBasicDowngrade || isForbiddenDedicatedUpdate {
 		return diag.Errorf("error updating Kafka Cluster %q: clusters can only be upgraded from 'Basic' to 'Standard'", d.Id())
 	}
@@ -273,10 +300,13 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 		updatedCluster, _, err := req.Execute()
 		if err != nil {
-			return diag.Errorf("error updating Kafka Cluster %q: %s", d.Id(), createDescriptiveError(err))
+			// Shrinking might be rejected by the backend, for example, when the cluster was resized recently
+			// (cooldown window) or the remaining CKUs can't fit the current load, so surface the error as is.
+			oldCku, _ := d.GetChange(paramDedicatedCku)
+			return diag.Errorf("error updating Kafka Cluster %q CKUs from %d to %d: %s", d.Id(), oldCku.(int), cku, createDescriptiveError(err))
 		}
 
-		if err := waitForKafkaClusterCkuUpdateToComplete(c.cmkApiContext(ctx), c, environmentId, d.Id(), cku); err != nil {
+		if err := waitForKafkaClusterCkuUpdateToComplete(ctx, c, environmentId, d.Id(), cku); err != nil {
 			return diag.Errorf("error waiting for Kafka Cluster %q to perform CKU update: %s", d.Id(), createDescriptiveError(err))
 		}
 		updatedClusterJson, err := json.Marshal(updatedCluster)
@@ -304,7 +334,10 @@ func kafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	cloud := d.Get(paramCloud).(string)
 	region := d.Get(paramRegion).(string)
 	clusterType := extractClusterType(d)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Cluster: %s", createDescriptiveError(err))
+	}
 	networkId := extractStringValueFromBlock(d, paramNetwork, paramId)
 	byokId := extractStringValueFromBlock(d, paramConfluentCustomerKey, paramId)
 
@@ -329,6 +362,9 @@ func kafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		if encryptionKey != "" {
 			config.SetEncryptionKey(encryptionKey)
 		}
+		if zones := extractZones(d); len(zones) > 0 {
+			config.SetZones(zones)
+		}
 
 		spec.SetConfig(cmk.CmkV2DedicatedAsCmkV2ClusterSpecConfigOneOf(config))
 	} else if clusterType == kafkaClusterTypeEnterprise {
@@ -358,16 +394,16 @@ func kafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(createdKafkaCluster.GetId())
 
-	if err := waitForKafkaClusterToProvision(c.cmkApiContext(ctx), c, environmentId, d.Id(), clusterType); err != nil {
+	if err := waitForKafkaClusterToProvision(ctx, c, environmentId, d.Id(), clusterType); err != nil {
 		return diag.Errorf("error waiting for Kafka Cluster %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	environment, _, err := executeEnvironmentRead(c.orgApiContext(ctx), c, environmentId)
+	environment, err := executeCachedEnvironmentRead(c.orgApiContext(ctx), c, environmentId)
 	if err != nil {
 		return diag.Errorf("error reading Environment %q: %s", environmentId, createDescriptiveError(err))
 	}
 	if environment.StreamGovernanceConfig != nil {
-		if err := waitForAnySchemaRegistryClusterToProvision(c.srcmApiContext(ctx), c, environmentId); err != nil {
+		if err := waitForAnySchemaRegistryClusterToProvision(ctx, c, environmentId); err != nil {
 			return diag.Errorf("error waiting for Schema Registry Cluster to provision: %s", createDescriptiveError(err))
 		}
 	}
@@ -402,6 +438,40 @@ func extractClusterType(d *schema.ResourceData) string {
 	return ""
 }
 
+// extractClusterTypeFromConfig returns the type of the Kafka Cluster returned by the API.
+func extractClusterTypeFromConfig(config cmk.CmkV2ClusterSpecConfigOneOf) string {
+	if config.CmkV2Basic != nil {
+		return kafkaClusterTypeBasic
+	} else if config.CmkV2Standard != nil {
+		return kafkaClusterTypeStandard
+	} else if config.CmkV2Dedicated != nil {
+		return kafkaClusterTypeDedicated
+	} else if config.CmkV2Enterprise != nil {
+		return kafkaClusterTypeEnterprise
+	} else if config.CmkV2Freight != nil {
+		return kafkaClusterTypeFreight
+	}
+	return ""
+}
+
+// extractOldClusterTypeResourceDiff returns the type of the Kafka Cluster from TF state.
+func extractOldClusterTypeResourceDiff(d *schema.ResourceDiff) string {
+	clusterTypes := map[string]string{
+		paramBasicCluster:      kafkaClusterTypeBasic,
+		paramStandardCluster:   kafkaClusterTypeStandard,
+		paramDedicatedCluster:  kafkaClusterTypeDedicated,
+		paramEnterpriseCluster: kafkaClusterTypeEnterprise,
+		paramFreightCluster:    kafkaClusterTypeFreight,
+	}
+	for configBlockName, clusterType := range clusterTypes {
+		oldConfigBlock, _ := d.GetChange(configBlockName)
+		if len(oldConfigBlock.([]interface{})) == 1 {
+			return clusterType
+		}
+	}
+	return ""
+}
+
 func extractClusterTypeResourceDiff(d *schema.ResourceDiff) string {
 	basicConfigBlock := d.Get(paramBasicCluster).([]interface{})
 	standardConfigBlock := d.Get(paramStandardCluster).([]interface{})
@@ -433,11 +503,20 @@ func extractEncryptionKey(d *schema.ResourceData) string {
 	return d.Get(paramDedicatedEncryptionKey).(string)
 }
 
+func extractZones(d *schema.ResourceData) []string {
+	// d.Get() will return an empty list if the key is not present
+	return convertToStringSlice(d.Get(paramDedicatedZones).([]interface{}))
+}
+
 func kafkaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Cluster %q", d.Id()), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
+	if diags := checkDeletionProtection(d, "Kafka Cluster"); diags != nil {
+		return diags
+	}
 	c := meta.(*Client)
 
 	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	defer c.responseCache.invalidate(kafkaClusterCacheKey(environmentId, d.Id()))
 
 	req := c.cmkClient.ClustersCmkV2Api.DeleteCmkV2Cluster(c.cmkApiContext(ctx), d.Id()).Environment(environmentId)
 	_, err := req.Execute()
@@ -479,6 +558,21 @@ func executeKafkaRead(ctx context.Context, c *Client, environmentId string, clus
 	return req.Execute()
 }
 
+// executeCachedKafkaRead is meant for lookups of a Kafka Cluster that other objects reference;
+// it shouldn't be used for refreshing the Kafka Cluster resource itself or for polling.
+func executeCachedKafkaRead(ctx context.Context, c *Client, environmentId string, clusterId string) (cmk.CmkV2Cluster, error) {
+	cacheKey := kafkaClusterCacheKey(environmentId, clusterId)
+	if cachedCluster, ok := c.responseCache.get(cacheKey); ok {
+		return cachedCluster.(cmk.CmkV2Cluster), nil
+	}
+	cluster, _, err := executeKafkaRead(ctx, c, environmentId, clusterId)
+	if err != nil {
+		return cluster, err
+	}
+	c.responseCache.set(cacheKey, cluster)
+	return cluster, nil
+}
+
 func kafkaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster %q", d.Id()), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
 
@@ -517,6 +611,9 @@ func readKafkaClusterAndSetAttributes(ctx context.Context, d *schema.ResourceDat
 	if _, err := setKafkaClusterAttributes(d, cluster); err != nil {
 		return nil, createDescriptiveError(err)
 	}
+	if err := setDeletionProtectionDefault(d); err != nil {
+		return nil, createDescriptiveError(err)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Cluster %q", d.Id()), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
 
@@ -574,8 +671,9 @@ func dedicatedClusterSchema() *schema.Schema {
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
+					Optional:    true,
 					Computed:    true,
-					Description: "The list of zones the cluster is in.",
+					Description: "The list of zones the cluster is in. If not specified, Confluent Cloud picks the zones.",
 				},
 			},
 		},
@@ -648,6 +746,24 @@ func ckuCheck(cku int32, availability string) error {
 	return nil
 }
 
+// preserveZonesOrder returns the zones in the order they were specified in the config, if they're the same as
+// the zones returned by the API, to avoid a diff when the API returns them in a different order.
+func preserveZonesOrder(configuredZones, zones []string) []string {
+	if len(configuredZones) != len(zones) || len(lo.Intersect(configuredZones, zones)) != len(zones) {
+		return zones
+	}
+	return configuredZones
+}
+
+func zonesCheck(zones []string, availability string) error {
+	if len(zones) != 1 && (availability == singleZone || availability == lowAvailability) {
+		return fmt.Errorf("single-zone dedicated clusters must be placed in exactly 1 zone, got %d: %v", len(zones), zones)
+	} else if len(zones) != 3 && (availability == multiZone || availability == highAvailability) {
+		return fmt.Errorf("multi-zone dedicated clusters must be placed in exactly 3 zones, got %d: %v", len(zones), zones)
+	}
+	return nil
+}
+
 func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster) (*schema.ResourceData, error) {
 	if err := d.Set(paramApiVersion, cluster.GetApiVersion()); err != nil {
 		return nil, err
@@ -698,7 +814,7 @@ func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster)
 		if err := d.Set(paramDedicatedCluster, []interface{}{map[string]interface{}{
 			paramCku:           cluster.Status.GetCku(),
 			paramEncryptionKey: cluster.Spec.Config.CmkV2Dedicated.GetEncryptionKey(),
-			paramZones:         cluster.Spec.Config.CmkV2Dedicated.GetZones(),
+			paramZones:         preserveZonesOrder(extractZones(d), cluster.Spec.Config.CmkV2Dedicated.GetZones()),
 		}}); err != nil {
 			return nil, err
 		}
@@ -36,7 +36,12 @@ var editableClusterSettings = []string{
 	"log.retention.ms",
 }
 
-const docsClusterConfigUrl = "https://docs.confluent.io/cloud/current/clusters/broker-config.html#change-cluster-settings-for-dedicated-clusters"
+const (
+	docsClusterConfigUrl = "https://docs.confluent.io/cloud/current/clusters/broker-config.html#change-cluster-settings-for-dedicated-clusters"
+
+	// Resets a cluster setting to its default value
+	clusterConfigOperationDelete = "DELETE"
+)
 
 func kafkaConfigResource() *schema.Resource {
 	return &schema.Resource{
@@ -117,10 +122,40 @@ func executeKafkaConfigCreate(ctx context.Context, c *KafkaRestClient, requestDa
 }
 
 func kafkaConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: KCFUN-389
-	// Call Reset Cluster Config method once KCFUN-389 is done. Currently kafkaConfigDelete() does nothing.
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Config %q", d.Id()), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
 
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Config: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Config: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Config: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+
+	// Reset every cluster setting managed by this resource to its default value
+	settingNames := make([]string, 0)
+	for settingName := range d.Get(paramConfigs).(map[string]interface{}) {
+		settingNames = append(settingNames, settingName)
+	}
+	deleteConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
+		Data: extractClusterConfigsToReset(settingNames),
+	}
+	deleteConfigRequestJson, err := json.Marshal(deleteConfigRequest)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Config: error marshaling %#v to json: %s", deleteConfigRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Resetting Kafka Config %q: %s", d.Id(), deleteConfigRequestJson), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	if _, err := executeKafkaConfigUpdate(ctx, kafkaRestClient, deleteConfigRequest); err != nil {
+		return diag.Errorf("error deleting Kafka Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Kafka Config %q", d.Id()), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
 
 	return nil
@@ -234,6 +269,7 @@ func kafkaConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		// TF Provider allows the following operations for editable cluster settings under 'config' block:
 		// 1. Adding new key value pair, for example, "retention.ms" = "600000"
 		// 2. Update a value for existing key value pair, for example, "retention.ms" = "600000" -> "retention.ms" = "600001"
+		// 3. Removing existing key value pair, which resets the cluster setting to its default value
 		// You might find the list of editable cluster settings and their limits at
 		// https://docs.confluent.io/cloud/current/clusters/broker-config.html#change-cluster-settings-for-dedicated-clusters
 		//https://registry.terraform.io/providers/confluentinc/confluent/latest/docs/resources/confluent_kafka_cluster_config
@@ -243,19 +279,18 @@ func kafkaConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		// * 'new' cluster settings -- all cluster settings from TF configuration _after_ changes
 		oldClusterSettingsMap, newClusterSettingsMap := extractOldAndNewSettings(d)
 
-		// Verify that no cluster settings were removed (reset to its default value) in TF configuration which is an unsupported operation at the moment
+		// Find cluster settings that were removed from TF configuration to reset them to their default values
+		removedSettingNames := make([]string, 0)
 		for oldSettingName := range oldClusterSettingsMap {
 			if _, ok := newClusterSettingsMap[oldSettingName]; !ok {
-				return diag.Errorf("error updating Kafka Config %q: reset to cluster setting's default value operation (in other words, removing cluster settings from %q block) "+
-					"is not supported at the moment. "+
-					"Instead, find its default value at %s and set its current value to the default value.", d.Id(), paramConfigs, docsUrl)
+				removedSettingNames = append(removedSettingNames, oldSettingName)
 			}
 		}
 
 		// Construct a request for Kafka REST API
 		_, newSettingsMapAny := d.GetChange(paramConfigs)
 		updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
-			Data: extractClusterConfigs(newSettingsMapAny.(map[string]interface{})),
+			Data: append(extractClusterConfigs(newSettingsMapAny.(map[string]interface{})), extractClusterConfigsToReset(removedSettingNames)...),
 		}
 		restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
 		if err != nil {
@@ -318,3 +353,16 @@ func extractClusterConfigs(configs map[string]interface{}) []kafkarestv3.AlterCo
 
 	return configResult
 }
+
+func extractClusterConfigsToReset(settingNames []string) []kafkarestv3.AlterConfigBatchRequestDataData {
+	configResult := make([]kafkarestv3.AlterConfigBatchRequestDataData, len(settingNames))
+
+	for i, name := range settingNames {
+		configResult[i] = kafkarestv3.AlterConfigBatchRequestDataData{
+			Name:      name,
+			Operation: *kafkarestv3.NewNullableString(kafkarestv3.PtrString(clusterConfigOperationDelete)),
+		}
+	}
+
+	return configResult
+}
@@ -84,6 +84,17 @@ func TestAccClusterConfigWithEnhancedProviderBlock(t *testing.T) {
 			http.StatusOK,
 		))
 
+	resetConfigStub := wiremock.Post(wiremock.URLPathEqualTo(updateKafkaConfigPath)).
+		WithBodyPattern(wiremock.Contains(clusterConfigOperationDelete)).
+		InScenario(configScenarioName).
+		WhenScenarioStateIs(scenarioStateConfigHasBeenUpdated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(resetConfigStub)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -132,7 +143,8 @@ func TestAccClusterConfigWithEnhancedProviderBlock(t *testing.T) {
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createConfigStub, fmt.Sprintf("POST %s", updateKafkaConfigPath), 2)
+	checkStubCount(t, wiremockClient, createConfigStub, fmt.Sprintf("POST %s", updateKafkaConfigPath), 3)
+	checkStubCount(t, wiremockClient, resetConfigStub, fmt.Sprintf("POST %s", updateKafkaConfigPath), 1)
 }
 
 func testAccCheckConfigConfigWithEnhancedProviderBlock(confluentCloudBaseUrl, mockServerUrl string) string {
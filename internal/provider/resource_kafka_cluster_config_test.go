@@ -109,6 +109,17 @@ func TestAccClusterConfig(t *testing.T) {
 			http.StatusOK,
 		))
 
+	resetConfigStub := wiremock.Post(wiremock.URLPathEqualTo(updateKafkaConfigPath)).
+		WithBodyPattern(wiremock.Contains(clusterConfigOperationDelete)).
+		InScenario(configScenarioName).
+		WhenScenarioStateIs(scenarioStateConfigHasBeenUpdated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(resetConfigStub)
+
 	// Set fake values for secrets since those are required for importing
 	_ = os.Setenv("IMPORT_KAFKA_API_KEY", kafkaApiKey)
 	_ = os.Setenv("IMPORT_KAFKA_API_SECRET", kafkaApiSecret)
@@ -175,7 +186,92 @@ func TestAccClusterConfig(t *testing.T) {
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createConfigStub, fmt.Sprintf("POST %s", updateKafkaConfigPath), 2)
+	checkStubCount(t, wiremockClient, createConfigStub, fmt.Sprintf("POST %s", updateKafkaConfigPath), 3)
+	checkStubCount(t, wiremockClient, resetConfigStub, fmt.Sprintf("POST %s", updateKafkaConfigPath), 1)
+}
+
+func TestAccClusterConfigDrift(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockConfigTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockConfigTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(updateKafkaConfigPath)).
+		InScenario(configScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateConfigHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	readCreatedConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_config/read_created_kafka_config.json")
+	readCreatedConfigStub := wiremock.Get(wiremock.URLPathEqualTo(readKafkaConfigPath)).
+		InScenario(configScenarioName).
+		WhenScenarioStateIs(scenarioStateConfigHasBeenCreated).
+		WillReturn(
+			string(readCreatedConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readCreatedConfigStub)
+
+	// num.partitions was changed outside of Terraform
+	readDriftedConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_config/read_drifted_kafka_config.json")
+	readDriftedConfigStub := wiremock.Get(wiremock.URLPathEqualTo(readKafkaConfigPath)).
+		InScenario(configScenarioName).
+		WhenScenarioStateIs(scenarioStateConfigHasBeenCreated).
+		WillReturn(
+			string(readDriftedConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(updateKafkaConfigPath)).
+		WithBodyPattern(wiremock.Contains(clusterConfigOperationDelete)).
+		InScenario(configScenarioName).
+		WhenScenarioStateIs(scenarioStateConfigHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckConfigConfig(confluentCloudBaseUrl, mockConfigTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(fullConfigResourceLabel),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", thirdClusterConfigName), thirdClusterConfigValue),
+				),
+			},
+			{
+				PreConfig: func() {
+					_ = wiremockClient.DeleteStub(readCreatedConfigStub)
+					_ = wiremockClient.StubFor(readDriftedConfigStub)
+				},
+				Config:             testAccCheckConfigConfig(confluentCloudBaseUrl, mockConfigTestServerUrl),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
 }
 
 func testAccCheckConfigDestroy(s *terraform.State) error {
@@ -0,0 +1,438 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateDedicatedKafkaHasBeenCreated  = "A new Kafka Dedicated cluster has been just created"
+	scenarioStateDedicatedKafkaIsExpanding     = "The new Kafka Dedicated cluster is expanding"
+	scenarioStateDedicatedKafkaHasBeenExpanded = "The new Kafka Dedicated cluster has been just expanded"
+	scenarioStateDedicatedKafkaIsShrinking     = "The new Kafka Dedicated cluster is shrinking"
+	scenarioStateDedicatedKafkaHasBeenShrunk   = "The new Kafka Dedicated cluster has been just shrunk"
+	scenarioStateDedicatedKafkaHasBeenDeleted  = "The new Kafka Dedicated cluster has been deleted"
+	dedicatedKafkaScenarioName                 = "confluent_kafka Dedicated Resource Lifecycle"
+	dedicatedKafkaInitialCku                   = 1
+	dedicatedKafkaExpandedCku                  = 2
+
+	scenarioStateMultiZoneDedicatedKafkaHasBeenCreated = "A new multi-zone Kafka Dedicated cluster has been just created"
+	scenarioStateMultiZoneDedicatedKafkaHasBeenDeleted = "The new multi-zone Kafka Dedicated cluster has been deleted"
+	multiZoneDedicatedKafkaScenarioName                = "confluent_kafka Multi-Zone Dedicated With Zones Resource Lifecycle"
+)
+
+func TestAccDedicatedClusterCkuUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readCreatedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_created_dedicated_kafka.json")
+	createClusterStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateDedicatedKafkaHasBeenCreated).
+		WillReturn(
+			string(readCreatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusAccepted,
+		)
+	_ = wiremockClient.StubFor(createClusterStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenCreated).
+		WillReturn(
+			string(readCreatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readEnvironmentResponse, _ := ioutil.ReadFile("../testdata/environment/read_created_env_without_sg.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readEnvPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenCreated).
+		WillReturn(
+			string(readEnvironmentResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readExpandingClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_expanding_dedicated_kafka.json")
+	expandClusterStub := wiremock.Patch(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"cku":%d`, dedicatedKafkaExpandedCku))).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenCreated).
+		WillSetStateTo(scenarioStateDedicatedKafkaIsExpanding).
+		WillReturn(
+			string(readExpandingClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(expandClusterStub)
+
+	// Return the intermediate state once to make sure the provider keeps polling until the resize completes
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaIsExpanding).
+		WillSetStateTo(scenarioStateDedicatedKafkaHasBeenExpanded).
+		WillReturn(
+			string(readExpandingClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readExpandedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_expanded_dedicated_kafka.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenExpanded).
+		WillReturn(
+			string(readExpandedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readShrinkingClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_shrinking_dedicated_kafka.json")
+	shrinkClusterStub := wiremock.Patch(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"cku":%d`, dedicatedKafkaInitialCku))).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenExpanded).
+		WillSetStateTo(scenarioStateDedicatedKafkaIsShrinking).
+		WillReturn(
+			string(readShrinkingClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(shrinkClusterStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaIsShrinking).
+		WillSetStateTo(scenarioStateDedicatedKafkaHasBeenShrunk).
+		WillReturn(
+			string(readShrinkingClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readShrunkClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_shrunk_dedicated_kafka.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenShrunk).
+		WillReturn(
+			string(readShrunkClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteClusterStub := wiremock.Delete(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenShrunk).
+		WillSetStateTo(scenarioStateDedicatedKafkaHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteClusterStub)
+
+	readDeletedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_deleted_kafka.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(dedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateDedicatedKafkaHasBeenDeleted).
+		WillReturn(
+			string(readDeletedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDedicatedClusterConfig(mockServerUrl, dedicatedKafkaInitialCku),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(fullKafkaResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "id", kafkaClusterId),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.cku", fmt.Sprintf("%d", dedicatedKafkaInitialCku)),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "basic.#", "0"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "standard.#", "0"),
+				),
+			},
+			{
+				// Expand the cluster
+				Config: testAccCheckDedicatedClusterConfig(mockServerUrl, dedicatedKafkaExpandedCku),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(fullKafkaResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "id", kafkaClusterId),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.cku", fmt.Sprintf("%d", dedicatedKafkaExpandedCku)),
+				),
+			},
+			{
+				// Shrink the cluster
+				Config: testAccCheckDedicatedClusterConfig(mockServerUrl, dedicatedKafkaInitialCku),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(fullKafkaResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "id", kafkaClusterId),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.cku", fmt.Sprintf("%d", dedicatedKafkaInitialCku)),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createClusterStub, fmt.Sprintf("POST %s", createKafkaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, expandClusterStub, fmt.Sprintf("PATCH %s", readKafkaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, shrinkClusterStub, fmt.Sprintf("PATCH %s", readKafkaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteClusterStub, fmt.Sprintf("DELETE %s", readKafkaPath), expectedCountOne)
+}
+
+func TestAccDedicatedClusterWithZones(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readCreatedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_created_multi_zone_dedicated_kafka.json")
+	createClusterStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaPath)).
+		InScenario(multiZoneDedicatedKafkaScenarioName).
+		WithBodyPattern(wiremock.Contains(`"zones":["us-central1-a","us-central1-b","us-central1-c"]`)).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateMultiZoneDedicatedKafkaHasBeenCreated).
+		WillReturn(
+			string(readCreatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusAccepted,
+		)
+	_ = wiremockClient.StubFor(createClusterStub)
+
+	// The API returns the zones in a different order than they were specified in
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(multiZoneDedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateMultiZoneDedicatedKafkaHasBeenCreated).
+		WillReturn(
+			string(readCreatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readEnvironmentResponse, _ := ioutil.ReadFile("../testdata/environment/read_created_env_without_sg.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readEnvPath)).
+		InScenario(multiZoneDedicatedKafkaScenarioName).
+		WhenScenarioStateIs(scenarioStateMultiZoneDedicatedKafkaHasBeenCreated).
+		WillReturn(
+			string(readEnvironmentResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteClusterStub := wiremock.Delete(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(multiZoneDedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateMultiZoneDedicatedKafkaHasBeenCreated).
+		WillSetStateTo(scenarioStateMultiZoneDedicatedKafkaHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteClusterStub)
+
+	readDeletedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_deleted_kafka.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaPath)).
+		InScenario(multiZoneDedicatedKafkaScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateMultiZoneDedicatedKafkaHasBeenDeleted).
+		WillReturn(
+			string(readDeletedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDedicatedClusterWithZonesConfig(mockServerUrl, multiZone, `["us-central1-a", "us-central1-b", "us-central1-c"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(fullKafkaResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "id", kafkaClusterId),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "availability", multiZone),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.cku", "2"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.zones.#", "3"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.zones.0", "us-central1-a"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.zones.1", "us-central1-b"),
+					resource.TestCheckResourceAttr(fullKafkaResourceLabel, "dedicated.0.zones.2", "us-central1-c"),
+				),
+			},
+			{
+				Config:      testAccCheckDedicatedClusterWithZonesConfig(mockServerUrl, multiZone, `["us-central1-a", "us-central1-b", "us-central1-f"]`),
+				ExpectError: regexp.MustCompile(`"zones" can't be updated after the cluster is created`),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createClusterStub, fmt.Sprintf("POST %s", createKafkaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteClusterStub, fmt.Sprintf("DELETE %s", readKafkaPath), expectedCountOne)
+}
+
+func TestResourceKafkaCustomizeDiffZones(t *testing.T) {
+	tests := []struct {
+		name          string
+		availability  string
+		zones         []interface{}
+		expectedError string
+	}{
+		{"multi-zone with 3 zones", multiZone, []interface{}{"use1-az1", "use1-az2", "use1-az4"}, ""},
+		{"single-zone with 1 zone", singleZone, []interface{}{"use1-az1"}, ""},
+		{"zones picked by Confluent Cloud", multiZone, nil, ""},
+		{"multi-zone with 2 zones", multiZone, []interface{}{"use1-az1", "use1-az2"}, "multi-zone dedicated clusters must be placed in exactly 3 zones, got 2"},
+		{"single-zone with 3 zones", singleZone, []interface{}{"use1-az1", "use1-az2", "use1-az4"}, "single-zone dedicated clusters must be placed in exactly 1 zone, got 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dedicated := map[string]interface{}{
+				paramCku: 2,
+			}
+			if tt.zones != nil {
+				dedicated[paramZones] = tt.zones
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				paramDisplayName:      kafkaDisplayName,
+				paramAvailability:     tt.availability,
+				paramCloud:            "AWS",
+				paramRegion:           "us-east-1",
+				paramDedicatedCluster: []interface{}{dedicated},
+				paramEnvironment: []interface{}{map[string]interface{}{
+					paramId: testEnvironmentId,
+				}},
+			})
+			_, err := kafkaResource().Diff(context.Background(), nil, config, &Client{})
+			if tt.expectedError == "" && err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if tt.expectedError != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedError)) {
+				t.Fatalf("expected an error containing %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestPreserveZonesOrder(t *testing.T) {
+	configuredZones := []string{"use1-az1", "use1-az2", "use1-az4"}
+	if got := preserveZonesOrder(configuredZones, []string{"use1-az4", "use1-az1", "use1-az2"}); !reflect.DeepEqual(got, configuredZones) {
+		t.Fatalf("expected the configured order %v, got %v", configuredZones, got)
+	}
+	apiZones := []string{"use1-az4", "use1-az1", "use1-az3"}
+	if got := preserveZonesOrder(configuredZones, apiZones); !reflect.DeepEqual(got, apiZones) {
+		t.Fatalf("expected the zones returned by the API %v, got %v", apiZones, got)
+	}
+	if got := preserveZonesOrder(nil, apiZones); !reflect.DeepEqual(got, apiZones) {
+		t.Fatalf("expected the zones returned by the API %v, got %v", apiZones, got)
+	}
+}
+
+func testAccCheckDedicatedClusterWithZonesConfig(mockServerUrl, availability, zones string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	resource "confluent_kafka_cluster" "basic-cluster" {
+		display_name = "%s"
+		availability = "%s"
+		cloud = "%s"
+		region = "%s"
+		dedicated {
+			cku = 2
+			zones = %s
+		}
+
+	  	environment {
+			id = "%s"
+	  	}
+	}
+	`, mockServerUrl, kafkaDisplayName, availability, kafkaCloud, kafkaRegion, zones, testEnvironmentId)
+}
+
+func testAccCheckDedicatedClusterConfig(mockServerUrl string, cku int) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	resource "confluent_kafka_cluster" "basic-cluster" {
+		display_name = "%s"
+		availability = "%s"
+		cloud = "%s"
+		region = "%s"
+		dedicated {
+			cku = %d
+		}
+
+	  	environment {
+			id = "%s"
+	  	}
+	}
+	`, mockServerUrl, kafkaDisplayName, kafkaAvailability, kafkaCloud, kafkaRegion, cku, testEnvironmentId)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -273,6 +274,70 @@ func TestAccClusterWithSGPackage(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteClusterStub, fmt.Sprintf("DELETE %s", readKafkaPath), expectedCountOne)
 }
 
+func TestResourceKafkaCustomizeDiffClusterType(t *testing.T) {
+	clusterConfigBlocks := map[string]interface{}{
+		paramBasicCluster:      []interface{}{map[string]interface{}{}},
+		paramStandardCluster:   []interface{}{map[string]interface{}{}},
+		paramDedicatedCluster:  []interface{}{map[string]interface{}{paramCku: 2}},
+		paramEnterpriseCluster: []interface{}{map[string]interface{}{}},
+	}
+	tests := []struct {
+		oldConfigBlockName string
+		newConfigBlockName string
+		expectedError      bool
+	}{
+		{paramBasicCluster, paramStandardCluster, false},
+		{paramBasicCluster, paramBasicCluster, false},
+		{paramStandardCluster, paramBasicCluster, true},
+		{paramBasicCluster, paramDedicatedCluster, true},
+		{paramStandardCluster, paramDedicatedCluster, true},
+		{paramBasicCluster, paramEnterpriseCluster, true},
+		{paramStandardCluster, paramEnterpriseCluster, true},
+		{paramEnterpriseCluster, paramStandardCluster, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s to %s", tt.oldConfigBlockName, tt.newConfigBlockName), func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: kafkaClusterId,
+				Attributes: map[string]string{
+					paramId:                               kafkaClusterId,
+					paramDisplayName:                      kafkaDisplayName,
+					paramAvailability:                     kafkaAvailability,
+					paramCloud:                            kafkaCloud,
+					paramRegion:                           kafkaRegion,
+					fmt.Sprintf("%s.#", paramEnvironment): "1",
+					fmt.Sprintf("%s.0.%s", paramEnvironment, paramId): testEnvironmentId,
+					fmt.Sprintf("%s.#", tt.oldConfigBlockName):        "1",
+				},
+			}
+			if tt.oldConfigBlockName == paramDedicatedCluster {
+				state.Attributes[paramDedicatedCku] = "2"
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				paramDisplayName:      kafkaDisplayName,
+				paramAvailability:     kafkaAvailability,
+				paramCloud:            kafkaCloud,
+				paramRegion:           kafkaRegion,
+				tt.newConfigBlockName: clusterConfigBlocks[tt.newConfigBlockName],
+				paramEnvironment: []interface{}{map[string]interface{}{
+					paramId: testEnvironmentId,
+				}},
+			})
+			diff, err := kafkaResource().Diff(context.Background(), state, config, &Client{})
+			if tt.expectedError && (err == nil || !strings.Contains(err.Error(), "clusters can only be upgraded from 'Basic' to 'Standard'")) {
+				t.Fatalf("expected an error, got: %v", err)
+			}
+			if !tt.expectedError && err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if !tt.expectedError && diff != nil && diff.RequiresNew() {
+				t.Fatalf("expected the Kafka Cluster to be updated in place")
+			}
+		})
+	}
+}
+
 func testAccCheckClusterDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 	// Loop through the resources in state, verifying each environment is destroyed
@@ -228,7 +228,7 @@ func kafkaMirrorTopicDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error deleting Kafka Mirror Topic %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForKafkaMirrorTopicToBeDeleted(kafkaRestClient.apiContext(ctx), kafkaRestClient, linkName, mirrorTopicName, meta.(*Client).isAcceptanceTestMode); err != nil {
+	if err := waitForKafkaMirrorTopicToBeDeleted(ctx, kafkaRestClient, linkName, mirrorTopicName, meta.(*Client).isAcceptanceTestMode); err != nil {
 		return diag.Errorf("error waiting for Kafka Mirror Topic %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -302,7 +302,7 @@ func kafkaMirrorTopicUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return diag.Errorf("error updating Kafka Mirror Topic %q: %s", d.Id(), createDescriptiveError(err))
 			}
-			if err := waitForKafkaMirrorTopicToChangeStatus(kafkaRestClient.apiContext(ctx), kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, stateActive, statePaused, meta.(*Client).isAcceptanceTestMode); err != nil {
+			if err := waitForKafkaMirrorTopicToChangeStatus(ctx, kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, stateActive, statePaused, meta.(*Client).isAcceptanceTestMode); err != nil {
 				return diag.Errorf("error waiting for Kafka Mirror Topic %q to be updated: %s", d.Id(), createDescriptiveError(err))
 			}
 		} else if shouldResumeKafkaMirrorTopic {
@@ -315,7 +315,7 @@ func kafkaMirrorTopicUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return diag.Errorf("error updating Kafka Mirror Topic %q: %s", d.Id(), createDescriptiveError(err))
 			}
-			if err := waitForKafkaMirrorTopicToChangeStatus(kafkaRestClient.apiContext(ctx), kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, statePaused, stateActive, meta.(*Client).isAcceptanceTestMode); err != nil {
+			if err := waitForKafkaMirrorTopicToChangeStatus(ctx, kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, statePaused, stateActive, meta.(*Client).isAcceptanceTestMode); err != nil {
 				return diag.Errorf("error waiting for Kafka Mirror Topic %q to be updated: %s", d.Id(), createDescriptiveError(err))
 			}
 		} else if shouldFailoverKafkaMirrorTopic {
@@ -328,7 +328,7 @@ func kafkaMirrorTopicUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return diag.Errorf("error updating Kafka Mirror Topic %q: %s", d.Id(), createDescriptiveError(err))
 			}
-			if err := waitForKafkaMirrorTopicToChangeStatus(kafkaRestClient.apiContext(ctx), kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, oldStatus, stateStopped, meta.(*Client).isAcceptanceTestMode); err != nil {
+			if err := waitForKafkaMirrorTopicToChangeStatus(ctx, kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, oldStatus, stateStopped, meta.(*Client).isAcceptanceTestMode); err != nil {
 				return diag.Errorf("error waiting for Kafka Mirror Topic %q to be updated: %s", d.Id(), createDescriptiveError(err))
 			}
 		} else if shouldPromoteKafkaMirrorTopic {
@@ -341,7 +341,7 @@ func kafkaMirrorTopicUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return diag.Errorf("error updating Kafka Mirror Topic %q: %s", d.Id(), createDescriptiveError(err))
 			}
-			if err := waitForKafkaMirrorTopicToChangeStatus(kafkaRestClient.apiContext(ctx), kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, oldStatus, stateStopped, meta.(*Client).isAcceptanceTestMode); err != nil {
+			if err := waitForKafkaMirrorTopicToChangeStatus(ctx, kafkaRestClient, kafkaRestClient.clusterId, linkName, mirrorTopicName, oldStatus, stateStopped, meta.(*Client).isAcceptanceTestMode); err != nil {
 				return diag.Errorf("error waiting for Kafka Mirror Topic %q to be updated: %s", d.Id(), createDescriptiveError(err))
 			}
 		} else {
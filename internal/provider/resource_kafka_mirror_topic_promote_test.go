@@ -0,0 +1,179 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	scenarioStateKafkaMirrorTopicHasBeenPromoted = "The Kafka Mirror Topic has been promoted"
+)
+
+var promoteKafkaMirrorTopicPath = fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors:promote", destinationClusterId, clusterLinkName)
+
+func TestAccKafkaMirrorTopicPromote(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockKafkaMirrorTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockKafkaMirrorTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createKafkaMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topic/regular/create_kafka_mirror_topic.json")
+	createKafkaMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicHasBeenCreated).
+		WillReturn(
+			string(createKafkaMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createKafkaMirrorTopicStub)
+
+	readCreatedKafkaMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topic/regular/read_created_kafka_mirror_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedKafkaMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	promoteKafkaMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(promoteKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicHasBeenCreated).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicHasBeenPromoted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(promoteKafkaMirrorTopicStub)
+
+	// API returns "STOPPED" status for a promoted Kafka Mirror Topic
+	readPromotedKafkaMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topic/regular/read_promoted_kafka_mirror_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicHasBeenPromoted).
+		WillReturn(
+			string(readPromotedKafkaMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteKafkaMirrorTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicHasBeenPromoted).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteKafkaMirrorTopicStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckKafkaMirrorTopicDestroy(s, mockKafkaMirrorTestServerUrl)
+		},
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKafkaMirrorTopicConfig(confluentCloudBaseUrl, mockKafkaMirrorTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKafkaMirrorTopicExists(fullKafkaMirrorTopicResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "mirror_topic_name", kafkaMirrorTopicName),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "status", stateActive),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "id", fmt.Sprintf("%s/%s/%s", destinationClusterId, clusterLinkName, kafkaMirrorTopicName)),
+				),
+			},
+			{
+				Config: testAccCheckKafkaMirrorTopicWithStatusConfig(confluentCloudBaseUrl, mockKafkaMirrorTestServerUrl, statePromoted),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKafkaMirrorTopicExists(fullKafkaMirrorTopicResourceLabel),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "mirror_topic_name", kafkaMirrorTopicName),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "status", stateStopped),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "source_kafka_topic.0.topic_name", kafkaMirrorTopicName),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "cluster_link.0.link_name", clusterLinkName),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicResourceLabel, "id", fmt.Sprintf("%s/%s/%s", destinationClusterId, clusterLinkName, kafkaMirrorTopicName)),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createKafkaMirrorTopicStub, fmt.Sprintf("POST %s", createKafkaMirrorTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, promoteKafkaMirrorTopicStub, fmt.Sprintf("POST %s", promoteKafkaMirrorTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteKafkaMirrorTopicStub, fmt.Sprintf("DELETE %s", deleteKafkaMirrorTopicPath), expectedCountOne)
+}
+
+func testAccCheckKafkaMirrorTopicWithStatusConfig(confluentCloudBaseUrl, mockServerUrl, status string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_kafka_mirror_topic" "%s" {
+      status = "%s"
+      mirror_topic_name = "%s"
+	  source_kafka_topic {
+        topic_name = "%s"
+      }
+      cluster_link {
+        link_name = "%s"
+      }
+      kafka_cluster {
+        id = "%s"
+        rest_endpoint = "%s"
+        credentials {
+		  key = "%s"
+		  secret = "%s"
+	    }
+      }
+	}
+	`, confluentCloudBaseUrl, kafkaMirrorTopicResourceLabel, status,
+		kafkaMirrorTopicName, kafkaMirrorTopicName, clusterLinkName,
+		destinationClusterId, mockServerUrl, destinationClusterApiKey, destinationClusterApiSecret)
+}
@@ -18,14 +18,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
 	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 )
@@ -41,6 +44,12 @@ const (
 	kafkaRestAPIWaitAfterCreate = 10 * time.Second
 	docsUrl                     = "https://registry.terraform.io/providers/confluentinc/confluent/latest/docs/resources/confluent_kafka_topic"
 	dynamicTopicConfig          = "DYNAMIC_TOPIC_CONFIG"
+
+	paramReplicaPlacement = "replica_placement"
+	// The topic setting that stores the replica placement policy of a topic
+	// https://docs.confluent.io/platform/current/multi-dc-deployments/multi-region.html#replica-placement
+	placementConstraintsTopicConfig = "confluent.placement.constraints"
+	alterConfigOperationDelete      = "DELETE"
 )
 
 // https://docs.confluent.io/cloud/current/client-apps/topics/manage.html#ak-topic-configurations-for-all-ccloud-cluster-types
@@ -114,6 +123,14 @@ func kafkaTopicResource() *schema.Resource {
 				Computed:    true,
 				Description: "The custom topic settings to set (e.g., `\"cleanup.policy\" = \"compact\"`).",
 			},
+			paramReplicaPlacement: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The replica placement policy of the topic in JSON format. It is supported for Dedicated Kafka clusters only.",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
 			paramCredentials: credentialsSchema(),
 		},
 		SchemaVersion: 2,
@@ -129,17 +146,39 @@ func kafkaTopicResource() *schema.Resource {
 				Version: 1,
 			},
 		},
-		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange(paramPartitionsCount, func(ctx context.Context, old, new, meta interface{}) bool {
-				// "partition" can only increase in-place, so we must create a new resource
-				// if it is decreased.
-				return new.(int) < old.(int)
-			}),
-		),
+		CustomizeDiff: customdiff.Sequence(resourceKafkaTopicCustomizeDiff),
 	}
 }
 
+func resourceKafkaTopicCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.Get(paramConfigs).(map[string]interface{})[placementConstraintsTopicConfig]; ok && diff.Get(paramReplicaPlacement).(string) != "" {
+		return fmt.Errorf("%q topic setting can't be set in %q block when %q attribute is set", placementConstraintsTopicConfig, paramConfigs, paramReplicaPlacement)
+	}
+
+	// Skip new topics and topics that are going to be recreated anyway
+	if diff.Id() == "" || diff.HasChanges(paramTopicName, paramKafkaCluster) {
+		return nil
+	}
+
+	// Display an error during `terraform plan` instead of failing with a Kafka error during `terraform apply`:
+	// Kafka doesn't support reducing the number of partitions of an existing topic.
+	if diff.HasChange(paramPartitionsCount) {
+		oldPartitionsCount, newPartitionsCount := diff.GetChange(paramPartitionsCount)
+		if newPartitionsCount.(int) < oldPartitionsCount.(int) {
+			return fmt.Errorf("error updating Kafka Topic %q: %q can't be decreased from %d to %d because Kafka doesn't support reducing the number of partitions of an existing topic. "+
+				"To reduce the number of partitions, delete the topic and create a new one", diff.Id(), paramPartitionsCount, oldPartitionsCount.(int), newPartitionsCount.(int))
+		}
+	}
+
+	return nil
+}
+
+// extractKafkaClusterId, extractRestEndpoint and extractClusterApiKeyAndApiSecret resolve Kafka cluster settings
+// in the following order: resource block, provider block (provider-level defaults), IMPORT_* environment variables.
 func extractKafkaClusterId(client *Client, d *schema.ResourceData, isImportOperation bool) (string, error) {
+	if clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId); clusterId != "" {
+		return clusterId, nil
+	}
 	if client.isKafkaClusterIdSet {
 		return client.kafkaClusterId, nil
 	}
@@ -151,14 +190,13 @@ func extractKafkaClusterId(client *Client, d *schema.ResourceData, isImportOpera
 			return "", fmt.Errorf("one of provider.kafka_id (defaults to KAFKA_ID environment variable) or IMPORT_KAFKA_ID environment variable must be set")
 		}
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	if clusterId != "" {
-		return clusterId, nil
-	}
 	return "", fmt.Errorf("one of provider.kafka_id (defaults to KAFKA_ID environment variable) or resource.kafka_cluster.id must be set")
 }
 
 func extractRestEndpoint(client *Client, d *schema.ResourceData, isImportOperation bool) (string, error) {
+	if restEndpoint := d.Get(paramRestEndpoint).(string); restEndpoint != "" {
+		return restEndpoint, nil
+	}
 	if client.isKafkaMetadataSet {
 		return client.kafkaRestEndpoint, nil
 	}
@@ -170,14 +208,13 @@ func extractRestEndpoint(client *Client, d *schema.ResourceData, isImportOperati
 			return "", fmt.Errorf("one of provider.kafka_rest_endpoint (defaults to KAFKA_REST_ENDPOINT environment variable) or IMPORT_KAFKA_REST_ENDPOINT environment variable must be set")
 		}
 	}
-	restEndpoint := d.Get(paramRestEndpoint).(string)
-	if restEndpoint != "" {
-		return restEndpoint, nil
-	}
 	return "", fmt.Errorf("one of provider.kafka_rest_endpoint (defaults to KAFKA_REST_ENDPOINT environment variable) or resource.rest_endpoint must be set")
 }
 
 func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, isImportOperation bool) (string, string, error) {
+	if clusterApiKey, clusterApiSecret := extractClusterApiKeyAndApiSecretFromCredentialsBlock(d); clusterApiKey != "" {
+		return clusterApiKey, clusterApiSecret, nil
+	}
 	if client.isKafkaMetadataSet {
 		return client.kafkaApiKey, client.kafkaApiSecret, nil
 	}
@@ -190,10 +227,6 @@ func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, is
 			return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret), (KAFKA_API_KEY, KAFKA_API_SECRET environment variables) or (IMPORT_KAFKA_API_KEY, IMPORT_KAFKA_API_SECRET environment variables) must be set")
 		}
 	}
-	clusterApiKey, clusterApiSecret := extractClusterApiKeyAndApiSecretFromCredentialsBlock(d)
-	if clusterApiKey != "" {
-		return clusterApiKey, clusterApiSecret, nil
-	}
 	return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret), (KAFKA_API_KEY, KAFKA_API_SECRET environment variables) or (resource.credentials.key, resource.credentials.secret) must be set")
 }
 
@@ -214,6 +247,15 @@ func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interfac
 	topicName := d.Get(paramTopicName).(string)
 	partitionsCountInt32 := int32(d.Get(paramPartitionsCount).(int))
 	configs := extractConfigs(d.Get(paramConfigs).(map[string]interface{}))
+	if replicaPlacement := d.Get(paramReplicaPlacement).(string); replicaPlacement != "" {
+		if err := validateReplicaPlacementClusterType(ctx, meta.(*Client), clusterId); err != nil {
+			return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
+		}
+		configs = append(configs, kafkarestv3.CreateTopicRequestDataConfigs{
+			Name:  placementConstraintsTopicConfig,
+			Value: *kafkarestv3.NewNullableString(ptr(replicaPlacement)),
+		})
+	}
 
 	createTopicRequest := kafkarestv3.CreateTopicRequestData{
 		TopicName:       topicName,
@@ -275,7 +317,7 @@ func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.Errorf("error deleting Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForKafkaTopicToBeDeleted(kafkaRestClient.apiContext(ctx), kafkaRestClient, topicName, meta.(*Client).isAcceptanceTestMode); err != nil {
+	if err := waitForKafkaTopicToBeDeleted(ctx, kafkaRestClient, topicName, meta.(*Client).isAcceptanceTestMode); err != nil {
 		return diag.Errorf("error waiting for Kafka Topic %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -400,24 +442,26 @@ func kafkaClusterIdSchema() *schema.Schema {
 func kafkaTopicImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tflog.Debug(ctx, fmt.Sprintf("Importing Kafka Topic %q", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 
+	clusterId, topicName, err := parseKafkaTopicImportId(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error importing Kafka Topic: %s", err)
+	}
+
 	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, true)
 	if err != nil {
-		return nil, fmt.Errorf("error importing Kafka Topic: %s", createDescriptiveError(err))
+		// Fall back to looking up the REST endpoint of the Kafka cluster using Cloud API credentials of the provider
+		tflog.Debug(ctx, fmt.Sprintf("Inferring REST endpoint of Kafka Cluster %q: %s", clusterId, createDescriptiveError(err)), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
+		inferredRestEndpoint, inferErr := inferKafkaRestEndpoint(ctx, meta.(*Client), clusterId)
+		if inferErr != nil {
+			return nil, fmt.Errorf("error importing Kafka Topic: %s, and inferring it failed: %s", createDescriptiveError(err), inferErr)
+		}
+		restEndpoint = inferredRestEndpoint
 	}
 	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, true)
 	if err != nil {
 		return nil, fmt.Errorf("error importing Kafka Topic: %s", createDescriptiveError(err))
 	}
 
-	clusterIDAndTopicName := d.Id()
-	parts := strings.Split(clusterIDAndTopicName, "/")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("error importing Kafka Topic: invalid format: expected '<Kafka cluster ID>/<topic name>'")
-	}
-
-	clusterId := parts[0]
-	topicName := parts[1]
-
 	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
 
 	// Mark resource as new to avoid d.Set("") when getting 404
@@ -429,6 +473,52 @@ func kafkaTopicImport(ctx context.Context, d *schema.ResourceData, meta interfac
 	return []*schema.ResourceData{d}, nil
 }
 
+func parseKafkaTopicImportId(clusterIdAndTopicName string) (string, string, error) {
+	parts := strings.Split(clusterIdAndTopicName, "/")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "lkc-") || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format: expected '<Kafka cluster ID>/<topic name>', for example, 'lkc-abc123/orders', got %q", clusterIdAndTopicName)
+	}
+	return parts[0], parts[1], nil
+}
+
+// inferKafkaRestEndpoint looks up the REST endpoint of the Kafka cluster with Cloud API Keys or OAuth tokens.
+func inferKafkaRestEndpoint(ctx context.Context, c *Client, clusterId string) (string, error) {
+	if !c.isCloudApiAccessible() {
+		return "", fmt.Errorf("neither Cloud API Key nor OAuth is set")
+	}
+	cluster, exists, err := findKafkaClusterInAllEnvironments(ctx, c, clusterId)
+	if err != nil {
+		return "", fmt.Errorf("error reading Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	if !exists {
+		return "", fmt.Errorf("Kafka Cluster %q could not be found", clusterId)
+	}
+	if restEndpoint := cluster.Spec.GetHttpEndpoint(); restEndpoint != "" {
+		return restEndpoint, nil
+	}
+	return "", fmt.Errorf("rest_endpoint is nil or empty for Kafka Cluster %q", clusterId)
+}
+
+// findKafkaClusterInAllEnvironments searches all environments for the Kafka cluster
+// since neither Kafka REST API nor Kafka Topic IDs reference the environment the Kafka cluster belongs to.
+func findKafkaClusterInAllEnvironments(ctx context.Context, c *Client, clusterId string) (cmk.CmkV2Cluster, bool, error) {
+	environments, err := loadEnvironments(ctx, c)
+	if err != nil {
+		return cmk.CmkV2Cluster{}, false, err
+	}
+	for _, environment := range environments {
+		cluster, resp, err := executeKafkaRead(ctx, c, environment.GetId(), clusterId)
+		if isNonKafkaRestApiResourceNotFound(resp) {
+			continue
+		}
+		if err != nil {
+			return cmk.CmkV2Cluster{}, false, err
+		}
+		return cluster, true, nil
+	}
+	return cmk.CmkV2Cluster{}, false, nil
+}
+
 func readTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *KafkaRestClient, topicName string) ([]*schema.ResourceData, error) {
 	kafkaTopic, resp, err := c.apiClient.TopicV3Api.GetKafkaTopic(c.apiContext(ctx), c.clusterId, topicName).Execute()
 	if err != nil {
@@ -456,10 +546,22 @@ func readTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *K
 		return nil, err
 	}
 
-	configs, err := loadTopicConfigs(ctx, d, c, topicName)
+	managedConfigs := d.Get(paramConfigs).(map[string]interface{})
+	_, isPlacementConstraintsTopicConfigManaged := managedConfigs[placementConstraintsTopicConfig]
+	if replicaPlacement := d.Get(paramReplicaPlacement).(string); replicaPlacement != "" && len(managedConfigs) > 0 {
+		managedConfigs[placementConstraintsTopicConfig] = replicaPlacement
+	}
+	configs, err := loadTopicConfigs(ctx, d, c, topicName, managedConfigs)
 	if err != nil {
 		return nil, err
 	}
+	// The replica placement policy is tracked in its own attribute unless it's explicitly set in 'config' block
+	if !isPlacementConstraintsTopicConfigManaged {
+		if err := d.Set(paramReplicaPlacement, configs[placementConstraintsTopicConfig]); err != nil {
+			return nil, err
+		}
+		delete(configs, placementConstraintsTopicConfig)
+	}
 	if err := d.Set(paramConfigs, configs); err != nil {
 		return nil, err
 	}
@@ -485,8 +587,8 @@ func readTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *K
 }
 
 func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramConfigs, paramPartitionsCount) {
-		return diag.Errorf("error updating Kafka Topic %q: only %q, %q and %q blocks can be updated for Kafka Topic", d.Id(), paramCredentials, paramConfigs, paramPartitionsCount)
+	if d.HasChangesExcept(paramCredentials, paramConfigs, paramPartitionsCount, paramReplicaPlacement) {
+		return diag.Errorf("error updating Kafka Topic %q: only %q, %q, %q and %q blocks can be updated for Kafka Topic", d.Id(), paramCredentials, paramConfigs, paramPartitionsCount, paramReplicaPlacement)
 	}
 	if d.HasChange(paramPartitionsCount) {
 		oldPartitionsCount, newPartitionsCount := d.GetChange(paramPartitionsCount)
@@ -537,6 +639,7 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		// TF Provider allows the following operations for editable topic settings under 'config' block:
 		// 1. Adding new key value pair, for example, "retention.ms" = "600000"
 		// 2. Update a value for existing key value pair, for example, "retention.ms" = "600000" -> "retention.ms" = "600001"
+		// 3. Removing existing key value pair, which resets the topic setting to its default value
 		// You might find the list of editable topic settings and their limits at
 		// https://docs.confluent.io/cloud/current/client-apps/topics/manage.html#ak-topic-configurations-for-all-ccloud-cluster-types
 
@@ -545,19 +648,26 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		// * 'new' topic settings -- all topic settings from TF configuration _after_ changes
 		oldTopicSettingsMap, newTopicSettingsMap := extractOldAndNewSettings(d)
 
-		// Verify that no topic settings were removed (reset to its default value) in TF configuration which is an unsupported operation at the moment
-		for oldTopicSettingName := range oldTopicSettingsMap {
-			if _, ok := newTopicSettingsMap[oldTopicSettingName]; !ok {
-				return diag.Errorf("error updating Kafka Topic %q: reset to topic setting's default value operation (in other words, removing topic settings from 'configs' block) "+
-					"is not supported at the moment. "+
-					"Instead, find its default value at %s and set its current value to the default value.", d.Id(), docsUrl)
-			}
-		}
-
-		// Store only topic settings that were updated in TF configuration.
+		// Store only topic settings that were updated or removed in TF configuration.
 		// Will be used for creating a request to Kafka REST API.
 		var topicSettingsUpdateBatch []kafkarestv3.AlterConfigBatchRequestDataData
 
+		// Operation #3: reset topic settings that were removed from TF configuration to their default values
+		for _, topicSettingName := range extractRemovedTopicSettings(oldTopicSettingsMap, newTopicSettingsMap) {
+			if topicSettingName == placementConstraintsTopicConfig && d.Get(paramReplicaPlacement).(string) != "" {
+				// The replica placement policy was moved to 'replica_placement' attribute and is updated below
+				continue
+			}
+			if !stringInSlice(topicSettingName, editableTopicSettings, false) {
+				return diag.Errorf("error updating Kafka Topic %q: %q topic setting is read-only and cannot be reset to its default value. "+
+					"Read %s for more details.", d.Id(), topicSettingName, docsUrl)
+			}
+			topicSettingsUpdateBatch = append(topicSettingsUpdateBatch, kafkarestv3.AlterConfigBatchRequestDataData{
+				Name:      topicSettingName,
+				Operation: *kafkarestv3.NewNullableString(ptr(alterConfigOperationDelete)),
+			})
+		}
+
 		// Verify that topics that were changed in TF configuration settings are indeed editable
 		for topicSettingName, newTopicSettingValue := range newTopicSettingsMap {
 			oldTopicSettingValue, ok := oldTopicSettingsMap[topicSettingName]
@@ -613,7 +723,7 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 		// Check that topic configs update was successfully executed
 		// In other words, remote topic setting values returned by Kafka REST API match topic setting values from updated TF configuration
-		actualTopicSettings, err := loadTopicConfigs(ctx, d, kafkaRestClient, topicName)
+		actualTopicSettings, err := loadTopicConfigs(ctx, d, kafkaRestClient, topicName, d.Get(paramConfigs).(map[string]interface{}))
 		if err != nil {
 			return diag.FromErr(createDescriptiveError(err))
 		}
@@ -621,7 +731,8 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		var updatedTopicSettings, outdatedTopicSettings []string
 		for _, v := range topicSettingsUpdateBatch {
 			if !v.Value.IsSet() {
-				// It will never happen because of the way we construct topicSettingsUpdateBatch
+				// Topic settings that were reset to their default values, which aren't known in advance
+				updatedTopicSettings = append(updatedTopicSettings, v.Name)
 				continue
 			}
 			topicSettingName := v.Name
@@ -643,6 +754,63 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Finished updating Kafka Topic %q: topic settings update has been completed for %s", d.Id(), updatedTopicSettingsJson), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 	}
+	if d.HasChange(paramReplicaPlacement) {
+		restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
+		}
+		clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
+		}
+		clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
+		}
+		if err := validateReplicaPlacementClusterType(ctx, meta.(*Client), clusterId); err != nil {
+			return diag.Errorf("error updating Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet, meta.(*Client).isKafkaClusterIdSet)
+		topicName := d.Get(paramTopicName).(string)
+		updateTopicRequest := kafkarestv3.AlterConfigBatchRequestData{
+			Data: []kafkarestv3.AlterConfigBatchRequestDataData{
+				{
+					Name:  placementConstraintsTopicConfig,
+					Value: *kafkarestv3.NewNullableString(ptr(d.Get(paramReplicaPlacement).(string))),
+				},
+			},
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Updating Kafka Topic %q: replica placement policy", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
+
+		if _, err := executeKafkaTopicUpdate(ctx, kafkaRestClient, topicName, updateTopicRequest); err != nil {
+			return diag.Errorf("error updating Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode)
+		tflog.Debug(ctx, fmt.Sprintf("Finished updating Kafka Topic %q: replica placement policy update has been completed", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
+	}
+	return nil
+}
+
+// validateReplicaPlacementClusterType returns an error if the Kafka cluster isn't a Dedicated one,
+// since replica placement policies aren't supported on multi-tenant Kafka clusters.
+// The Kafka REST API doesn't expose the cluster type, so the Kafka cluster is looked up with Cloud API Keys or OAuth tokens;
+// the check is skipped when neither is set, in that case the Kafka REST API rejects the policy instead.
+func validateReplicaPlacementClusterType(ctx context.Context, c *Client, clusterId string) error {
+	if !c.isCloudApiAccessible() {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of the type of Kafka Cluster %q: neither Cloud API Key nor OAuth is set", clusterId))
+		return nil
+	}
+	cluster, exists, err := findKafkaClusterInAllEnvironments(ctx, c, clusterId)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if config := cluster.Spec.GetConfig(); config.CmkV2Dedicated == nil {
+			return fmt.Errorf("%q attribute is supported for %s Kafka clusters only, Kafka Cluster %q is not %s", paramReplicaPlacement, kafkaClusterTypeDedicated, clusterId, kafkaClusterTypeDedicated)
+		}
+		return nil
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Skipping validation of the type of Kafka Cluster %q: Kafka Cluster could not be found", clusterId))
 	return nil
 }
 
@@ -661,7 +829,11 @@ func setKafkaCredentials(kafkaApiKey, kafkaApiSecret string, d *schema.ResourceD
 	}})
 }
 
-func loadTopicConfigs(ctx context.Context, d *schema.ResourceData, c *KafkaRestClient, topicName string) (map[string]string, error) {
+// loadTopicConfigs returns the topic settings to track in TF state.
+// When managedConfigs is empty (e.g., for imports and data sources), all dynamic topic settings are returned.
+// Otherwise, only the topic settings from managedConfigs are returned, even if they're set to their default values,
+// so that topic settings which were set on the server side don't cause perpetual diffs.
+func loadTopicConfigs(ctx context.Context, d *schema.ResourceData, c *KafkaRestClient, topicName string, managedConfigs map[string]interface{}) (map[string]string, error) {
 	topicConfigList, _, err := c.apiClient.ConfigsV3Api.ListKafkaTopicConfigs(c.apiContext(ctx), c.clusterId, topicName).Execute()
 	if err != nil {
 		return nil, fmt.Errorf("error reading Kafka Topic %q: could not load configs %s", topicName, createDescriptiveError(err))
@@ -669,8 +841,15 @@ func loadTopicConfigs(ctx context.Context, d *schema.ResourceData, c *KafkaRestC
 
 	config := make(map[string]string)
 	for _, remoteConfig := range topicConfigList.Data {
-		// Extract configs that were set via terraform vs set by default
-		if remoteConfig.Source == dynamicTopicConfig && remoteConfig.Value.IsSet() {
+		if !remoteConfig.Value.IsSet() || remoteConfig.Value.Get() == nil {
+			continue
+		}
+		if len(managedConfigs) > 0 {
+			if _, ok := managedConfigs[remoteConfig.Name]; ok {
+				config[remoteConfig.Name] = *remoteConfig.Value.Get()
+			}
+		} else if remoteConfig.Source == dynamicTopicConfig {
+			// Extract configs that were set via terraform vs set by default
 			config[remoteConfig.Name] = *remoteConfig.Value.Get()
 		}
 	}
@@ -688,6 +867,18 @@ func extractOldAndNewSettings(d *schema.ResourceData) (map[string]string, map[st
 	return convertToStringStringMap(oldConfigs.(map[string]interface{})), convertToStringStringMap(newConfigs.(map[string]interface{}))
 }
 
+// extractRemovedTopicSettings returns the names of topic settings that were removed from 'config' block, sorted for a deterministic request
+func extractRemovedTopicSettings(oldTopicSettingsMap, newTopicSettingsMap map[string]string) []string {
+	var removedTopicSettings []string
+	for topicSettingName := range oldTopicSettingsMap {
+		if _, ok := newTopicSettingsMap[topicSettingName]; !ok {
+			removedTopicSettings = append(removedTopicSettings, topicSettingName)
+		}
+	}
+	sort.Strings(removedTopicSettings)
+	return removedTopicSettings
+}
+
 // TODO: we might want to load all the resources instead
 func kafkaTopicImporter() *Importer {
 	return &Importer{
@@ -0,0 +1,207 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTopicConfigReset(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/create_kafka_topic.json")
+	createTopicStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(createTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTopicStub)
+
+	readCreatedTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic_config.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// Removing "retention.ms" from 'config' block resets it to its default value instead of updating it
+	resetTopicConfigStub := wiremock.Post(wiremock.URLPathEqualTo(updateKafkaTopicConfigPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WithBodyPattern(wiremock.EqualToJson(fmt.Sprintf(`{"data":[{"name":%q,"operation":"DELETE"}]}`, secondConfigName))).
+		WillSetStateTo(scenarioStateTopicHasBeenUpdated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(resetTopicConfigStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdated).
+		WillReturn(
+			string(readCreatedTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// "retention.ms" is back to its default value on the server
+	readResetTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_reset_kafka_topic_config.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdated).
+		WillReturn(
+			string(readResetTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdated).
+		WillSetStateTo(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTopicStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckTopicDestroy(s, mockServerUrl)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTopicConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(fullTopicResourceLabel),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.%", "2"),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.max.message.bytes", firstConfigValue),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.retention.ms", secondConfigValue),
+				),
+			},
+			{
+				Config: testAccCheckTopicWithSingleConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(fullTopicResourceLabel),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.%", "1"),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.max.message.bytes", firstConfigValue),
+					resource.TestCheckNoResourceAttr(fullTopicResourceLabel, "config.retention.ms"),
+				),
+			},
+			{
+				Config:   testAccCheckTopicWithSingleConfig(confluentCloudBaseUrl, mockServerUrl),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createTopicStub, fmt.Sprintf("POST %s", createKafkaTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, resetTopicConfigStub, fmt.Sprintf("POST %s", updateKafkaTopicConfigPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTopicStub, fmt.Sprintf("DELETE %s", kafkaTopicPath), expectedCountOne)
+}
+
+func TestExtractRemovedTopicSettings(t *testing.T) {
+	oldTopicSettings := map[string]string{"retention.ms": "6789", "max.message.bytes": "12345", "segment.bytes": "104857600"}
+	newTopicSettings := map[string]string{"max.message.bytes": "123456"}
+
+	expected := []string{"retention.ms", "segment.bytes"}
+	if result := extractRemovedTopicSettings(oldTopicSettings, newTopicSettings); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Unexpected result: expected %v, got %v", expected, result)
+	}
+	if result := extractRemovedTopicSettings(newTopicSettings, oldTopicSettings); result != nil {
+		t.Fatalf("Unexpected result: expected no removed topic settings, got %v", result)
+	}
+}
+
+func testAccCheckTopicWithSingleConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_kafka_topic" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+	
+	  topic_name = "%s"
+	  partitions_count = "%d"
+	  rest_endpoint = "%s"
+	
+	  config = {
+		"%s" = "%s"
+	  }
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, topicResourceLabel, clusterId, topicName, partitionCount, mockServerUrl, firstConfigName, firstConfigValue, kafkaApiKey, kafkaApiSecret)
+}
@@ -0,0 +1,276 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	topicImportScenarioName = "confluent_kafka_topic Import With Inferred REST Endpoint"
+)
+
+func TestAccTopicImportWithInferredRestEndpoint(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	// The REST endpoint of the Kafka cluster is looked up with Cloud API Keys on import:
+	// the Kafka cluster can't be found in env-ab123, so it's looked up in env-1jrymj next
+	readEnvironmentsResponse, _ := ioutil.ReadFile("../testdata/environment/read_envs.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(topicImportScenarioName).
+		WillReturn(
+			string(readEnvironmentsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+	readDeletedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_deleted_kafka.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("/cmk/v2/clusters/%s", clusterId))).
+		WithQueryParam("environment", wiremock.EqualTo("env-ab123")).
+		InScenario(topicImportScenarioName).
+		WillReturn(
+			string(readDeletedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+	readClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_created_kafka.json")
+	readClusterResponseWithMockServerUrl := strings.NewReplacer(
+		"lkc-19ynpv", clusterId,
+		"https://pkc-0wg55.us-central1.gcp.confluent.cloud:443", mockServerUrl,
+	).Replace(string(readClusterResponse))
+	readClusterStub := wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("/cmk/v2/clusters/%s", clusterId))).
+		WithQueryParam("environment", wiremock.EqualTo("env-1jrymj")).
+		InScenario(topicImportScenarioName).
+		WillReturn(
+			readClusterResponseWithMockServerUrl,
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readClusterStub)
+
+	createTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/create_kafka_topic.json")
+	createTopicStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaTopicPath)).
+		InScenario(topicImportScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(createTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTopicStub)
+
+	readCreatedTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicImportScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic_config.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(topicImportScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicImportScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillSetStateTo(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTopicStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicImportScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	// IMPORT_KAFKA_REST_ENDPOINT is deliberately not set
+	_ = os.Setenv("IMPORT_KAFKA_API_KEY", kafkaApiKey)
+	_ = os.Setenv("IMPORT_KAFKA_API_SECRET", kafkaApiSecret)
+	defer func() {
+		_ = os.Unsetenv("IMPORT_KAFKA_API_KEY")
+		_ = os.Unsetenv("IMPORT_KAFKA_API_SECRET")
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckTopicDestroy(s, mockServerUrl)
+		},
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTopicConfig(mockServerUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(fullTopicResourceLabel),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "rest_endpoint", mockServerUrl),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullTopicResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, readClusterStub, fmt.Sprintf("GET /cmk/v2/clusters/%s", clusterId), expectedCountOne)
+}
+
+func TestParseKafkaTopicImportId(t *testing.T) {
+	tests := []struct {
+		importId          string
+		expectedClusterId string
+		expectedTopicName string
+		expectedError     bool
+	}{
+		{"lkc-abc123/orders", "lkc-abc123", "orders", false},
+		{"lkc-abc123/orders.v1_test-topic", "lkc-abc123", "orders.v1_test-topic", false},
+		{"lkc-abc123", "", "", true},
+		{"lkc-abc123/", "", "", true},
+		{"/orders", "", "", true},
+		{"env-abc123/lkc-abc123/orders", "", "", true},
+		{"https://pkc-00000.us-central1.gcp.confluent.cloud:443/lkc-abc123/orders", "", "", true},
+		{"orders/lkc-abc123", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importId, func(t *testing.T) {
+			clusterId, topicName, err := parseKafkaTopicImportId(tt.importId)
+			if tt.expectedError {
+				if err == nil || !strings.Contains(err.Error(), "expected '<Kafka cluster ID>/<topic name>'") {
+					t.Fatalf("expected an invalid format error for %q, got: %v", tt.importId, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error for %q, got: %s", tt.importId, err)
+			}
+			if clusterId != tt.expectedClusterId || topicName != tt.expectedTopicName {
+				t.Fatalf("expected (%q, %q), got (%q, %q)", tt.expectedClusterId, tt.expectedTopicName, clusterId, topicName)
+			}
+		})
+	}
+}
+
+func TestInferKafkaRestEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/org/v2/environments":
+			_, _ = fmt.Fprint(w, `{"data": [{"id": "env-abc123"}, {"id": "env-def456"}], "metadata": {}}`)
+		case r.URL.Path == "/cmk/v2/clusters/lkc-abc123" && r.URL.Query().Get("environment") == "env-def456":
+			_, _ = fmt.Fprint(w, `{"id": "lkc-abc123", "spec": {"http_endpoint": "https://pkc-00000.us-central1.gcp.confluent.cloud:443"}}`)
+		case r.URL.Path == "/cmk/v2/clusters/lkc-noendpoint" && r.URL.Query().Get("environment") == "env-abc123":
+			_, _ = fmt.Fprint(w, `{"id": "lkc-noendpoint", "spec": {}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"errors": [{"status": "404", "detail": "Not Found"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	orgCfg := org.NewConfiguration()
+	orgCfg.Servers[0].URL = server.URL
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = server.URL
+	newClient := func(cloudApiKey string, isOAuthEnabled bool) *Client {
+		return &Client{
+			orgClient:      org.NewAPIClient(orgCfg),
+			cmkClient:      cmk.NewAPIClient(cmkCfg),
+			cloudApiKey:    cloudApiKey,
+			isOAuthEnabled: isOAuthEnabled,
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		clusterId            string
+		cloudApiKey          string
+		isOAuthEnabled       bool
+		expectedRestEndpoint string
+		expectedError        string
+	}{
+		{"cluster in the second environment", "lkc-abc123", "key", false, "https://pkc-00000.us-central1.gcp.confluent.cloud:443", ""},
+		{"unknown cluster", "lkc-unknown", "key", false, "", "could not be found"},
+		{"cluster without REST endpoint", "lkc-noendpoint", "key", false, "", "rest_endpoint is nil or empty"},
+		{"OAuth", "lkc-abc123", "", true, "https://pkc-00000.us-central1.gcp.confluent.cloud:443", ""},
+		{"no Cloud API credentials", "lkc-abc123", "", false, "", "neither Cloud API Key nor OAuth is set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restEndpoint, err := inferKafkaRestEndpoint(context.Background(), newClient(tt.cloudApiKey, tt.isOAuthEnabled), tt.clusterId)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected an error containing %q, got: %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if restEndpoint != tt.expectedRestEndpoint {
+				t.Fatalf("expected REST endpoint %q, got %q", tt.expectedRestEndpoint, restEndpoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	topicReplicaPlacement = `{"replicas":[{"constraints":{"rack":"use1-az1"},"count":3}],"version":1}`
+)
+
+func TestAccTopicWithReplicaPlacement(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	// The type of the Kafka cluster is looked up with Cloud API Keys or OAuth tokens before the replica placement policy is set
+	readEnvironmentsResponse, _ := ioutil.ReadFile("../testdata/environment/read_envs.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(topicScenarioName).
+		WillReturn(
+			string(readEnvironmentsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+	readDedicatedClusterResponse, _ := ioutil.ReadFile("../testdata/kafka/read_created_dedicated_kafka.json")
+	readDedicatedClusterStub := wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("/cmk/v2/clusters/%s", clusterId))).
+		InScenario(topicScenarioName).
+		WillReturn(
+			string(readDedicatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readDedicatedClusterStub)
+
+	createTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/create_kafka_topic.json")
+	createTopicStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"name":%q`, placementConstraintsTopicConfig))).
+		WillSetStateTo(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(createTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTopicStub)
+
+	readCreatedTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// The server returns the replica placement policy formatted differently than the TF configuration
+	readTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_kafka_topic_config_with_replica_placement.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillSetStateTo(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTopicStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckTopicDestroy(s, mockServerUrl)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTopicWithReplicaPlacementConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(fullTopicResourceLabel),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.%", "1"),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.max.message.bytes", firstConfigValue),
+					resource.TestCheckResourceAttrSet(fullTopicResourceLabel, paramReplicaPlacement),
+				),
+			},
+			{
+				Config:   testAccCheckTopicWithReplicaPlacementConfig(mockServerUrl),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createTopicStub, fmt.Sprintf("POST %s", createKafkaTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, readDedicatedClusterStub, fmt.Sprintf("GET /cmk/v2/clusters/%s", clusterId), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTopicStub, fmt.Sprintf("DELETE %s", kafkaTopicPath), expectedCountOne)
+}
+
+func TestResourceKafkaTopicCustomizeDiffReplicaPlacement(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramTopicName: topicName,
+		paramKafkaCluster: []interface{}{map[string]interface{}{
+			paramId: clusterId,
+		}},
+		paramConfigs: map[string]interface{}{
+			placementConstraintsTopicConfig: topicReplicaPlacement,
+		},
+		paramReplicaPlacement: topicReplicaPlacement,
+	})
+	_, err := kafkaTopicResource().Diff(context.Background(), nil, config, nil)
+	if err == nil || !strings.Contains(err.Error(), "can't be set in") {
+		t.Fatalf("expected an error when %q is set in both %q block and %q attribute, got: %v", placementConstraintsTopicConfig, paramConfigs, paramReplicaPlacement, err)
+	}
+}
+
+func TestValidateReplicaPlacementClusterType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/org/v2/environments":
+			_, _ = fmt.Fprint(w, `{"data": [{"id": "env-abc123"}, {"id": "env-def456"}], "metadata": {}}`)
+		case r.URL.Path == "/cmk/v2/clusters/lkc-dedicated" && r.URL.Query().Get("environment") == "env-def456":
+			_, _ = fmt.Fprint(w, `{"id": "lkc-dedicated", "spec": {"config": {"kind": "Dedicated", "cku": 2}}}`)
+		case r.URL.Path == "/cmk/v2/clusters/lkc-standard" && r.URL.Query().Get("environment") == "env-abc123":
+			_, _ = fmt.Fprint(w, `{"id": "lkc-standard", "spec": {"config": {"kind": "Standard"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"errors": [{"status": "404", "detail": "Not Found"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	orgCfg := org.NewConfiguration()
+	orgCfg.Servers[0].URL = server.URL
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = server.URL
+	newClient := func(cloudApiKey string, isOAuthEnabled bool) *Client {
+		return &Client{
+			orgClient:      org.NewAPIClient(orgCfg),
+			cmkClient:      cmk.NewAPIClient(cmkCfg),
+			cloudApiKey:    cloudApiKey,
+			isOAuthEnabled: isOAuthEnabled,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		clusterId      string
+		cloudApiKey    string
+		isOAuthEnabled bool
+		expectedError  bool
+	}{
+		{"dedicated cluster", "lkc-dedicated", "key", false, false},
+		{"standard cluster", "lkc-standard", "key", false, true},
+		{"unknown cluster", "lkc-unknown", "key", false, false},
+		{"standard cluster with OAuth", "lkc-standard", "", true, true},
+		{"no Cloud API credentials", "lkc-standard", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateReplicaPlacementClusterType(context.Background(), newClient(tt.cloudApiKey, tt.isOAuthEnabled), tt.clusterId)
+			if tt.expectedError && (err == nil || !strings.Contains(err.Error(), "is supported for Dedicated Kafka clusters only")) {
+				t.Fatalf("expected an error for Kafka Cluster %q, got: %v", tt.clusterId, err)
+			}
+			if !tt.expectedError && err != nil {
+				t.Fatalf("expected no error for Kafka Cluster %q, got: %s", tt.clusterId, err)
+			}
+		})
+	}
+}
+
+func testAccCheckTopicWithReplicaPlacementConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_kafka_topic" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+
+	  topic_name = "%s"
+	  partitions_count = "%d"
+	  rest_endpoint = "%s"
+
+	  config = {
+		"%s" = "%s"
+	  }
+
+	  replica_placement = jsonencode({
+		version = 1
+		replicas = [{
+		  count = 3
+		  constraints = {
+			rack = "use1-az1"
+		  }
+		}]
+	  })
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, mockServerUrl, topicResourceLabel, clusterId, topicName, partitionCount, mockServerUrl, firstConfigName, firstConfigValue, kafkaApiKey, kafkaApiSecret)
+}
@@ -21,7 +21,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,30 +31,28 @@ import (
 )
 
 const (
-	scenarioStateTopicHasBeenCreated       = "A new topic has been just created"
-	scenarioStateTopicHasBeenUpdated       = "A new topic has been just updated"
-	scenarioStateTopicHasBeenDeleted       = "The topic has been deleted"
-	scenarioStateTopicHasBeenUpdateCreated = "The topic has been update created"
-	scenarioStateTopicHasBeenDeletedUpdate = "The topic has been update deleted"
-	topicScenarioName                      = "confluent_kafka_topic Resource Lifecycle"
-	clusterId                              = "lkc-190073"
-	partitionCount                         = 4
-	partitionCountUpdated                  = 6
-	partitionCountUpdated2                 = 2
-	firstConfigName                        = "max.message.bytes"
-	firstConfigValue                       = "12345"
-	secondConfigName                       = "retention.ms"
-	secondConfigValue                      = "6789"
-	secondConfigUpdatedValue               = "67890"
-	thirdConfigName                        = "segment.bytes"
-	thirdConfigAddedValue                  = "104857600"
-	fourthConfigName                       = "max.compaction.lag.ms"
-	fourthConfigAddedValue                 = "604800000"
-	topicName                              = "test_topic_name"
-	topicResourceLabel                     = "test_topic_resource_label"
-	kafkaApiKey                            = "test_key"
-	kafkaApiSecret                         = "test_secret"
-	numberOfResourceAttributes             = "7"
+	scenarioStateTopicHasBeenCreated = "A new topic has been just created"
+	scenarioStateTopicHasBeenUpdated = "A new topic has been just updated"
+	scenarioStateTopicHasBeenDeleted = "The topic has been deleted"
+	topicScenarioName                = "confluent_kafka_topic Resource Lifecycle"
+	clusterId                        = "lkc-190073"
+	partitionCount                   = 4
+	partitionCountUpdated            = 6
+	partitionCountUpdated2           = 2
+	firstConfigName                  = "max.message.bytes"
+	firstConfigValue                 = "12345"
+	secondConfigName                 = "retention.ms"
+	secondConfigValue                = "6789"
+	secondConfigUpdatedValue         = "67890"
+	thirdConfigName                  = "segment.bytes"
+	thirdConfigAddedValue            = "104857600"
+	fourthConfigName                 = "max.compaction.lag.ms"
+	fourthConfigAddedValue           = "604800000"
+	topicName                        = "test_topic_name"
+	topicResourceLabel               = "test_topic_resource_label"
+	kafkaApiKey                      = "test_key"
+	kafkaApiSecret                   = "test_secret"
+	numberOfResourceAttributes       = "8"
 )
 
 var fullTopicResourceLabel = fmt.Sprintf("confluent_kafka_topic.%s", topicResourceLabel)
@@ -328,69 +328,9 @@ func TestAccTopicPartition(t *testing.T) {
 			http.StatusOK,
 		))
 
-	deleteTopicStubUpdate := wiremock.Delete(wiremock.URLPathEqualTo(kafkaTopicPath)).
-		InScenario(topicScenarioName).
-		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdated).
-		WillSetStateTo(scenarioStateTopicHasBeenDeletedUpdate).
-		WillReturn(
-			"",
-			contentTypeJSONHeader,
-			http.StatusNoContent,
-		)
-	_ = wiremockClient.StubFor(deleteTopicStubUpdate)
-
-	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
-		InScenario(topicScenarioName).
-		WhenScenarioStateIs(scenarioStateTopicHasBeenDeletedUpdate).
-		WillReturn(
-			"",
-			contentTypeJSONHeader,
-			http.StatusNotFound,
-		))
-
-	createTopicUpdateResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/create_kafka_topic.json")
-	createTopicUpdateStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaTopicPath)).
-		InScenario(topicScenarioName).
-		WhenScenarioStateIs(scenarioStateTopicHasBeenDeletedUpdate).
-		WillSetStateTo(scenarioStateTopicHasBeenUpdateCreated).
-		WillReturn(
-			string(createTopicUpdateResponse),
-			contentTypeJSONHeader,
-			http.StatusCreated,
-		)
-	_ = wiremockClient.StubFor(createTopicUpdateStub)
-
-	readCreatedTopicUpdateResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_create_updated_kafka_topic.json")
-	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
-		InScenario(topicScenarioName).
-		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdateCreated).
-		WillReturn(
-			string(readCreatedTopicUpdateResponse),
-			contentTypeJSONHeader,
-			http.StatusOK,
-		))
-
-	readCreatedUpdatedTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic_config.json")
-	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
-		InScenario(topicScenarioName).
-		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
-		WillReturn(
-			string(readCreatedUpdatedTopicConfigResponse),
-			contentTypeJSONHeader,
-			http.StatusOK,
-		))
-	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
-		InScenario(topicScenarioName).
-		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdateCreated).
-		WillReturn(
-			string(readCreatedUpdatedTopicConfigResponse),
-			contentTypeJSONHeader,
-			http.StatusOK,
-		))
-
 	deleteTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(kafkaTopicPath)).
 		InScenario(topicScenarioName).
-		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdateCreated).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenUpdated).
 		WillSetStateTo(scenarioStateTopicHasBeenDeleted).
 		WillReturn(
 			"",
@@ -468,36 +408,163 @@ func TestAccTopicPartition(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccCheckTopicPartition(confluentCloudBaseUrl, mockTopicTestServerUrl, partitionCountUpdated2),
+				// Kafka doesn't support reducing the number of partitions
+				Config:      testAccCheckTopicPartition(confluentCloudBaseUrl, mockTopicTestServerUrl, partitionCountUpdated2),
+				ExpectError: regexp.MustCompile("\"partitions_count\" can't be decreased from 6 to 2"),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullTopicResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createTopicStub, fmt.Sprintf("POST %s", createKafkaTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateTopicStub, fmt.Sprintf("PATCH %s", kafkaTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTopicStub, fmt.Sprintf("DELETE %s", kafkaTopicPath), expectedCountOne)
+}
+
+func TestAccTopicWithServerDefaultedConfigs(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockTopicTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockTopicTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/create_kafka_topic.json")
+	createTopicStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(createTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTopicStub)
+
+	readCreatedTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// The server returns 20 topic settings, 8 of which are dynamic, whereas the TF configuration contains 2 topic settings only
+	readTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_kafka_topic_config_with_server_defaults.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillSetStateTo(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTopicStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(topicScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckTopicDestroy(s, mockTopicTestServerUrl)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTopicWithDefaultValueConfig(confluentCloudBaseUrl, mockTopicTestServerUrl),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTopicExists(fullTopicResourceLabel),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "kafka_cluster.#", "1"),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "%", numberOfResourceAttributes),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "topic_name", topicName),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "partitions_count", strconv.Itoa(partitionCountUpdated2)),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "rest_endpoint", mockTopicTestServerUrl),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.%", "2"),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.max.message.bytes", "12345"),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.retention.ms", "6789"),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "credentials.#", "1"),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "credentials.0.%", "2"),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "credentials.0.key", kafkaApiKey),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "credentials.0.secret", kafkaApiSecret),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.max.message.bytes", firstConfigValue),
+					// A topic setting that is explicitly set to its default value is still tracked
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "config.cleanup.policy", "delete"),
 				),
 			},
 			{
-				// https://www.terraform.io/docs/extend/resources/import.html
-				ResourceName:      fullTopicResourceLabel,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:   testAccCheckTopicWithDefaultValueConfig(confluentCloudBaseUrl, mockTopicTestServerUrl),
+				PlanOnly: true,
 			},
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createTopicStub, fmt.Sprintf("POST %s", createKafkaTopicPath), expectedCountTwo)
-	checkStubCount(t, wiremockClient, deleteTopicStub, fmt.Sprintf("DELETE %s", kafkaTopicPath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, createTopicStub, fmt.Sprintf("POST %s", createKafkaTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTopicStub, fmt.Sprintf("DELETE %s", kafkaTopicPath), expectedCountOne)
+}
+
+func TestResourceKafkaTopicCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: fmt.Sprintf("%s/%s", clusterId, topicName),
+		Attributes: map[string]string{
+			paramId:                                fmt.Sprintf("%s/%s", clusterId, topicName),
+			paramTopicName:                         topicName,
+			paramPartitionsCount:                   strconv.Itoa(partitionCountUpdated),
+			fmt.Sprintf("%s.#", paramKafkaCluster): "1",
+			fmt.Sprintf("%s.0.%s", paramKafkaCluster, paramId): clusterId,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		partitionsCount int
+		expectedError   bool
+	}{
+		{"increase", partitionCountUpdated + 2, false},
+		{"no change", partitionCountUpdated, false},
+		{"decrease", partitionCountUpdated2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				paramTopicName:       topicName,
+				paramPartitionsCount: tt.partitionsCount,
+				paramKafkaCluster: []interface{}{map[string]interface{}{
+					paramId: clusterId,
+				}},
+			})
+			_, err := kafkaTopicResource().Diff(context.Background(), state, config, nil)
+			if tt.expectedError && (err == nil || !strings.Contains(err.Error(), "can't be decreased")) {
+				t.Fatalf("expected an error when changing %q from %d to %d, got: %v", paramPartitionsCount, partitionCountUpdated, tt.partitionsCount, err)
+			}
+			if !tt.expectedError && err != nil {
+				t.Fatalf("expected no error when changing %q from %d to %d, got: %s", paramPartitionsCount, partitionCountUpdated, tt.partitionsCount, err)
+			}
+		})
+	}
 }
 
 func testAccCheckTopicDestroy(s *terraform.State, url string) error {
@@ -578,6 +645,33 @@ func testAccCheckTopicUpdatedConfig(confluentCloudBaseUrl, mockServerUrl string)
 	`, confluentCloudBaseUrl, topicResourceLabel, clusterId, topicName, partitionCount, mockServerUrl, firstConfigName, firstConfigValue, secondConfigName, secondConfigUpdatedValue, thirdConfigName, thirdConfigAddedValue, fourthConfigName, fourthConfigAddedValue, kafkaApiKey, kafkaApiSecret)
 }
 
+func testAccCheckTopicWithDefaultValueConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_kafka_topic" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+
+	  topic_name = "%s"
+	  partitions_count = "%d"
+	  rest_endpoint = "%s"
+
+	  config = {
+		"%s" = "%s"
+		"cleanup.policy" = "delete"
+	  }
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, topicResourceLabel, clusterId, topicName, partitionCount, mockServerUrl, firstConfigName, firstConfigValue, kafkaApiKey, kafkaApiSecret)
+}
+
 func testAccCheckTopicPartition(confluentCloudBaseUrl, mockServerUrl string, partitionCount int) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
@@ -7,9 +7,11 @@ import (
 	ksql "github.com/confluentinc/ccloud-sdk-go-v2/ksql/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -21,12 +23,14 @@ const (
 	paramStorage                  = "storage"
 	paramUseDetailedProcessingLog = "use_detailed_processing_log"
 	ksqlCreateTimeout             = 12 * time.Hour
+	ksqlUpdateTimeout             = 12 * time.Hour
 )
 
 func ksqlResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: ksqlCreate,
 		ReadContext:   ksqlRead,
+		UpdateContext: ksqlUpdate,
 		DeleteContext: ksqlDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: ksqlImport,
@@ -50,7 +54,6 @@ func ksqlResource() *schema.Resource {
 				Type:         schema.TypeInt,
 				Description:  "The number of Confluent Streaming Units (CSUs) for the ksqlDB cluster.",
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 			paramApiVersion: {
@@ -87,7 +90,9 @@ func ksqlResource() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(ksqlCreateTimeout),
+			Update: schema.DefaultTimeout(ksqlUpdateTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -99,7 +104,10 @@ func ksqlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	csu := d.Get(paramCsu).(int)
 	kafkaClusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
 	credentialIdentityId := extractStringValueFromBlock(d, paramCredentialIdentity, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating ksqlDB Cluster: %s", createDescriptiveError(err))
+	}
 
 	spec := ksql.NewKsqldbcmV2ClusterSpec()
 	spec.SetDisplayName(displayName)
@@ -123,7 +131,7 @@ func ksqlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 	d.SetId(createdKsqlCluster.GetId())
 
-	if err := waitForKsqlClusterToProvision(c.ksqlApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForKsqlClusterToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for ksqlDB Cluster %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -149,6 +157,27 @@ func ksqlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	return nil
 }
 
+func ksqlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramCsu) {
+		return diag.Errorf("error updating ksqlDB Cluster %q: only %q attribute can be updated for ksqlDB Cluster", d.Id(), paramCsu)
+	}
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	updatedCsu := d.Get(paramCsu).(int)
+	tflog.Debug(ctx, fmt.Sprintf("Updating ksqlDB Cluster %q: setting %q to %d", d.Id(), paramCsu, updatedCsu), map[string]interface{}{ksqlClusterLoggingKey: d.Id()})
+
+	if _, err := executeKsqlUpdate(c.ksqlApiContext(ctx), c, environmentId, d.Id(), int32(updatedCsu)); err != nil {
+		return diag.Errorf("error updating ksqlDB Cluster %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	if err := waitForKsqlClusterToBeResized(ctx, c, environmentId, d.Id(), int32(updatedCsu), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for ksqlDB Cluster %q to be updated: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating ksqlDB Cluster %q", d.Id()), map[string]interface{}{ksqlClusterLoggingKey: d.Id()})
+	return ksqlRead(ctx, d, meta)
+}
+
 func ksqlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting ksqlDB Cluster %q", d.Id()), map[string]interface{}{ksqlClusterLoggingKey: d.Id()})
 	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
@@ -228,6 +257,32 @@ func executeKsqlRead(ctx context.Context, c *Client, environmentId, clusterId st
 	return req.Execute()
 }
 
+// executeKsqlUpdate sends a PATCH request directly since ccloud-sdk-go-v2/ksql doesn't support updating ksqlDB Clusters yet
+func executeKsqlUpdate(ctx context.Context, c *Client, environmentId, clusterId string, csu int32) (*http.Response, error) {
+	cfg := c.ksqlClient.GetConfig()
+	serverUrl, err := cfg.ServerURLWithContext(ctx, "ClustersKsqldbcmV2ApiService.GetKsqldbcmV2Cluster")
+	if err != nil {
+		return nil, err
+	}
+	auth, _ := ctx.Value(ksql.ContextBasicAuth).(ksql.BasicAuth)
+	updateKsqlClusterRequest := map[string]interface{}{
+		"spec": map[string]interface{}{
+			paramCsu: csu,
+			paramEnvironment: map[string]string{
+				paramId: environmentId,
+			},
+		},
+	}
+	return executeApiRequest(ctx, apiRequestConfig{
+		serverUrl:     serverUrl,
+		userAgent:     cfg.UserAgent,
+		defaultHeader: cfg.DefaultHeader,
+		httpClient:    cfg.HTTPClient,
+		userName:      auth.UserName,
+		password:      auth.Password,
+	}, http.MethodPatch, fmt.Sprintf("/ksqldbcm/v2/clusters/%s", url.PathEscape(clusterId)), updateKsqlClusterRequest, nil)
+}
+
 func executeKsqlDelete(ctx context.Context, c *Client, environmentId, clusterId string) error {
 	req := c.ksqlClient.ClustersKsqldbcmV2Api.DeleteKsqldbcmV2Cluster(c.ksqlApiContext(ctx), clusterId).Environment(environmentId)
 	_, err := req.Execute()
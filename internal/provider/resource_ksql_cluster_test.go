@@ -17,11 +17,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	ksql "github.com/confluentinc/ccloud-sdk-go-v2/ksql/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 )
@@ -30,6 +32,9 @@ const (
 	scenarioStateKsqlHasBeenCreated   = "A new ksqlDB cluster has been just created"
 	scenarioStateKsqlHasBeenUpdated   = "The new ksqlDB cluster's kind has been just updated"
 	scenarioStateKsqlHasBeenDeleted   = "The new ksqlDB cluster has been deleted"
+	scenarioStateKsqlIsProvisioned    = "The new ksqlDB cluster has been provisioned"
+	scenarioStateKsqlIsBeingResized   = "The new ksqlDB cluster is being resized"
+	scenarioStateKsqlHasBeenResized   = "The new ksqlDB cluster has been resized"
 	ksqlScenarioName                  = "confluent_ksql_cluster Resource Lifecycle"
 	ksqlResourceLabel                 = "basic-cluster"
 	containerPort                     = "8080"
@@ -378,6 +383,146 @@ func TestAccKsqlCluster(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteClusterStub, fmt.Sprintf("DELETE %s", readKsqlPath), 2)
 }
 
+func TestAccKsqlClusterCsuUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createClusterResponse, _ := ioutil.ReadFile("../testdata/ksql/PROVISIONING_ksql_4_csu.json")
+	createClusterStub := wiremock.Post(wiremock.URLPathEqualTo(createKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateKsqlHasBeenCreated).
+		WillReturn(
+			string(createClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusAccepted,
+		)
+	_ = wiremockClient.StubFor(createClusterStub)
+
+	// The cluster is still provisioning when it is read for the first time
+	readProvisioningClusterStub := wiremock.Get(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateKsqlHasBeenCreated).
+		WillSetStateTo(scenarioStateKsqlIsProvisioned).
+		WillReturn(
+			string(createClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readProvisioningClusterStub)
+
+	readCreatedClusterResponse, _ := ioutil.ReadFile("../testdata/ksql/PROVISIONED_ksql_4_csu.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateKsqlIsProvisioned).
+		WillReturn(
+			string(readCreatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	updateClusterStub := wiremock.Patch(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"csu":%s`, ksqlCsuTest2))).
+		WhenScenarioStateIs(scenarioStateKsqlIsProvisioned).
+		WillSetStateTo(scenarioStateKsqlIsBeingResized).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusAccepted,
+		)
+	_ = wiremockClient.StubFor(updateClusterStub)
+
+	// The cluster still reports the previous number of CSUs right after the update request
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateKsqlIsBeingResized).
+		WillSetStateTo(scenarioStateKsqlHasBeenResized).
+		WillReturn(
+			string(readCreatedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readResizedClusterResponse, _ := ioutil.ReadFile("../testdata/ksql/PROVISIONED_resized_ksql_8_csu.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateKsqlHasBeenResized).
+		WillReturn(
+			string(readResizedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteClusterStub := wiremock.Delete(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateKsqlHasBeenResized).
+		WillSetStateTo(scenarioStateKsqlHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteClusterStub)
+
+	readDeletedClusterResponse, _ := ioutil.ReadFile("../testdata/ksql/403_forbidden.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKsqlPath)).
+		InScenario(ksqlScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(testEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateKsqlHasBeenDeleted).
+		WillReturn(
+			string(readDeletedClusterResponse),
+			contentTypeJSONHeader,
+			http.StatusForbidden,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKsqlClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: ksqlResourceConfig(mockServerUrl, ksqlCsuTest1, ksqlUseDetailedProcessingLogTest1),
+				Check: resource.ComposeTestCheckFunc(
+					resourceCommonChecks,
+					resource.TestCheckResourceAttr(fullKsqlResourceLabel, paramCsu, ksqlCsuTest1),
+				),
+			},
+			{
+				// Updating CSUs resizes the cluster in place
+				Config: ksqlResourceConfig(mockServerUrl, ksqlCsuTest2, ksqlUseDetailedProcessingLogTest1),
+				Check: resource.ComposeTestCheckFunc(
+					resourceCommonChecks,
+					resource.TestCheckResourceAttr(fullKsqlResourceLabel, paramCsu, ksqlCsuTest2),
+					resource.TestCheckResourceAttr(fullKsqlResourceLabel, paramUseDetailedProcessingLog, ksqlUseDetailedProcessingLogTest1),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createClusterStub, fmt.Sprintf("POST %s", createKsqlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, readProvisioningClusterStub, fmt.Sprintf("GET %s", readKsqlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateClusterStub, fmt.Sprintf("PATCH %s", readKsqlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteClusterStub, fmt.Sprintf("DELETE %s", readKsqlPath), expectedCountOne)
+}
+
 func testAccCheckKsqlClusterDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 	// Loop through the resources in state, verifying each environment is destroyed
@@ -451,3 +596,34 @@ func testAccCheckKsqlClusterExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestExecuteKsqlUpdateError(t *testing.T) {
+	tests := []struct {
+		name          string
+		responseBody  string
+		expectedError string
+	}{
+		{"error detail", `{"errors":[{"status":"400","detail":"csu must be one of 1, 2, 4, 8, 12, 28"}]}`, "400 Bad Request: csu must be one of 1, 2, 4, 8, 12, 28"},
+		{"unstructured body", "invalid request", "400 Bad Request: invalid request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			cfg := ksql.NewConfiguration()
+			cfg.Servers[0].URL = server.URL
+			c := &Client{ksqlClient: ksql.NewAPIClient(cfg)}
+
+			_, err := executeKsqlUpdate(context.Background(), c, testEnvironmentId, ksqlId, 4)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Fatalf("expected error %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -134,7 +134,7 @@ func networkResource() *schema.Resource {
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
-		CustomizeDiff: customdiff.Sequence(setNetworkDiff),
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff, setNetworkDiff),
 	}
 }
 
@@ -248,6 +248,9 @@ func networkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return diag.Errorf("input validation error reading Network's %q: %s", paramConnectionTypes, createDescriptiveError(err))
 	}
+	if err := validateConnectionTypes(cloud, connectionTypes); err != nil {
+		return diag.Errorf("input validation error reading Network's %q: %s", paramConnectionTypes, createDescriptiveError(err))
+	}
 
 	cidr := d.Get(paramCidr).(string)
 	reservedCidr := d.Get(paramReservedCidr).(string)
@@ -258,7 +261,10 @@ func networkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.Errorf("input validation error reading Network's %q: %s", paramZones, createDescriptiveError(err))
 	}
 
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Network: %s", createDescriptiveError(err))
+	}
 	resolution := extractStringValueFromBlock(d, paramDnsConfig, paramResolution)
 
 	spec := net.NewNetworkingV1NetworkSpec()
@@ -300,7 +306,7 @@ func networkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(createdNetwork.GetId())
 
-	if err := waitForNetworkToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForNetworkToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Network %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -561,6 +567,15 @@ func zonesInfoSchema() *schema.Schema {
 	}
 }
 
+// TransitGateway connection type is only supported for AWS networks.
+func validateConnectionTypes(cloud string, connectionTypes []string) error {
+	isAws := cloud == strings.ToUpper(paramAws)
+	if stringInSlice(connectionTypeTransitGateway, connectionTypes, false) && !isAws {
+		return fmt.Errorf("%s connection type is only supported for AWS networks", connectionTypeTransitGateway)
+	}
+	return nil
+}
+
 // cidr is required for VPC peering and AWS TransitGateway.
 // TODO: update error messages
 func validateCidr(cidr, cloud string, connectionTypes []string) error {
@@ -664,6 +679,21 @@ func buildTfZoneInfo(zoneInfo net.NetworkingV1ZoneInfo) *map[string]interface{}
 	return &tfZoneInfo
 }
 
+// areRequestedConnectionTypesActive returns true if all requested connection types are active.
+// Networks that don't report active connection types are considered to have all of them active.
+func areRequestedConnectionTypesActive(network net.NetworkingV1Network) bool {
+	activeConnectionTypes := network.Status.GetActiveConnectionTypes().Items
+	if len(activeConnectionTypes) == 0 {
+		return true
+	}
+	for _, connectionType := range network.Spec.GetConnectionTypes().Items {
+		if !stringInSlice(connectionType, activeConnectionTypes, false) {
+			return false
+		}
+	}
+	return true
+}
+
 func getGatewayID(network net.NetworkingV1Network) string {
 	gateway := network.Spec.GetGateway()
 	return gateway.GetId()
@@ -0,0 +1,191 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	scenarioStateAwsTransitGatewayNetworkIsProvisioning = "The new aws transit gateway network is in provisioning state"
+	scenarioStateAwsTransitGatewayNetworkIsValidating   = "The new aws transit gateway network is being validated"
+	scenarioStateAwsTransitGatewayNetworkHasBeenCreated = "The new aws transit gateway network has been just created"
+	scenarioStateAwsTransitGatewayNetworkHasBeenDeleted = "The new aws transit gateway network has been deleted"
+	awsTransitGatewayNetworkScenarioName                = "confluent_network aws transit gateway Resource Lifecycle"
+	awsTransitGatewayNetworkId                          = "n-tgw123"
+	awsTransitGatewayNetworkDisplayName                 = "tgw-network"
+	awsTransitGatewayNetworkRegion                      = "us-east-1"
+	awsTransitGatewayNetworkCidr                        = "10.10.0.0/16"
+	awsTransitGatewayNetworkVpc                         = "vpc-0a1b2c3d4e5f67890"
+	awsTransitGatewayNetworkResourceName                = "crn://confluent.cloud/organization=foo/environment=env-gz903/network=n-tgw123"
+)
+
+var awsTransitGatewayNetworkUrlPath = fmt.Sprintf("/networking/v1/networks/%s", awsTransitGatewayNetworkId)
+
+func TestAccAwsTransitGatewayNetwork(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createNetworkResponse, _ := ioutil.ReadFile("../testdata/network/aws_transit_gateway/create_network.json")
+	createNetworkStub := wiremock.Post(wiremock.URLPathEqualTo("/networking/v1/networks")).
+		WithBodyPattern(wiremock.Contains(connectionTypeTransitGateway)).
+		WithBodyPattern(wiremock.Contains(awsTransitGatewayNetworkCidr)).
+		InScenario(awsTransitGatewayNetworkScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateAwsTransitGatewayNetworkIsProvisioning).
+		WillReturn(
+			string(createNetworkResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createNetworkStub)
+
+	readProvisioningNetworkResponse, _ := ioutil.ReadFile("../testdata/network/aws_transit_gateway/read_provisioning_network.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(awsTransitGatewayNetworkUrlPath)).
+		InScenario(awsTransitGatewayNetworkScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(awsNetworkEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateAwsTransitGatewayNetworkIsProvisioning).
+		WillSetStateTo(scenarioStateAwsTransitGatewayNetworkIsValidating).
+		WillReturn(
+			string(readProvisioningNetworkResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// The network is READY but TRANSITGATEWAY is not active yet
+	readValidatingNetworkResponse, _ := ioutil.ReadFile("../testdata/network/aws_transit_gateway/read_validating_network.json")
+	readValidatingNetworkStub := wiremock.Get(wiremock.URLPathEqualTo(awsTransitGatewayNetworkUrlPath)).
+		InScenario(awsTransitGatewayNetworkScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(awsNetworkEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateAwsTransitGatewayNetworkIsValidating).
+		WillSetStateTo(scenarioStateAwsTransitGatewayNetworkHasBeenCreated).
+		WillReturn(
+			string(readValidatingNetworkResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readValidatingNetworkStub)
+
+	readCreatedNetworkResponse, _ := ioutil.ReadFile("../testdata/network/aws_transit_gateway/read_created_network.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(awsTransitGatewayNetworkUrlPath)).
+		InScenario(awsTransitGatewayNetworkScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(awsNetworkEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateAwsTransitGatewayNetworkHasBeenCreated).
+		WillReturn(
+			string(readCreatedNetworkResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteNetworkStub := wiremock.Delete(wiremock.URLPathEqualTo(awsTransitGatewayNetworkUrlPath)).
+		InScenario(awsTransitGatewayNetworkScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(awsNetworkEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateAwsTransitGatewayNetworkHasBeenCreated).
+		WillSetStateTo(scenarioStateAwsTransitGatewayNetworkHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteNetworkStub)
+
+	readDeletedNetworkResponse, _ := ioutil.ReadFile("../testdata/network/aws_transit_gateway/read_deleted_network.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(awsTransitGatewayNetworkUrlPath)).
+		InScenario(awsTransitGatewayNetworkScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(awsNetworkEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateAwsTransitGatewayNetworkHasBeenDeleted).
+		WillReturn(
+			string(readDeletedNetworkResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	networkResourceLabel := "test"
+	fullNetworkResourceLabel := fmt.Sprintf("confluent_network.%s", networkResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckTransitGatewayNetworkConfig(mockServerUrl, networkResourceLabel, "AZURE"),
+				ExpectError: regexp.MustCompile("TRANSITGATEWAY connection type is only supported for AWS networks"),
+			},
+			{
+				Config: testAccCheckTransitGatewayNetworkConfig(mockServerUrl, networkResourceLabel, awsNetworkCloud),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsNetworkExists(fullNetworkResourceLabel),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, paramId, awsTransitGatewayNetworkId),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, paramDisplayName, awsTransitGatewayNetworkDisplayName),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, paramCloud, awsNetworkCloud),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, paramRegion, awsTransitGatewayNetworkRegion),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, paramCidr, awsTransitGatewayNetworkCidr),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.#", paramConnectionTypes), "2"),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.0", paramConnectionTypes), connectionTypeTransitGateway),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.1", paramConnectionTypes), connectionTypePeering),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.0.%s", paramEnvironment, paramId), awsNetworkEnvironmentId),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, paramResourceName, awsTransitGatewayNetworkResourceName),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.0.%s", paramAws, paramVpc), awsTransitGatewayNetworkVpc),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.0.%s", paramAws, paramAccount), awsNetworkAccount),
+					resource.TestCheckResourceAttr(fullNetworkResourceLabel, fmt.Sprintf("%s.0.%s", paramAws, paramPrivateLinkEndpointService), ""),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createNetworkStub, "POST /networking/v1/networks", expectedCountOne)
+	checkStubCount(t, wiremockClient, readValidatingNetworkStub, fmt.Sprintf("GET %s?environment=%s", awsTransitGatewayNetworkUrlPath, awsNetworkEnvironmentId), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteNetworkStub, fmt.Sprintf("DELETE %s?environment=%s", awsTransitGatewayNetworkUrlPath, awsNetworkEnvironmentId), expectedCountOne)
+}
+
+func testAccCheckTransitGatewayNetworkConfig(mockServerUrl, resourceLabel, cloud string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	resource "confluent_network" "%s" {
+	    display_name     = "%s"
+	    cloud            = "%s"
+	    region           = "%s"
+	    cidr             = "%s"
+	    connection_types = ["%s", "%s"]
+	    environment {
+		  id = "%s"
+	    }
+	}
+	`, mockServerUrl, resourceLabel, awsTransitGatewayNetworkDisplayName, cloud, awsTransitGatewayNetworkRegion,
+		awsTransitGatewayNetworkCidr, connectionTypeTransitGateway, connectionTypePeering, awsNetworkEnvironmentId)
+}
@@ -21,6 +21,7 @@ import (
 	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
 	"strings"
@@ -65,6 +66,7 @@ func networkLinkEndpointResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -95,7 +97,10 @@ func networkLinkEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	displayName := d.Get(paramDisplayName).(string)
 	description := d.Get(paramDescription).(string)
 	networkId := extractStringValueFromBlock(d, paramNetwork, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Network Link Endpoint: %s", createDescriptiveError(err))
+	}
 	nlsId := extractStringValueFromBlock(d, paramNetworkLinkService, paramId)
 
 	spec.SetDisplayName(displayName)
@@ -125,7 +130,7 @@ func networkLinkEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	nleId := createdNLE.GetId()
 	d.SetId(nleId)
 
-	if err := waitForNetworkLinkEndpointToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForNetworkLinkEndpointToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Network Link Endpoint %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -206,7 +211,7 @@ func networkLinkEndpointDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error deleting Network Link Endpoint %q: %s", nleId, createDescriptiveError(err))
 	}
 
-	if err := waitForNetworkLinkEndpointToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForNetworkLinkEndpointToBeDeleted(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Network Link Endpoint %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
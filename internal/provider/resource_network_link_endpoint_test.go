@@ -32,6 +32,15 @@ const (
 
 	networkLinkEndpointUrlPath       = "/networking/v1/network-link-endpoints"
 	networkLinkEndpointResourceLabel = "confluent_network_link_endpoint.nle"
+
+	connectedNetworkLinkServiceScenarioName                 = "confluent_network_link_service Connected Resource Lifecycle"
+	connectedNetworkLinkEndpointScenarioName                = "confluent_network_link_endpoint Connected Resource Lifecycle"
+	scenarioStateConnectedNetworkLinkServiceHasBeenDeleted  = "The connected network link service has been just deleted"
+	scenarioStateConnectedNetworkLinkEndpointIsProvisioning = "The connected network link endpoint is provisioning"
+	scenarioStateConnectedNetworkLinkEndpointIsReady        = "The connected network link endpoint is ready"
+	scenarioStateConnectedNetworkLinkEndpointHasBeenDeleted = "The connected network link endpoint has been just deleted"
+
+	connectedNetworkLinkEndpointReadUrlPath = "/networking/v1/network-link-endpoints/nle-2qmlz0"
 )
 
 func TestAccNetworkLinkEndpoint(t *testing.T) {
@@ -149,6 +158,185 @@ func TestAccNetworkLinkEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccNetworkLinkEndpointConnectedToNetworkLinkService(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createNLSResponse, _ := ioutil.ReadFile("../testdata/network_link_service/create_nls.json")
+	createNLSStub := wiremock.Post(wiremock.URLPathEqualTo(networkLinkServiceUrlPath)).
+		InScenario(connectedNetworkLinkServiceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateNetworkLinkServiceHasBeenCreated).
+		WillReturn(
+			string(createNLSResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createNLSStub)
+
+	readNLSResponse, _ := ioutil.ReadFile("../testdata/network_link_service/read_nls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(networkLinkServiceReadUrlPath)).
+		InScenario(connectedNetworkLinkServiceScenarioName).
+		WhenScenarioStateIs(scenarioStateNetworkLinkServiceHasBeenCreated).
+		WillReturn(
+			string(readNLSResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteNLSStub := wiremock.Delete(wiremock.URLPathEqualTo(networkLinkServiceReadUrlPath)).
+		InScenario(connectedNetworkLinkServiceScenarioName).
+		WhenScenarioStateIs(scenarioStateNetworkLinkServiceHasBeenCreated).
+		WillSetStateTo(scenarioStateConnectedNetworkLinkServiceHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteNLSStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(networkLinkServiceReadUrlPath)).
+		InScenario(connectedNetworkLinkServiceScenarioName).
+		WhenScenarioStateIs(scenarioStateConnectedNetworkLinkServiceHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	// The endpoint must reference the ID of the service that was created in the same run
+	createNLEResponse, _ := ioutil.ReadFile("../testdata/network_link_endpoint/create_connected_nle.json")
+	createNLEStub := wiremock.Post(wiremock.URLPathEqualTo(networkLinkEndpointUrlPath)).
+		InScenario(connectedNetworkLinkEndpointScenarioName).
+		WithBodyPattern(wiremock.Contains(`"network_link_service":{"id":"nls-p2k0l1"`)).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateConnectedNetworkLinkEndpointIsProvisioning).
+		WillReturn(
+			string(createNLEResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createNLEStub)
+
+	// Return the PROVISIONING status once to make sure the provider keeps polling until the endpoint is READY
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectedNetworkLinkEndpointReadUrlPath)).
+		InScenario(connectedNetworkLinkEndpointScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo("env-nkv0pz")).
+		WhenScenarioStateIs(scenarioStateConnectedNetworkLinkEndpointIsProvisioning).
+		WillSetStateTo(scenarioStateConnectedNetworkLinkEndpointIsReady).
+		WillReturn(
+			string(createNLEResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readNLEResponse, _ := ioutil.ReadFile("../testdata/network_link_endpoint/read_connected_nle.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectedNetworkLinkEndpointReadUrlPath)).
+		InScenario(connectedNetworkLinkEndpointScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo("env-nkv0pz")).
+		WhenScenarioStateIs(scenarioStateConnectedNetworkLinkEndpointIsReady).
+		WillReturn(
+			string(readNLEResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteNLEStub := wiremock.Delete(wiremock.URLPathEqualTo(connectedNetworkLinkEndpointReadUrlPath)).
+		InScenario(connectedNetworkLinkEndpointScenarioName).
+		WhenScenarioStateIs(scenarioStateConnectedNetworkLinkEndpointIsReady).
+		WillSetStateTo(scenarioStateConnectedNetworkLinkEndpointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteNLEStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(connectedNetworkLinkEndpointReadUrlPath)).
+		InScenario(connectedNetworkLinkEndpointScenarioName).
+		WhenScenarioStateIs(scenarioStateConnectedNetworkLinkEndpointHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceNetworkLinkEndpointConnectedToNetworkLinkService(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(networkLinkServiceResourceLabel, "id", "nls-p2k0l1"),
+					resource.TestCheckResourceAttr(networkLinkServiceResourceLabel, "accept.0.environments.#", "1"),
+					resource.TestCheckResourceAttr(networkLinkServiceResourceLabel, "accept.0.environments.0", "env-nkv0pz"),
+					resource.TestCheckResourceAttr(networkLinkEndpointResourceLabel, "id", "nle-2qmlz0"),
+					resource.TestCheckResourceAttr(networkLinkEndpointResourceLabel, "display_name", "network-link-endpoint-2"),
+					resource.TestCheckResourceAttr(networkLinkEndpointResourceLabel, "environment.0.id", "env-nkv0pz"),
+					resource.TestCheckResourceAttr(networkLinkEndpointResourceLabel, "network.0.id", "n-6xr90w"),
+					resource.TestCheckResourceAttrPair(networkLinkEndpointResourceLabel, "network_link_service.0.id", networkLinkServiceResourceLabel, "id"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createNLSStub, fmt.Sprintf("POST %s", networkLinkServiceUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, createNLEStub, fmt.Sprintf("POST %s", networkLinkEndpointUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteNLEStub, fmt.Sprintf("DELETE %s", connectedNetworkLinkEndpointReadUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteNLSStub, fmt.Sprintf("DELETE %s", networkLinkServiceReadUrlPath), expectedCountOne)
+}
+
+func testAccCheckResourceNetworkLinkEndpointConnectedToNetworkLinkService(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+
+	resource "confluent_network_link_service" "nls" {
+		environment {
+			id = "env-d1o8qo"
+		}
+		network {
+			id = "n-6kqnx2"
+		}
+		display_name = "network-link-service-2"
+		description = "Test NL service"
+		accept {
+			environments = ["env-nkv0pz"]
+			networks = ["n-6xr90w"]
+		}
+	}
+
+	resource "confluent_network_link_endpoint" "nle" {
+		environment {
+			id = "env-nkv0pz"
+		}
+		network {
+			id = "n-6xr90w"
+		}
+		display_name = "network-link-endpoint-2"
+		description = "NL endpoint connected to network-link-service-2"
+		network_link_service {
+			id = confluent_network_link_service.nls.id
+		}
+	}
+	`, mockServerUrl)
+}
+
 func testAccCheckResourceNetworkLinkEndpointWithIdSet(mockServerUrl string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
@@ -21,6 +21,7 @@ import (
 	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
 	"strings"
@@ -60,6 +61,7 @@ func networkLinkServiceResource() *schema.Resource {
 			},
 			paramAccept: acceptSchema(),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -95,7 +97,10 @@ func networkLinkServiceCreate(ctx context.Context, d *schema.ResourceData, meta
 	displayName := d.Get(paramDisplayName).(string)
 	description := d.Get(paramDescription).(string)
 	networkId := extractStringValueFromBlock(d, paramNetwork, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Network Link Service: %s", createDescriptiveError(err))
+	}
 
 	spec.SetDisplayName(displayName)
 	spec.SetDescription(description)
@@ -132,7 +137,7 @@ func networkLinkServiceCreate(ctx context.Context, d *schema.ResourceData, meta
 	nlsId := createdNLS.GetId()
 	d.SetId(nlsId)
 
-	if err := waitForNetworkLinkServiceToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForNetworkLinkServiceToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Network Link Service %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -220,7 +225,7 @@ func networkLinkServiceDelete(ctx context.Context, d *schema.ResourceData, meta
 
 func networkLinkServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChangesExcept(paramDisplayName, paramDescription, paramAccept) {
-		return diag.Errorf("error updating Network Link Service %q: only %q, %q and %q attributes can be updated for Network Link Endpoint", d.Id(), paramDisplayName, paramDescription, paramAccept)
+		return diag.Errorf("error updating Network Link Service %q: only %q, %q and %q attributes can be updated for Network Link Service", d.Id(), paramDisplayName, paramDescription, paramAccept)
 	}
 
 	spec := net.NewNetworkingV1NetworkLinkServiceSpecUpdate()
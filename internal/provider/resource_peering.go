@@ -21,6 +21,7 @@ import (
 	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -81,6 +82,7 @@ func peeringResource() *schema.Resource {
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -89,7 +91,10 @@ func peeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	displayName := d.Get(paramDisplayName).(string)
 	networkId := extractStringValueFromBlock(d, paramNetwork, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Peering: %s", createDescriptiveError(err))
+	}
 
 	isAwsPeering := len(d.Get(paramAws).([]interface{})) > 0
 	isAzurePeering := len(d.Get(paramAzure).([]interface{})) > 0
@@ -136,7 +141,7 @@ func peeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(createdPeering.GetId())
 
-	if err := waitForPeeringToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPeeringToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Peering %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -256,7 +261,7 @@ func peeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.Errorf("error deleting Peering %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForPeeringToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPeeringToBeDeleted(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Peering %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,7 +32,9 @@ const (
 	scenarioStateGcpPeeringIsDeprovisioning = "The new gcp peering is deprovisioning"
 	scenarioStateGcpPeeringHasBeenCreated   = "The new gcp peering has been just created"
 	scenarioStateGcpPeeringHasBeenDeleted   = "The new gcp peering's deletion has been just completed"
+	scenarioStateGcpPeeringHasFailed        = "The new gcp peering has failed"
 	gcpPeeringScenarioName                  = "confluent_gcp Peering Gcp Resource Lifecycle"
+	gcpPeeringFailureScenarioName           = "confluent_gcp Peering Gcp Resource Failure"
 	gcpPeeringEnvironmentId                 = "env-gz903"
 	gcpPeeringNetworkId                     = "n-gez54g"
 	gcpPeeringId                            = "peer-6me8yg"
@@ -192,6 +195,85 @@ func TestAccGcpPeeringAccess(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteGcpPeeringStub, fmt.Sprintf("DELETE %s?environment=%s", gcpPeeringUrlPath, gcpPeeringEnvironmentId), expectedCountOne)
 }
 
+func TestAccGcpPeeringWithOverlappingCidr(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	createGcpPeeringResponse, _ := ioutil.ReadFile("../testdata/peering/gcp/create_peering.json")
+	createGcpPeeringStub := wiremock.Post(wiremock.URLPathEqualTo("/networking/v1/peerings")).
+		InScenario(gcpPeeringFailureScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateGcpPeeringHasFailed).
+		WillReturn(
+			string(createGcpPeeringResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createGcpPeeringStub)
+
+	readFailedGcpPeeringResponse, _ := ioutil.ReadFile("../testdata/peering/gcp/read_failed_peering.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(gcpPeeringUrlPath)).
+		InScenario(gcpPeeringFailureScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(gcpPeeringEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateGcpPeeringHasFailed).
+		WillReturn(
+			string(readFailedGcpPeeringResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// The failed peering is tainted and gets deleted on destroy
+	deleteGcpPeeringStub := wiremock.Delete(wiremock.URLPathEqualTo(gcpPeeringUrlPath)).
+		InScenario(gcpPeeringFailureScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(gcpPeeringEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateGcpPeeringHasFailed).
+		WillSetStateTo(scenarioStateGcpPeeringHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteGcpPeeringStub)
+
+	readDeletedGcpPeeringResponse, _ := ioutil.ReadFile("../testdata/peering/gcp/read_deleted_peering.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(gcpPeeringUrlPath)).
+		InScenario(gcpPeeringFailureScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(gcpPeeringEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateGcpPeeringHasBeenDeleted).
+		WillReturn(
+			string(readDeletedGcpPeeringResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckGcpPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckGcpPeeringConfig(mockServerUrl, "my-test-peering", "test"),
+				ExpectError: regexp.MustCompile("CIDR_OVERLAP"),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createGcpPeeringStub, "POST /networking/v1/peerings", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteGcpPeeringStub, fmt.Sprintf("DELETE %s?environment=%s", gcpPeeringUrlPath, gcpPeeringEnvironmentId), expectedCountOne)
+}
+
 func testAccCheckGcpPeeringDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 	// Loop through the resources in state, verifying each gcp peering is destroyed
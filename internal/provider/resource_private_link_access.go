@@ -21,6 +21,7 @@ import (
 	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -67,6 +68,7 @@ func privateLinkAccessResource() *schema.Resource {
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -97,7 +99,10 @@ func privateLinkAccessCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	displayName := d.Get(paramDisplayName).(string)
 	networkId := extractStringValueFromBlock(d, paramNetwork, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Private Link Access: %s", createDescriptiveError(err))
+	}
 
 	// Non-empty value means AWS account attribute has been set
 	awsAccount := extractStringValueFromBlock(d, paramAws, paramAccount)
@@ -133,7 +138,7 @@ func privateLinkAccessCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.SetId(createdPrivateLinkAccess.GetId())
 
-	if err := waitForPrivateLinkAccessToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPrivateLinkAccessToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Private Link Access %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -245,7 +250,7 @@ func privateLinkAccessDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error deleting Private Link Access %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForPrivateLinkAccessToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id(), c.isAcceptanceTestMode); err != nil {
+	if err := waitForPrivateLinkAccessToBeDeleted(ctx, c, environmentId, d.Id(), c.isAcceptanceTestMode); err != nil {
 		return diag.Errorf("error waiting for Private Link Access %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
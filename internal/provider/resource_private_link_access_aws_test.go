@@ -28,7 +28,6 @@ import (
 
 const (
 	scenarioStateAwsPlaIsProvisioning          = "The new aws private link access is provisioning"
-	scenarioStateAwsPlaIsDeprovisioning        = "The new aws private link access is deprovisioning"
 	scenarioStateAwsPlaHasBeenCreated          = "The new aws private link access has been just created"
 	scenarioStateAwsPlaIsInDeprovisioningState = "The new aws private link access is in deprovisioning state"
 	scenarioStateAwsPlaHasBeenDeleted          = "The new aws private link access's deletion has been just completed"
@@ -104,11 +103,12 @@ func TestAccAwsPrivateLinkAccess(t *testing.T) {
 		)
 	_ = wiremockClient.StubFor(deleteAwsPlaStub)
 
+	// Return the DEPROVISIONING status once to make sure the provider keeps polling until the Private Link Access is gone
 	readDeprovisioningAwsPlaResponse, _ := ioutil.ReadFile("../testdata/private_link_access/aws/read_deprovisioning_pla.json")
-	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(awsPlaUrlPath)).
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(awsPlaUrlPath)).
 		InScenario(awsPlaScenarioName).
 		WithQueryParam("environment", wiremock.EqualTo(awsPlaEnvironmentId)).
-		WhenScenarioStateIs(scenarioStateAwsPlaIsDeprovisioning).
+		WhenScenarioStateIs(scenarioStateAwsPlaIsInDeprovisioningState).
 		WillSetStateTo(scenarioStateAwsPlaHasBeenDeleted).
 		WillReturn(
 			string(readDeprovisioningAwsPlaResponse),
@@ -28,7 +28,6 @@ import (
 
 const (
 	scenarioStateAzurePlaIsProvisioning          = "The new azure private link access is provisioning"
-	scenarioStateAzurePlaIsDeprovisioning        = "The new azure private link access is deprovisioning"
 	scenarioStateAzurePlaHasBeenCreated          = "The new azure private link access has been just created"
 	scenarioStateAzurePlaIsInDeprovisioningState = "The new azure private link access is in deprovisioning state"
 	scenarioStateAzurePlaHasBeenDeleted          = "The new azure private link access's deletion has been just completed"
@@ -104,11 +103,12 @@ func TestAccAzurePrivateLinkAccess(t *testing.T) {
 		)
 	_ = wiremockClient.StubFor(deleteAzurePlaStub)
 
+	// Return the DEPROVISIONING status once to make sure the provider keeps polling until the Private Link Access is gone
 	readDeprovisioningAzurePlaResponse, _ := ioutil.ReadFile("../testdata/private_link_access/azure/read_deprovisioning_pla.json")
-	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(azurePlaUrlPath)).
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(azurePlaUrlPath)).
 		InScenario(azurePlaScenarioName).
 		WithQueryParam("environment", wiremock.EqualTo(azurePlaEnvironmentId)).
-		WhenScenarioStateIs(scenarioStateAzurePlaIsDeprovisioning).
+		WhenScenarioStateIs(scenarioStateAzurePlaIsInDeprovisioningState).
 		WillSetStateTo(scenarioStateAzurePlaHasBeenDeleted).
 		WillReturn(
 			string(readDeprovisioningAzurePlaResponse),
@@ -28,7 +28,6 @@ import (
 
 const (
 	scenarioStateGcpPlaIsProvisioning          = "The new gcp private link access is provisioning"
-	scenarioStateGcpPlaIsDeprovisioning        = "The new gcp private link access is deprovisioning"
 	scenarioStateGcpPlaHasBeenCreated          = "The new gcp private link access has been just created"
 	scenarioStateGcpPlaIsInDeprovisioningState = "The new gcp private link access is in deprovisioning state"
 	scenarioStateGcpPlaHasBeenDeleted          = "The new gcp private link access's deletion has been just completed"
@@ -104,11 +103,12 @@ func TestAccGcpPrivateLinkAccess(t *testing.T) {
 		)
 	_ = wiremockClient.StubFor(deleteGcpPlaStub)
 
+	// Return the DEPROVISIONING status once to make sure the provider keeps polling until the Private Link Access is gone
 	readDeprovisioningGcpPlaResponse, _ := ioutil.ReadFile("../testdata/private_link_access/gcp/read_deprovisioning_pla.json")
-	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(gcpPlaUrlPath)).
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(gcpPlaUrlPath)).
 		InScenario(gcpPlaScenarioName).
 		WithQueryParam("environment", wiremock.EqualTo(gcpPlaEnvironmentId)).
-		WhenScenarioStateIs(scenarioStateGcpPlaIsDeprovisioning).
+		WhenScenarioStateIs(scenarioStateGcpPlaIsInDeprovisioningState).
 		WillSetStateTo(scenarioStateGcpPlaHasBeenDeleted).
 		WillReturn(
 			string(readDeprovisioningGcpPlaResponse),
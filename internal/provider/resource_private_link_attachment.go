@@ -21,6 +21,7 @@ import (
 	netpl "github.com/confluentinc/ccloud-sdk-go-v2/networking-privatelink/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -71,6 +72,7 @@ func privateLinkAttachmentResource() *schema.Resource {
 			paramAzure: azurePrivateLinkServicesSchema(),
 			paramGcp:   gcpServiceAttachmentsSchema(),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -134,7 +136,10 @@ func privateLinkAttachmentCreate(ctx context.Context, d *schema.ResourceData, me
 	displayName := d.Get(paramDisplayName).(string)
 	cloud := d.Get(paramCloud).(string)
 	region := d.Get(paramRegion).(string)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Private Link Attachment: %s", createDescriptiveError(err))
+	}
 
 	spec.SetDisplayName(displayName)
 	spec.SetEnvironment(netpl.ObjectReference{Id: environmentId})
@@ -162,7 +167,7 @@ func privateLinkAttachmentCreate(ctx context.Context, d *schema.ResourceData, me
 	plattId := createdPlatt.GetId()
 	d.SetId(plattId)
 
-	if err := waitForPrivateLinkAttachmentToProvision(c.netPLApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPrivateLinkAttachmentToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Private Link Attachment %q to provision: %s", plattId, createDescriptiveError(err))
 	}
 
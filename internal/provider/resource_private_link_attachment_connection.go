@@ -21,6 +21,7 @@ import (
 	netpl "github.com/confluentinc/ccloud-sdk-go-v2/networking-privatelink/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -65,6 +66,7 @@ func privateLinkAttachmentConnectionResource() *schema.Resource {
 			paramAzure:                 azurePlattcSchema(),
 			paramGcp:                   gcpPlattcSchema(),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -210,7 +212,10 @@ func privateLinkAttachmentConnectionCreate(ctx context.Context, d *schema.Resour
 	spec := netpl.NewNetworkingV1PrivateLinkAttachmentConnectionSpec()
 
 	displayName := d.Get(paramDisplayName).(string)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Private Link Attachment Connection: %s", createDescriptiveError(err))
+	}
 	plattId := extractStringValueFromBlock(d, paramPrivateLinkAttachment, paramId)
 
 	spec.SetDisplayName(displayName)
@@ -250,7 +255,7 @@ func privateLinkAttachmentConnectionCreate(ctx context.Context, d *schema.Resour
 	plattcId := createdPlattc.GetId()
 	d.SetId(plattcId)
 
-	if err := waitForPrivateLinkAttachmentConnectionToProvision(c.netPLApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPrivateLinkAttachmentConnectionToProvision(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Private Link Attachment Connection %q to provision: %s", plattcId, createDescriptiveError(err))
 	}
 
@@ -275,7 +280,7 @@ func privateLinkAttachmentConnectionDelete(ctx context.Context, d *schema.Resour
 		return diag.Errorf("error deleting Private Link Attachment Connection %q: %s", plattcId, createDescriptiveError(err))
 	}
 
-	if err := waitForPrivateLinkAttachmentConnectionToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPrivateLinkAttachmentConnectionToBeDeleted(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Private Link Attachment Connection %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
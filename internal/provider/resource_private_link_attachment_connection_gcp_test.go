@@ -0,0 +1,153 @@
+// Copyright 2023 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const (
+	privateLinkAttachmentConnectionGcpResourceScenarioName              = "confluent_private_link_attachment_connection GCP Resource Lifecycle"
+	scenarioStatePrivateLinkAttachmentConnectionGcpIsProvisioning       = "A new GCP private link attachment connection is provisioning"
+	scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenCreated       = "A new GCP private link attachment connection has been just created"
+	scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenDeleted       = "A new GCP private link attachment connection has been deleted"
+	privateLinkAttachmentConnectionGcpReadUrlPath                       = "/networking/v1/private-link-attachment-connections/plattc-gcp123"
+	privateLinkAttachmentConnectionGcpResourceLabel                     = "confluent_private_link_attachment_connection.main"
+	privateLinkAttachmentConnectionGcpPrivateServiceConnectConnectionId = "76543210987654321"
+)
+
+func TestAccPrivateLinkAttachmentConnectionGcp(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createPlattcResponse, _ := ioutil.ReadFile("../testdata/private_link_attachment_connection/create_gcp_plattc.json")
+	createPlattcStub := wiremock.Post(wiremock.URLPathEqualTo(privateLinkAttachmentConnectionAzureUrlPath)).
+		WithBodyPattern(wiremock.Contains(privateLinkAttachmentConnectionGcpPrivateServiceConnectConnectionId)).
+		InScenario(privateLinkAttachmentConnectionGcpResourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStatePrivateLinkAttachmentConnectionGcpIsProvisioning).
+		WillReturn(
+			string(createPlattcResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createPlattcStub)
+
+	readProvisioningPlattcResponse, _ := ioutil.ReadFile("../testdata/private_link_attachment_connection/read_provisioning_gcp_plattc.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(privateLinkAttachmentConnectionGcpReadUrlPath)).
+		InScenario(privateLinkAttachmentConnectionGcpResourceScenarioName).
+		WhenScenarioStateIs(scenarioStatePrivateLinkAttachmentConnectionGcpIsProvisioning).
+		WillSetStateTo(scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenCreated).
+		WillReturn(
+			string(readProvisioningPlattcResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readPlattcResponse, _ := ioutil.ReadFile("../testdata/private_link_attachment_connection/read_gcp_plattc.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(privateLinkAttachmentConnectionGcpReadUrlPath)).
+		InScenario(privateLinkAttachmentConnectionGcpResourceScenarioName).
+		WhenScenarioStateIs(scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenCreated).
+		WillReturn(
+			string(readPlattcResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deletePlattcStub := wiremock.Delete(wiremock.URLPathEqualTo(privateLinkAttachmentConnectionGcpReadUrlPath)).
+		InScenario(privateLinkAttachmentConnectionGcpResourceScenarioName).
+		WhenScenarioStateIs(scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenCreated).
+		WillSetStateTo(scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deletePlattcStub)
+
+	readDeletedPlattcResponse, _ := ioutil.ReadFile("../testdata/private_link_attachment_connection/read_deleted_gcp_plattc.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(privateLinkAttachmentConnectionGcpReadUrlPath)).
+		InScenario(privateLinkAttachmentConnectionGcpResourceScenarioName).
+		WhenScenarioStateIs(scenarioStatePrivateLinkAttachmentConnectionGcpHasBeenDeleted).
+		WillReturn(
+			string(readDeletedPlattcResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourcePrivateLinkAttachmentConnectionGcp(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "id", "plattc-gcp123"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "resource_name", "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/private-link-attachment-connection=plattc-gcp123"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "display_name", "prod-gcp-us-central1-a-connection"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "environment.0.id", "env-12345"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "gcp.#", "1"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "gcp.0.private_service_connect_connection_id", privateLinkAttachmentConnectionGcpPrivateServiceConnectConnectionId),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "aws.#", "0"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "azure.#", "0"),
+					resource.TestCheckResourceAttr(privateLinkAttachmentConnectionGcpResourceLabel, "private_link_attachment.0.id", "platt-gcp456"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createPlattcStub, fmt.Sprintf("POST %s", privateLinkAttachmentConnectionAzureUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deletePlattcStub, fmt.Sprintf("DELETE %s", privateLinkAttachmentConnectionGcpReadUrlPath), expectedCountOne)
+}
+
+func testAccCheckResourcePrivateLinkAttachmentConnectionGcp(mockServerUrl string) string {
+	return fmt.Sprintf(`
+    provider "confluent" {
+        endpoint = "%s"
+    }
+
+    resource confluent_private_link_attachment_connection main {
+	    display_name = "prod-gcp-us-central1-a-connection"
+		environment {
+			id = "env-12345"
+		}
+		gcp {
+			private_service_connect_connection_id = "%s"
+		}
+		private_link_attachment {
+			id = "platt-gcp456"
+		}
+	}
+	`, mockServerUrl, privateLinkAttachmentConnectionGcpPrivateServiceConnectConnectionId)
+}
@@ -0,0 +1,273 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramCustomerRoleArn = "customer_role_arn"
+	paramIamRoleArn      = "iam_role_arn"
+	paramExternalId      = "external_id"
+	paramUsages          = "usages"
+
+	providerIntegrationsPath = "/pim/v1/integrations"
+	providerIntegrationAws   = "aws"
+	kindAwsIntegrationConfig = "AwsIntegrationConfig"
+)
+
+// pimIntegration represents pim.v1.Integration since ccloud-sdk-go-v2 doesn't have a Provider Integration Manager SDK yet
+type pimIntegration struct {
+	ApiVersion  string             `json:"api_version,omitempty"`
+	Kind        string             `json:"kind,omitempty"`
+	Id          string             `json:"id,omitempty"`
+	DisplayName string             `json:"display_name,omitempty"`
+	Provider    string             `json:"provider,omitempty"`
+	Config      *pimAwsIntegration `json:"config,omitempty"`
+	Environment *pimEnvironmentRef `json:"environment,omitempty"`
+	Usages      []string           `json:"usages,omitempty"`
+}
+
+type pimAwsIntegration struct {
+	Kind               string `json:"kind"`
+	CustomerIamRoleArn string `json:"customer_iam_role_arn,omitempty"`
+	IamRoleArn         string `json:"iam_role_arn,omitempty"`
+	ExternalId         string `json:"external_id,omitempty"`
+}
+
+type pimEnvironmentRef struct {
+	Id string `json:"id"`
+}
+
+func providerIntegrationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: providerIntegrationCreate,
+		ReadContext:   providerIntegrationRead,
+		DeleteContext: providerIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: providerIntegrationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the Provider Integration.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramAws:         awsProviderIntegrationSchema(),
+			paramEnvironment: environmentSchema(),
+			paramUsages: {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "List of resource CRNs where this Provider Integration is being used.",
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
+	}
+}
+
+func awsProviderIntegrationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramCustomerRoleArn: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Amazon Resource Name (ARN) that identifies the AWS Identity and Access Management (IAM) role that Confluent Cloud assumes when it accesses resources in your AWS account.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/`), "the customer role ARN must be of the form 'arn:aws:iam::<AWS account ID>:role/<role name>'"),
+				},
+				paramIamRoleArn: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The IAM role ARN used in Confluent Cloud internally, bundled with customer_role_arn.",
+				},
+				paramExternalId: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Unique external ID used by Confluent Cloud to assume the IAM role.",
+				},
+			},
+		},
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		MaxItems: 1,
+	}
+}
+
+func providerIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Provider Integration: %s", createDescriptiveError(err))
+	}
+
+	createProviderIntegrationRequest := &pimIntegration{
+		DisplayName: d.Get(paramDisplayName).(string),
+		Provider:    providerIntegrationAws,
+		Config: &pimAwsIntegration{
+			Kind:               kindAwsIntegrationConfig,
+			CustomerIamRoleArn: extractStringValueFromBlock(d, paramAws, paramCustomerRoleArn),
+		},
+		Environment: &pimEnvironmentRef{Id: environmentId},
+	}
+	createProviderIntegrationRequestJson, err := json.Marshal(createProviderIntegrationRequest)
+	if err != nil {
+		return diag.Errorf("error creating Provider Integration: error marshaling %#v to json: %s", createProviderIntegrationRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Provider Integration: %s", createProviderIntegrationRequestJson))
+
+	var createdProviderIntegration pimIntegration
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodPost, providerIntegrationsPath, createProviderIntegrationRequest, &createdProviderIntegration); err != nil {
+		return diag.Errorf("error creating Provider Integration %q: %s", createProviderIntegrationRequest.DisplayName, createDescriptiveError(err))
+	}
+	d.SetId(createdProviderIntegration.Id)
+
+	createdProviderIntegrationJson, err := json.Marshal(createdProviderIntegration)
+	if err != nil {
+		return diag.Errorf("error creating Provider Integration %q: error marshaling %#v to json: %s", d.Id(), createdProviderIntegration, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Provider Integration %q: %s", d.Id(), createdProviderIntegrationJson), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+
+	return providerIntegrationRead(ctx, d, meta)
+}
+
+func executeProviderIntegrationRead(ctx context.Context, c *Client, environmentId, providerIntegrationId string) (pimIntegration, *http.Response, error) {
+	var providerIntegration pimIntegration
+	resp, err := executeCloudApiRequest(ctx, c, http.MethodGet, providerIntegrationPath(environmentId, providerIntegrationId), nil, &providerIntegration)
+	return providerIntegration, resp, err
+}
+
+func providerIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Provider Integration %q", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	if _, err := readProviderIntegrationAndSetAttributes(ctx, d, meta, environmentId, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Provider Integration %q: %s", d.Id(), createDescriptiveError(err)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Provider Integration %q", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+
+	return nil
+}
+
+func readProviderIntegrationAndSetAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentId, providerIntegrationId string) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	providerIntegration, resp, err := executeProviderIntegrationRead(ctx, c, environmentId, providerIntegrationId)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Provider Integration %q: %s", providerIntegrationId, createDescriptiveError(err)), map[string]interface{}{providerIntegrationLoggingKey: providerIntegrationId})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Provider Integration %q in TF state because Provider Integration could not be found on the server", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+			d.SetId("")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	providerIntegrationJson, err := json.Marshal(providerIntegration)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Provider Integration %q: error marshaling %#v to json: %s", providerIntegrationId, providerIntegration, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Provider Integration %q: %s", providerIntegrationId, providerIntegrationJson), map[string]interface{}{providerIntegrationLoggingKey: providerIntegrationId})
+
+	if _, err := setProviderIntegrationAttributes(d, providerIntegration, environmentId); err != nil {
+		return nil, createDescriptiveError(err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func providerIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Provider Integration %q", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodDelete, providerIntegrationPath(environmentId, d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting Provider Integration %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Provider Integration %q", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+
+	return nil
+}
+
+func providerIntegrationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Provider Integration %q", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+
+	envIDAndProviderIntegrationId := d.Id()
+	parts := strings.Split(envIDAndProviderIntegrationId, "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("error importing Provider Integration: invalid format: expected '<env ID>/<Provider Integration ID>'")
+	}
+
+	environmentId := parts[0]
+	providerIntegrationId := parts[1]
+	d.SetId(providerIntegrationId)
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readProviderIntegrationAndSetAttributes(ctx, d, meta, environmentId, providerIntegrationId); err != nil {
+		return nil, fmt.Errorf("error importing Provider Integration %q: %s", d.Id(), err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Provider Integration %q", d.Id()), map[string]interface{}{providerIntegrationLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func setProviderIntegrationAttributes(d *schema.ResourceData, providerIntegration pimIntegration, environmentId string) (*schema.ResourceData, error) {
+	if err := d.Set(paramDisplayName, providerIntegration.DisplayName); err != nil {
+		return nil, err
+	}
+	if providerIntegration.Config != nil {
+		if err := d.Set(paramAws, []interface{}{map[string]interface{}{
+			paramCustomerRoleArn: providerIntegration.Config.CustomerIamRoleArn,
+			paramIamRoleArn:      providerIntegration.Config.IamRoleArn,
+			paramExternalId:      providerIntegration.Config.ExternalId,
+		}}); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.Set(paramUsages, providerIntegration.Usages); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, environmentId, d); err != nil {
+		return nil, err
+	}
+	d.SetId(providerIntegration.Id)
+	return d, nil
+}
+
+func providerIntegrationPath(environmentId, providerIntegrationId string) string {
+	return fmt.Sprintf("%s/%s?%s", providerIntegrationsPath, url.PathEscape(providerIntegrationId), url.Values{"environment": {environmentId}}.Encode())
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateProviderIntegrationHasBeenCreated = "The new Provider Integration has been just created"
+	scenarioStateProviderIntegrationHasBeenDeleted = "The new Provider Integration has been deleted"
+	providerIntegrationScenarioName                = "confluent_provider_integration Resource Lifecycle"
+
+	providerIntegrationId              = "cspi-4xg0q"
+	providerIntegrationDisplayName     = "s3_tableflow_integration"
+	providerIntegrationEnvironmentId   = "env-00000"
+	providerIntegrationCustomerRoleArn = "arn:aws:iam::123456789012:role/confluent-tableflow"
+	providerIntegrationIamRoleArn      = "arn:aws:iam::210987654321:role/cspi-4xg0q-assumer"
+	providerIntegrationExternalId      = "95b6d3c1-8c45-4e6a-9a5b-2f0b4e1f3a7c"
+	providerIntegrationUsage           = "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-00000/cloud-cluster=lkc-00000/connector=s3_sink"
+	providerIntegrationResourceLabel   = "test_provider_integration_resource_label"
+)
+
+var providerIntegrationUrlPath = fmt.Sprintf("/pim/v1/integrations/%s", providerIntegrationId)
+
+func TestAccProviderIntegrationAws(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createProviderIntegrationResponse, _ := ioutil.ReadFile("../testdata/provider_integration/create_provider_integration.json")
+	createProviderIntegrationStub := wiremock.Post(wiremock.URLPathEqualTo("/pim/v1/integrations")).
+		InScenario(providerIntegrationScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(providerIntegrationCustomerRoleArn)).
+		WillSetStateTo(scenarioStateProviderIntegrationHasBeenCreated).
+		WillReturn(
+			string(createProviderIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createProviderIntegrationStub)
+
+	readCreatedProviderIntegrationResponse, _ := ioutil.ReadFile("../testdata/provider_integration/read_created_provider_integration.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(providerIntegrationUrlPath)).
+		InScenario(providerIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(providerIntegrationEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateProviderIntegrationHasBeenCreated).
+		WillReturn(
+			string(readCreatedProviderIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedProviderIntegrationResponse, _ := ioutil.ReadFile("../testdata/provider_integration/read_deleted_provider_integration.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(providerIntegrationUrlPath)).
+		InScenario(providerIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(providerIntegrationEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateProviderIntegrationHasBeenDeleted).
+		WillReturn(
+			string(readDeletedProviderIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteProviderIntegrationStub := wiremock.Delete(wiremock.URLPathEqualTo(providerIntegrationUrlPath)).
+		InScenario(providerIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(providerIntegrationEnvironmentId)).
+		WhenScenarioStateIs(scenarioStateProviderIntegrationHasBeenCreated).
+		WillSetStateTo(scenarioStateProviderIntegrationHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteProviderIntegrationStub)
+
+	fullProviderIntegrationResourceLabel := fmt.Sprintf("confluent_provider_integration.%s", providerIntegrationResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProviderIntegrationDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckProviderIntegrationConfig(mockServerUrl, "arn:aws:iam::123456789012:user/confluent"),
+				ExpectError: regexp.MustCompile("the customer role ARN must be of the form"),
+			},
+			{
+				Config: testAccCheckProviderIntegrationConfig(mockServerUrl, providerIntegrationCustomerRoleArn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "id", providerIntegrationId),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "display_name", providerIntegrationDisplayName),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "environment.#", "1"),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "environment.0.id", providerIntegrationEnvironmentId),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "aws.#", "1"),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "aws.0.customer_role_arn", providerIntegrationCustomerRoleArn),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "aws.0.iam_role_arn", providerIntegrationIamRoleArn),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "aws.0.external_id", providerIntegrationExternalId),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "usages.#", "1"),
+					resource.TestCheckResourceAttr(fullProviderIntegrationResourceLabel, "usages.0", providerIntegrationUsage),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullProviderIntegrationResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.RootModule().Resources
+					providerIntegrationId := resources[fullProviderIntegrationResourceLabel].Primary.ID
+					environmentId := resources[fullProviderIntegrationResourceLabel].Primary.Attributes["environment.0.id"]
+					return environmentId + "/" + providerIntegrationId, nil
+				},
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createProviderIntegrationStub, "POST /pim/v1/integrations", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteProviderIntegrationStub, fmt.Sprintf("DELETE %s", providerIntegrationUrlPath), expectedCountOne)
+}
+
+func testAccCheckProviderIntegrationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each Provider Integration is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_provider_integration" {
+			continue
+		}
+		deletedProviderIntegration, response, err := executeProviderIntegrationRead(context.Background(), c, rs.Primary.Attributes["environment.0.id"], rs.Primary.ID)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedProviderIntegration.Id == rs.Primary.ID {
+			return fmt.Errorf("Provider Integration (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckProviderIntegrationConfig(mockServerUrl, customerRoleArn string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_provider_integration" "%s" {
+		display_name = "%s"
+		environment {
+			id = "%s"
+		}
+		aws {
+			customer_role_arn = "%s"
+		}
+	}
+	`, mockServerUrl, providerIntegrationResourceLabel, providerIntegrationDisplayName, providerIntegrationEnvironmentId, customerRoleArn)
+}
@@ -21,27 +21,50 @@ import (
 	mds "github.com/confluentinc/ccloud-sdk-go-v2/mds/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
 	"regexp"
+	"strings"
 	"time"
 )
 
 const (
-	paramRoleName   = "role_name"
-	paramCrnPattern = "crn_pattern"
+	paramRoleName            = "role_name"
+	paramCrnPattern          = "crn_pattern"
+	paramKafkaClusterRbacCrn = "kafka_cluster_rbac_crn"
 
 	rbacWaitAfterCreateToSync = 90 * time.Second
+
+	roleBindingResourceTypeTopic           = "TOPIC"
+	roleBindingResourceTypeGroup           = "GROUP"
+	roleBindingResourceTypeCluster         = "CLUSTER"
+	roleBindingResourceTypeTransactionalId = "TRANSACTIONAL_ID"
+	roleBindingPatternTypeLiteral          = "LITERAL"
+	roleBindingPatternTypePrefixed         = "PREFIXED"
 )
 
+var acceptedRoleBindingResourceTypes = []string{roleBindingResourceTypeTopic, roleBindingResourceTypeGroup, roleBindingResourceTypeCluster, roleBindingResourceTypeTransactionalId}
+var acceptedRoleBindingPatternTypes = []string{roleBindingPatternTypeLiteral, roleBindingPatternTypePrefixed}
+
+// Maps a resource type to the name of the corresponding element of a Kafka CRN, for example, crn://.../kafka=lkc-abc123/topic=orders
+var roleBindingResourceTypeToCrnElement = map[string]string{
+	roleBindingResourceTypeTopic:           "topic",
+	roleBindingResourceTypeGroup:           "group",
+	roleBindingResourceTypeTransactionalId: "transactional-id",
+}
+
+var kafkaClusterRbacCrnRegExp = regexp.MustCompile("^crn://.+/cloud-cluster=(lkc-[^/]+)$")
+var roleBindingIdRegExp = regexp.MustCompile(`^rb-[^\s/]+$`)
+
 func roleBindingResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: roleBindingCreate,
 		ReadContext:   roleBindingRead,
 		DeleteContext: roleBindingDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: roleBindingImport,
 		},
 		Schema: map[string]*schema.Schema{
 			paramPrincipal: {
@@ -58,14 +81,108 @@ func roleBindingResource() *schema.Resource {
 				Description: "The name of the role to bind to the principal.",
 			},
 			paramCrnPattern: {
-				Type:         schema.TypeString,
-				Required:     true,
+				Type:     schema.TypeString,
+				Optional: true,
+				// Computed when the CRN is assembled from the resource_type, resource_name, pattern_type and kafka_cluster_rbac_crn attributes
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "A CRN that specifies the scope and resource patterns necessary for the role to bind.",
 				ValidateFunc: validation.StringMatch(regexp.MustCompile("^crn://"), "the CRN must be of the form 'crn://'"),
+				ExactlyOneOf: []string{paramCrnPattern, paramResourceType},
+			},
+			paramResourceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The type of the Kafka resource to bind the role to.",
+				ValidateFunc: validation.StringInSlice(acceptedRoleBindingResourceTypes, false),
+				ExactlyOneOf: []string{paramCrnPattern, paramResourceType},
+				RequiredWith: []string{paramKafkaClusterRbacCrn},
+			},
+			paramResourceName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The name of the Kafka resource to bind the role to.",
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{paramResourceType},
+			},
+			paramPatternType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Whether resource_name is matched literally or as a prefix.",
+				ValidateFunc: validation.StringInSlice(acceptedRoleBindingPatternTypes, false),
+				RequiredWith: []string{paramResourceType},
+			},
+			paramKafkaClusterRbacCrn: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The `rbac_crn` of the Kafka cluster that contains the resource to bind the role to.",
+				ValidateFunc: validation.StringMatch(kafkaClusterRbacCrnRegExp, "the CRN must be of the form 'crn://.../cloud-cluster=lkc-abc123'"),
+				RequiredWith: []string{paramResourceType},
 			},
 		},
+		CustomizeDiff: customdiff.Sequence(resourceRoleBindingCustomizeDiff),
+	}
+}
+
+func resourceRoleBindingCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	resourceType := diff.Get(paramResourceType).(string)
+	if resourceType == "" {
+		return nil
+	}
+	// Display the assembled CRN during `terraform plan` unless it depends on a cluster that hasn't been created yet
+	if !diff.NewValueKnown(paramKafkaClusterRbacCrn) || !diff.NewValueKnown(paramResourceName) {
+		return diff.SetNewComputed(paramCrnPattern)
+	}
+	crnPattern, err := buildRoleBindingCrnPattern(diff.Get(paramKafkaClusterRbacCrn).(string), resourceType, diff.Get(paramResourceName).(string), diff.Get(paramPatternType).(string))
+	if err != nil {
+		return err
 	}
+	return diff.SetNew(paramCrnPattern, crnPattern)
+}
+
+// buildRoleBindingCrnPattern assembles a CRN pattern for a Kafka resource from the rbac_crn of its cluster, for example,
+// crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123/cloud-cluster=lkc-abc123/kafka=lkc-abc123/topic=orders*
+func buildRoleBindingCrnPattern(kafkaClusterRbacCrn, resourceType, resourceName, patternType string) (string, error) {
+	matches := kafkaClusterRbacCrnRegExp.FindStringSubmatch(kafkaClusterRbacCrn)
+	if matches == nil {
+		return "", fmt.Errorf("invalid %q %q: the CRN must be of the form 'crn://.../cloud-cluster=lkc-abc123'", paramKafkaClusterRbacCrn, kafkaClusterRbacCrn)
+	}
+	kafkaCrnPattern := fmt.Sprintf("%s/kafka=%s", kafkaClusterRbacCrn, matches[1])
+
+	if resourceType == roleBindingResourceTypeCluster {
+		if resourceName != "" || patternType != "" {
+			return "", fmt.Errorf("%q and %q must not be set when %q is %q", paramResourceName, paramPatternType, paramResourceType, roleBindingResourceTypeCluster)
+		}
+		return kafkaCrnPattern, nil
+	}
+
+	crnElement, ok := roleBindingResourceTypeToCrnElement[resourceType]
+	if !ok {
+		return "", fmt.Errorf("invalid %q %q: expected one of %v", paramResourceType, resourceType, acceptedRoleBindingResourceTypes)
+	}
+	if resourceName == "" {
+		return "", fmt.Errorf("%q must be set when %q is %q", paramResourceName, paramResourceType, resourceType)
+	}
+	switch patternType {
+	case "", roleBindingPatternTypeLiteral:
+		return fmt.Sprintf("%s/%s=%s", kafkaCrnPattern, crnElement, resourceName), nil
+	case roleBindingPatternTypePrefixed:
+		return fmt.Sprintf("%s/%s=%s*", kafkaCrnPattern, crnElement, resourceName), nil
+	default:
+		return "", fmt.Errorf("invalid %q %q: expected one of %v", paramPatternType, patternType, acceptedRoleBindingPatternTypes)
+	}
+}
+
+func extractRoleBindingCrnPattern(d *schema.ResourceData) (string, error) {
+	resourceType := d.Get(paramResourceType).(string)
+	if resourceType == "" {
+		return d.Get(paramCrnPattern).(string), nil
+	}
+	return buildRoleBindingCrnPattern(d.Get(paramKafkaClusterRbacCrn).(string), resourceType, d.Get(paramResourceName).(string), d.Get(paramPatternType).(string))
 }
 
 func roleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -73,7 +190,10 @@ func roleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	principal := d.Get(paramPrincipal).(string)
 	roleName := d.Get(paramRoleName).(string)
-	crnPattern := d.Get(paramCrnPattern).(string)
+	crnPattern, err := extractRoleBindingCrnPattern(d)
+	if err != nil {
+		return diag.Errorf("error creating Role Binding: %s", createDescriptiveError(err))
+	}
 
 	createRoleBindingRequest := mds.NewIamV2RoleBinding()
 	createRoleBindingRequest.SetPrincipal(principal)
@@ -170,3 +290,30 @@ func setRoleBindingAttributes(d *schema.ResourceData, roleBinding mds.IamV2RoleB
 	d.SetId(roleBinding.GetId())
 	return d, nil
 }
+
+func roleBindingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	roleBindingId, err := extractRoleBindingImportId(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error importing Role Binding: %s", err)
+	}
+	d.SetId(roleBindingId)
+	tflog.Debug(ctx, fmt.Sprintf("Importing Role Binding %q", d.Id()), map[string]interface{}{roleBindingLoggingKey: d.Id()})
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if diagnostics := roleBindingRead(ctx, d, meta); diagnostics != nil {
+		return nil, fmt.Errorf("error importing Role Binding %q: %s", d.Id(), diagnostics[0].Summary)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Role Binding %q", d.Id()), map[string]interface{}{roleBindingLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+// extractRoleBindingImportId accepts the raw ID of a Role Binding, for example, rb-abc123, as printed by the
+// confluent_role_bindings data source or the Confluent CLI, tolerating surrounding whitespace from copy-pasting
+func extractRoleBindingImportId(importId string) (string, error) {
+	roleBindingId := strings.TrimSpace(importId)
+	if !roleBindingIdRegExp.MatchString(roleBindingId) {
+		return "", fmt.Errorf("invalid format: expected the ID of the Role Binding, for example, 'rb-abc123', got %q", importId)
+	}
+	return roleBindingId, nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -62,6 +63,9 @@ const (
 	paramRecreateOnUpdateDefaultValue         = false
 	paramSkipValidationDuringPlan             = "skip_validation_during_plan"
 	paramSkipValidationDuringPlanDefaultValue = false
+	paramNormalize                            = "normalize"
+	paramNormalizeDefaultValue                = false
+	paramLatest                               = "latest"
 
 	latestSchemaVersionAndPlaceholderForSchemaIdentifier = "latest"
 )
@@ -108,11 +112,12 @@ func schemaResource() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(acceptedSchemaFormats, false),
 			},
 			paramSchema: {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The definition of the Schema.",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				Description:      "The definition of the Schema.",
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressNormalizedSchemaDiff,
 			},
 			paramVersion: {
 				Type:        schema.TypeInt,
@@ -171,6 +176,12 @@ func schemaResource() *schema.Resource {
 				Default:     paramSkipValidationDuringPlanDefaultValue,
 				Description: "Controls whether a schema validation should be skipped during terraform plan.",
 			},
+			paramNormalize: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramNormalizeDefaultValue,
+				Description: "Controls whether the schema should be normalized by Schema Registry when it's registered, and whether formatting-only differences of AVRO and JSON schemas should be ignored.",
+			},
 		},
 		CustomizeDiff: customdiff.Sequence(SetSchemaDiff),
 	}
@@ -304,6 +315,16 @@ func metadataSchema() *schema.Schema {
 	}
 }
 
+// suppressNormalizedSchemaDiff ignores differences in whitespace and in the order of object keys between the
+// configured and the registered AVRO or JSON schema when normalize is set. PROTOBUF schemas are compared as is,
+// semantically equivalent PROTOBUF schemas are handled by the schema lookup in SetSchemaDiff instead.
+func suppressNormalizedSchemaDiff(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get(paramNormalize).(bool) || d.Get(paramFormat).(string) == protobufFormat {
+		return false
+	}
+	return structure.SuppressJsonDiff(k, old, new, d)
+}
+
 func SetSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if !diff.HasChange(paramSchema) {
 		return nil
@@ -503,26 +524,10 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	createSchemaRequest.SetSchema(schemaContent)
 	createSchemaRequest.SetReferences(schemaReferences)
 	if tfRuleset := d.Get(paramRuleset).([]interface{}); len(tfRuleset) == 1 {
-		ruleset := sr.NewRuleSet()
-		tfRulesetMap := tfRuleset[0].(map[string]interface{})
-		if tfRulesetMap[paramDomainRules] != nil {
-			ruleset.SetDomainRules(buildRules(tfRulesetMap[paramDomainRules].(*schema.Set).List()))
-		}
-		createSchemaRequest.SetRuleSet(*ruleset)
+		createSchemaRequest.SetRuleSet(buildRuleSet(tfRuleset[0].(map[string]interface{})))
 	}
 	if tfMetadata := d.Get(paramMetadata).([]interface{}); len(tfMetadata) == 1 {
-		metadata := sr.NewMetadata()
-		tfMetadataMap := tfMetadata[0].(map[string]interface{})
-		if tfMetadataMap[paramTags] != nil {
-			metadata.SetTags(convertToStringStringListMap(tfMetadataMap[paramTags].(*schema.Set).List()))
-		}
-		if tfMetadataMap[paramProperties] != nil {
-			metadata.SetProperties(convertToStringStringMap(tfMetadataMap[paramProperties].(map[string]interface{})))
-		}
-		if tfMetadataMap[paramSensitive] != nil {
-			metadata.SetSensitive(convertToStringSlice(tfMetadataMap[paramSensitive].(*schema.Set).List()))
-		}
-		createSchemaRequest.SetMetadata(*metadata)
+		createSchemaRequest.SetMetadata(buildMetadata(tfMetadata[0].(map[string]interface{})))
 	}
 	createSchemaRequestJson, err := json.Marshal(createSchemaRequest)
 	if err != nil {
@@ -551,7 +556,7 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating new Schema: %s", createSchemaRequestJson))
 
-	registeredSchema, _, err := executeSchemaCreate(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName)
+	registeredSchema, _, err := executeSchemaCreate(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName, d.Get(paramNormalize).(bool))
 
 	if err != nil {
 		return diag.Errorf("error creating Schema: %s", createDescriptiveError(err))
@@ -616,6 +621,16 @@ func schemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished %s deleting Schema %q", deletionType, d.Id()), map[string]interface{}{schemaLoggingKey: d.Id()})
 
+	if isHardDeleteEnabled {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Schema %q has been hard deleted", d.Id()),
+				Detail:   fmt.Sprintf("Version %d of Subject %q was permanently deleted and can't be recovered. Set %s = false to soft delete schemas instead.", schemaVersion, subjectName, paramHardDelete),
+			},
+		}
+	}
+
 	return nil
 }
 
@@ -656,18 +671,21 @@ func schemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramSkipValidationDuringPlan) {
-		return diag.Errorf("error updating Schema %q: only %q, %q, %q, %q, %q and %q blocks can be updated for Schema", d.Id(), paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramSkipValidationDuringPlan)
+	if d.HasChangesExcept(paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramRuleset, paramMetadata, paramSkipValidationDuringPlan, paramNormalize) {
+		return diag.Errorf("error updating Schema %q: only %q, %q, %q, %q, %q, %q, %q, %q and %q blocks can be updated for Schema", d.Id(), paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramRuleset, paramMetadata, paramSkipValidationDuringPlan, paramNormalize)
 	}
 
-	if d.HasChanges(paramSchema, paramSchemaReference) {
+	// Rule sets and metadata are attached to a schema version, so updating them registers a new version too.
+	if d.HasChanges(paramSchema, paramSchemaReference, paramRuleset, paramMetadata) {
 		oldSchema, _ := d.GetChange(paramSchema)
 		oldSchemaReference, _ := d.GetChange(paramSchemaReference)
+		oldRuleset, _ := d.GetChange(paramRuleset)
+		oldMetadata, _ := d.GetChange(paramMetadata)
 
 		// User wants to edit / evolve a schema. See https://docs.confluent.io/cloud/current/sr/schemas-manage.html#editing-schemas for more details.
 		shouldRecreateOnUpdate := d.Get(paramRecreateOnUpdate).(bool)
 		if shouldRecreateOnUpdate {
-			// At this point new schema and schema_reference is saved to TF file,
+			// At this point new schema, schema_reference, ruleset and metadata are saved to TF file,
 			// so we need to revert it to the old value to avoid TF drift.
 			if err := d.Set(paramSchema, oldSchema); err != nil {
 				return diag.FromErr(createDescriptiveError(err))
@@ -675,6 +693,12 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			if err := d.Set(paramSchemaReference, oldSchemaReference); err != nil {
 				return diag.FromErr(createDescriptiveError(err))
 			}
+			if err := d.Set(paramRuleset, oldRuleset); err != nil {
+				return diag.FromErr(createDescriptiveError(err))
+			}
+			if err := d.Set(paramMetadata, oldMetadata); err != nil {
+				return diag.FromErr(createDescriptiveError(err))
+			}
 			return diag.Errorf("error updating Schema %q: reimport the current resource instance and set %s = false to evolve a schema using the same resource instance.\nIn this case, on an update resource instance will reference the updated (latest) schema by overriding %s, %s and %s attributes and the old schema will be orphaned.", d.Id(), paramRecreateOnUpdate, paramSchemaIdentifier, paramSchema, paramVersion)
 		}
 		// Create a new schema and make existing resource instance point to it.
@@ -759,12 +783,12 @@ func schemaImport(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func loadIdForLatestSchema(ctx context.Context, d *schema.ResourceData, c *SchemaRegistryRestClient, subjectName string) (string, error) {
-	latestSchema, _, err := c.apiClient.SubjectsV1Api.GetSchemaByVersion(c.apiContext(ctx), subjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier).Execute()
+func loadIdForLatestSchema(ctx context.Context, d *schema.ResourceData, c *SchemaRegistryRestClient, subjectName string) (string, *http.Response, error) {
+	latestSchema, resp, err := c.apiClient.SubjectsV1Api.GetSchemaByVersion(c.apiContext(ctx), subjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier).Execute()
 	if err != nil {
-		return "", fmt.Errorf("error loading the latest Schema: %s", createDescriptiveError(err))
+		return "", resp, fmt.Errorf("error loading the latest Schema: %s", createDescriptiveError(err))
 	}
-	return strconv.Itoa(int(latestSchema.GetId())), nil
+	return strconv.Itoa(int(latestSchema.GetId())), resp, nil
 }
 
 func isLatestSchema(schemaIdentifier string) bool {
@@ -775,8 +799,15 @@ func loadSchema(ctx context.Context, d *schema.ResourceData, c *SchemaRegistryRe
 	// Option #1: find the schema identifier of the latest schema
 	var err error
 	if isLatestSchema(schemaIdentifier) {
-		schemaIdentifier, err = loadIdForLatestSchema(ctx, d, c, subjectName)
+		var resp *http.Response
+		schemaIdentifier, resp, err = loadIdForLatestSchema(ctx, d, c, subjectName)
 		if err != nil {
+			// Schema Registry returns 404 for subjects whose versions have all been soft deleted,
+			// so treat them as absent to let Terraform recreate the schema instead of failing.
+			if isNonKafkaRestApiResourceNotFound(resp) {
+				tflog.Debug(ctx, fmt.Sprintf("Subject %q could not be found on the server: it either doesn't exist or all of its versions have been deleted", subjectName), map[string]interface{}{schemaLoggingKey: d.Id()})
+				return &sr.Schema{}, false, nil
+			}
 			return nil, false, fmt.Errorf("error loading the latest Schema: %s", createDescriptiveError(err))
 		}
 	}
@@ -857,8 +888,8 @@ func readSchemaRegistryConfigAndSetAttributes(ctx context.Context, d *schema.Res
 	}
 
 	if ruleSet, ok := srSchema.GetRuleSetOk(); ok {
-		if len(ruleSet.GetDomainRules()) > 0 {
-			if err := d.Set(paramRuleset, buildTfRules(ruleSet.GetDomainRules())); err != nil {
+		if len(ruleSet.GetDomainRules()) > 0 || len(ruleSet.GetMigrationRules()) > 0 {
+			if err := d.Set(paramRuleset, buildTfRuleSet(*ruleSet)); err != nil {
 				return nil, err
 			}
 		}
@@ -895,6 +926,13 @@ func readSchemaRegistryConfigAndSetAttributes(ctx context.Context, d *schema.Res
 		}
 	}
 
+	// Explicitly set paramNormalize to the default value if unset
+	if _, ok := d.GetOk(paramNormalize); !ok {
+		if err := d.Set(paramNormalize, paramNormalizeDefaultValue); err != nil {
+			return nil, createDescriptiveError(err)
+		}
+	}
+
 	d.SetId(createSchemaId(c.clusterId, srSchema.GetSubject(), srSchema.GetId(), d.Get(paramRecreateOnUpdate).(bool)))
 	return srSchema, nil
 }
@@ -917,8 +955,8 @@ func executeSchemaLookup(ctx context.Context, c *SchemaRegistryRestClient, reque
 	return c.apiClient.SubjectsV1Api.LookUpSchemaUnderSubject(c.apiContext(ctx), subjectName).RegisterSchemaRequest(*requestData).Normalize(shouldNormalize).Execute()
 }
 
-func executeSchemaCreate(ctx context.Context, c *SchemaRegistryRestClient, requestData *sr.RegisterSchemaRequest, subjectName string) (sr.RegisterSchemaResponse, *http.Response, error) {
-	return c.apiClient.SubjectsV1Api.Register(c.apiContext(ctx), subjectName).RegisterSchemaRequest(*requestData).Execute()
+func executeSchemaCreate(ctx context.Context, c *SchemaRegistryRestClient, requestData *sr.RegisterSchemaRequest, subjectName string, shouldNormalize bool) (sr.RegisterSchemaResponse, *http.Response, error) {
+	return c.apiClient.SubjectsV1Api.Register(c.apiContext(ctx), subjectName).RegisterSchemaRequest(*requestData).Normalize(shouldNormalize).Execute()
 }
 
 func executeSchemaDelete(ctx context.Context, c *SchemaRegistryRestClient, subjectName, schemaVersion string, isHardDelete bool) error {
@@ -1072,11 +1110,15 @@ func loadAllSchemas(ctx context.Context, client *Client) (InstanceIdsToNameMap,
 
 	for _, subjectName := range subjects {
 		// using schemaSr as schema collides with the package name
-		schemaSr, _, err := loadSchema(schemaRegistryRestClient.apiContext(ctx), &schema.ResourceData{}, schemaRegistryRestClient, subjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier)
+		schemaSr, exists, err := loadSchema(schemaRegistryRestClient.apiContext(ctx), &schema.ResourceData{}, schemaRegistryRestClient, subjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier)
 		if err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("Error reading the latest Schema for Subject %q: %s", schemaSr.GetSubject(), createDescriptiveError(err)), map[string]interface{}{schemaRegistryClusterLoggingKey: schemaRegistryRestClient.clusterId})
 			return nil, diag.FromErr(createDescriptiveError(err))
 		}
+		// Skip subjects that have been (soft) deleted since they were listed
+		if !exists {
+			continue
+		}
 		schemaJson, err := json.Marshal(schemaSr)
 		if err != nil {
 			return nil, diag.Errorf("error reading the latest Schema for Subject %q: error marshaling %#v to json: %s", schemaSr.GetSubject(), schemaSr, createDescriptiveError(err))
@@ -1130,7 +1172,32 @@ func buildRules(tfRules []interface{}) []sr.Rule {
 	return rules
 }
 
-func buildTfRules(rules []sr.Rule) *[]map[string]interface{} {
+func buildRuleSet(tfRulesetMap map[string]interface{}) sr.RuleSet {
+	ruleset := sr.NewRuleSet()
+	if tfRulesetMap[paramDomainRules] != nil {
+		ruleset.SetDomainRules(buildRules(tfRulesetMap[paramDomainRules].(*schema.Set).List()))
+	}
+	if tfRulesetMap[paramMigrationRules] != nil {
+		ruleset.SetMigrationRules(buildRules(tfRulesetMap[paramMigrationRules].(*schema.Set).List()))
+	}
+	return *ruleset
+}
+
+func buildMetadata(tfMetadataMap map[string]interface{}) sr.Metadata {
+	metadata := sr.NewMetadata()
+	if tfMetadataMap[paramTags] != nil {
+		metadata.SetTags(convertToStringStringListMap(tfMetadataMap[paramTags].(*schema.Set).List()))
+	}
+	if tfMetadataMap[paramProperties] != nil {
+		metadata.SetProperties(convertToStringStringMap(tfMetadataMap[paramProperties].(map[string]interface{})))
+	}
+	if tfMetadataMap[paramSensitive] != nil {
+		metadata.SetSensitive(convertToStringSlice(tfMetadataMap[paramSensitive].(*schema.Set).List()))
+	}
+	return *metadata
+}
+
+func buildTfRules(rules []sr.Rule) []map[string]interface{} {
 	tfRules := make([]map[string]interface{}, len(rules))
 	for i, rule := range rules {
 		tfRule := make(map[string]interface{})
@@ -1146,11 +1213,15 @@ func buildTfRules(rules []sr.Rule) *[]map[string]interface{} {
 		tfRule[paramParams] = rule.GetParams()
 		tfRules[i] = tfRule
 	}
-	tfDomainRules := make(map[string]interface{})
-	tfDomainRules[paramDomainRules] = tfRules
+	return tfRules
+}
 
+func buildTfRuleSet(ruleSet sr.RuleSet) *[]map[string]interface{} {
 	tfRuleSet := make([]map[string]interface{}, 1)
-	tfRuleSet[0] = tfDomainRules
+	tfRuleSet[0] = map[string]interface{}{
+		paramDomainRules:    buildTfRules(ruleSet.GetDomainRules()),
+		paramMigrationRules: buildTfRules(ruleSet.GetMigrationRules()),
+	}
 	return &tfRuleSet
 }
 
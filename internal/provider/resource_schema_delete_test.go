@@ -0,0 +1,196 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	scenarioStateSchemaHasBeenSoftDeleted = "The schema has been soft deleted"
+)
+
+func TestAccSchemaSoftDelete(t *testing.T) {
+	testAccSchemaDelete(t, false)
+}
+
+func TestAccSchemaHardDelete(t *testing.T) {
+	testAccSchemaDelete(t, true)
+}
+
+func testAccSchemaDelete(t *testing.T, isHardDelete bool) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	validateSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/validate_schema.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(validateSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(validateSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	createSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/create_schema.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(createSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateSchemaHasBeenCreated).
+		WillReturn(
+			string(createSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedSchemasResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_schemas.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenCreated).
+		WillReturn(
+			string(readCreatedSchemasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// Both soft and hard delete start with a soft delete
+	stateAfterSoftDelete := scenarioStateSchemaHasBeenDeleted
+	if isHardDelete {
+		stateAfterSoftDelete = scenarioStateSchemaHasBeenSoftDeleted
+	}
+	softDeleteSchemaStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteSchemaPath)).
+		WithQueryParam("permanent", wiremock.EqualTo("false")).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenCreated).
+		WillSetStateTo(stateAfterSoftDelete).
+		WillReturn(
+			strconv.Itoa(testSchemaVersion),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(softDeleteSchemaStub)
+
+	hardDeleteSchemaStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteSchemaPath)).
+		WithQueryParam("permanent", wiremock.EqualTo("true")).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenSoftDeleted).
+		WillSetStateTo(scenarioStateSchemaHasBeenDeleted).
+		WillReturn(
+			strconv.Itoa(testSchemaVersion),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(hardDeleteSchemaStub)
+
+	readDeletedSchemasResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_schemas_after_delete.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenDeleted).
+		WillReturn(
+			string(readDeletedSchemasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckSchemaDestroy(s, mockSchemaTestServerUrl)
+		},
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSchemaWithHardDeleteConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl, isHardDelete),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(fullSchemaResourceLabel),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "id", fmt.Sprintf("%s/%s/%d", testStreamGovernanceClusterId, testSubjectName, testSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "hard_delete", strconv.FormatBool(isHardDelete)),
+				),
+			},
+		},
+	})
+
+	expectedHardDeleteCount := expectedCountZero
+	if isHardDelete {
+		expectedHardDeleteCount = expectedCountOne
+	}
+	checkStubCount(t, wiremockClient, softDeleteSchemaStub, fmt.Sprintf("DELETE %s?permanent=false", deleteSchemaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, hardDeleteSchemaStub, fmt.Sprintf("DELETE %s?permanent=true", deleteSchemaPath), expectedHardDeleteCount)
+}
+
+func testAccCheckSchemaWithHardDeleteConfig(confluentCloudBaseUrl, mockServerUrl string, isHardDelete bool) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_schema" "%s" {
+	  schema_registry_cluster {
+        id = "%s"
+      }
+      rest_endpoint = "%s"
+      credentials {
+        key = "%s"
+        secret = "%s"
+	  }
+
+	  subject_name = "%s"
+	  format = "%s"
+      schema = "%s"
+
+      hard_delete = %t
+      recreate_on_update = true
+      skip_validation_during_plan = true
+
+      schema_reference {
+        name = "%s"
+        subject_name = "%s"
+        version = %d
+      }
+
+      schema_reference {
+        name = "%s"
+        subject_name = "%s"
+        version = %d
+      }
+	}
+	`, confluentCloudBaseUrl, testSchemaResourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret,
+		testSubjectName, testFormat, testSchemaContent, isHardDelete,
+		testFirstSchemaReferenceDisplayName, testFirstSchemaReferenceSubject, testFirstSchemaReferenceVersion,
+		testSecondSchemaReferenceDisplayName, testSecondSchemaReferenceSubject, testSecondSchemaReferenceVersion)
+}
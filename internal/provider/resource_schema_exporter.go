@@ -211,7 +211,7 @@ func schemaExporterCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("error creating Schema Exporter: %s", createDescriptiveError(err))
 	}
 
-	if err := waitForSchemaExporterToProvision(c.apiContext(ctx), c, exporterId, name); err != nil {
+	if err := waitForSchemaExporterToProvision(ctx, c, exporterId, name); err != nil {
 		return diag.Errorf("error waiting for Schema Exporter %q to provision: %s", exporterId, createDescriptiveError(err))
 	}
 
@@ -401,11 +401,11 @@ func resumeExporter(ctx context.Context, d *schema.ResourceData, c *SchemaRegist
 	if isRunning {
 		// resume the exporter last after making any changes
 		_, resp, err := c.apiClient.ExportersV1Api.ResumeExporterByName(c.apiContext(ctx), name).Execute()
-		if err != nil && resp.StatusCode != http.StatusConflict {
+		if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusConflict) {
 			return diag.Errorf("error resuming Schema Exporter (Failed to resume the exporter): %s", createDescriptiveError(err))
 		}
 
-		if err := waitForSchemaExporterToProvision(c.apiContext(ctx), c, id, name); err != nil {
+		if err := waitForSchemaExporterToProvision(ctx, c, id, name); err != nil {
 			return diag.Errorf("error waiting for Schema Exporter %q to updating: %s", id, createDescriptiveError(err))
 		}
 		status, _, err := c.apiClient.ExportersV1Api.GetExporterStatusByName(c.apiContext(ctx), name).Execute()
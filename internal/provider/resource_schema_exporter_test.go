@@ -29,6 +29,8 @@ const (
 	schemaExporterResourceScenarioName        = "confluent_schema_exporter Resource Lifecycle"
 	scenarioStateSchemaExporterHasBeenCreated = "A new schema exporter has been just created"
 	scenarioStateSchemaExporterHasBeenUpdated = "A new schema exporter has been just updated"
+	scenarioStateSchemaExporterHasBeenPaused  = "The schema exporter has been paused"
+	scenarioStateSchemaExporterHasBeenResumed = "The schema exporter has been resumed"
 	createSchemaExporterUrlPath               = "/exporters"
 	readCreatedSchemaExporterUrlPath          = "/exporters/exporter1"
 	readCreatedSchemaExporterStatusUrlPath    = "/exporters/exporter1/status"
@@ -288,3 +290,174 @@ func schemaExporterResourceUpdatedConfig(mockServerUrl string) string {
  	`, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret,
 		testDestinationSchemaRegistryRestEndpoint, testDestinationSchemaRegistryKey, testDestinationSchemaRegistrySecret)
 }
+
+func TestAccSchemaExporterPauseResume(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	generalResponse, _ := ioutil.ReadFile("../testdata/schema_exporter/general_response.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(createSchemaExporterUrlPath)).
+		InScenario(schemaExporterResourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateSchemaExporterHasBeenCreated).
+		WillReturn(
+			string(generalResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	// The exporter settings don't change in this test, only its status does
+	createdExporter, _ := ioutil.ReadFile("../testdata/schema_exporter/created_exporter.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedSchemaExporterUrlPath)).
+		InScenario(schemaExporterResourceScenarioName).
+		WillReturn(
+			string(createdExporter),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	pauseSchemaExporterStub := wiremock.Put(wiremock.URLPathEqualTo(readCreatedSchemaExporterUrlPath+"/pause")).
+		InScenario(schemaExporterResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaExporterHasBeenCreated).
+		WillSetStateTo(scenarioStateSchemaExporterHasBeenPaused).
+		WillReturn(
+			string(generalResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(pauseSchemaExporterStub)
+
+	resumeSchemaExporterStub := wiremock.Put(wiremock.URLPathEqualTo(readCreatedSchemaExporterUrlPath+"/resume")).
+		InScenario(schemaExporterResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaExporterHasBeenPaused).
+		WillSetStateTo(scenarioStateSchemaExporterHasBeenResumed).
+		WillReturn(
+			string(generalResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(resumeSchemaExporterStub)
+
+	// An exporter is always paused before it is deleted
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo(readCreatedSchemaExporterUrlPath+"/pause")).
+		InScenario(schemaExporterResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaExporterHasBeenResumed).
+		WillReturn(
+			string(generalResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	runningStatusResponse, _ := ioutil.ReadFile("../testdata/schema_exporter/running_status.json")
+	for _, state := range []string{scenarioStateSchemaExporterHasBeenCreated, scenarioStateSchemaExporterHasBeenResumed} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedSchemaExporterStatusUrlPath)).
+			InScenario(schemaExporterResourceScenarioName).
+			WhenScenarioStateIs(state).
+			WillReturn(
+				string(runningStatusResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	pausedStatusResponse, _ := ioutil.ReadFile("../testdata/schema_exporter/pause_status.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedSchemaExporterStatusUrlPath)).
+		InScenario(schemaExporterResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaExporterHasBeenPaused).
+		WillReturn(
+			string(pausedStatusResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteSchemaExporterStub := wiremock.Delete(wiremock.URLPathEqualTo(readCreatedSchemaExporterUrlPath)).
+		InScenario(schemaExporterResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaExporterHasBeenResumed).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteSchemaExporterStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: schemaExporterResourceWithStatusConfig(mockServerUrl, stateRunning),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaExporterLabel, "name", "exporter1"),
+					resource.TestCheckResourceAttr(schemaExporterLabel, "status", stateRunning),
+				),
+			},
+			{
+				Config: schemaExporterResourceWithStatusConfig(mockServerUrl, statePaused),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaExporterLabel, "name", "exporter1"),
+					resource.TestCheckResourceAttr(schemaExporterLabel, "context", "tc"),
+					resource.TestCheckResourceAttr(schemaExporterLabel, "status", statePaused),
+				),
+			},
+			{
+				Config: schemaExporterResourceWithStatusConfig(mockServerUrl, stateRunning),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaExporterLabel, "name", "exporter1"),
+					resource.TestCheckResourceAttr(schemaExporterLabel, "context", "tc"),
+					resource.TestCheckResourceAttr(schemaExporterLabel, "status", stateRunning),
+				),
+			},
+		},
+	})
+
+	// One pause for the status update and another one before deleting the exporter
+	checkStubCount(t, wiremockClient, pauseSchemaExporterStub, fmt.Sprintf("PUT %s/pause", readCreatedSchemaExporterUrlPath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, resumeSchemaExporterStub, fmt.Sprintf("PUT %s/resume", readCreatedSchemaExporterUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteSchemaExporterStub, fmt.Sprintf("DELETE %s", readCreatedSchemaExporterUrlPath), expectedCountOne)
+}
+
+func schemaExporterResourceWithStatusConfig(mockServerUrl, status string) string {
+	return fmt.Sprintf(`
+ 	provider "confluent" {}
+ 	resource "confluent_schema_exporter" "main" {
+        schema_registry_cluster {
+		  id = "%s"
+		}
+		rest_endpoint = "%s"
+		credentials {
+		  key    = "%s"
+		  secret = "%s"
+        }
+		name = "exporter1"
+		context = "tc"
+		context_type = "CUSTOM"
+		subjects = ["foo"]
+
+		status = "%s"
+
+		destination_schema_registry_cluster {
+		  rest_endpoint = "%s"
+		  credentials {
+			key    = "%s"
+			secret = "%s"
+		  }
+		}
+	}
+ 	`, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, status,
+		testOriginalDestinationSchemaRegistryRestEndpoint, testDestinationSchemaRegistryKey, testDestinationSchemaRegistrySecret)
+}
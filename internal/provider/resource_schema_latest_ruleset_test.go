@@ -0,0 +1,264 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	scenarioStateSchemaRuleSetHasBeenUpdated = "The schema rule set has been updated"
+
+	testMigrationRuleExpr        = "$.size"
+	testMigrationRuleExprUpdated = "$.height"
+	testMetadataOwner            = "Bob Jones"
+	testMetadataOwnerUpdated     = "Alice Smith"
+)
+
+func TestAccLatestSchemaWithRuleSet(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+	validateSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/validate_schema.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(validateSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(validateSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	createSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/create_schema.json")
+	createSchemaStub := wiremock.Post(wiremock.URLPathEqualTo(createSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"expr":"%s"`, testMigrationRuleExpr))).
+		WillSetStateTo(scenarioStateSchemaHasBeenCreated).
+		WillReturn(
+			string(createSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(createSchemaStub)
+
+	readLatestSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_latest_schema.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readLatestSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenCreated).
+		WillReturn(
+			string(readLatestSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readCreatedSchemasResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_schemas_with_ruleset.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenCreated).
+		WillReturn(
+			string(readCreatedSchemasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(validateSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenCreated).
+		WillReturn(
+			string(validateSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// Updating a rule set registers a new schema version with the same schema content
+	updateSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/create_updated_schema.json")
+	updateSchemaStub := wiremock.Post(wiremock.URLPathEqualTo(createSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenCreated).
+		WithBodyPattern(wiremock.Contains(fmt.Sprintf(`"expr":"%s"`, testMigrationRuleExprUpdated))).
+		WillSetStateTo(scenarioStateSchemaRuleSetHasBeenUpdated).
+		WillReturn(
+			string(updateSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateSchemaStub)
+
+	readLatestUpdatedSchemaResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_latest_schema_with_ruleset_updated.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readLatestSchemaPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRuleSetHasBeenUpdated).
+		WillReturn(
+			string(readLatestUpdatedSchemaResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedSchemasResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_schemas_with_ruleset_updated.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRuleSetHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedSchemasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteSchemaStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteSchemaPathUpdated)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRuleSetHasBeenUpdated).
+		WillSetStateTo(scenarioStateSchemaHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteSchemaStub)
+
+	readDeletedSchemasResponse, _ := os.ReadFile("../testdata/schema_registry_schema/read_schemas_after_delete.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).
+		InScenario(schemaScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaHasBeenDeleted).
+		WillReturn(
+			string(readDeletedSchemasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckSchemaDestroy(s, mockSchemaTestServerUrl)
+		},
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLatestSchemaWithRuleSetConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl, testMigrationRuleExpr, testMetadataOwner),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(fullSchemaResourceLabel),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "id", fmt.Sprintf("%s/%s/%s", testStreamGovernanceClusterId, testSubjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "version", strconv.Itoa(testSchemaVersion)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "schema_identifier", strconv.Itoa(testSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.#", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.#", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.0.name", "checkSsnLen"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.0.kind", "CONDITION"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.0.type", "CEL"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.0.mode", "WRITE"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.0.expr", "size(message.ssn) == 9"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.#", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.0.name", "upgradeSize"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.0.kind", "TRANSFORM"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.0.type", "JSONATA"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.0.mode", "UPGRADE"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.0.expr", testMigrationRuleExpr),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "metadata.#", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "metadata.0.properties.%", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "metadata.0.properties.owner", testMetadataOwner),
+				),
+			},
+			{
+				Config: testAccCheckLatestSchemaWithRuleSetConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl, testMigrationRuleExprUpdated, testMetadataOwnerUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(fullSchemaResourceLabel),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "id", fmt.Sprintf("%s/%s/%s", testStreamGovernanceClusterId, testSubjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "schema", testSchemaContent),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "version", strconv.Itoa(testSchemaVersion+1)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "schema_identifier", strconv.Itoa(testSchemaIdentifier+1)),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.#", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.domain_rules.0.name", "checkSsnLen"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.#", "1"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "ruleset.0.migration_rules.0.expr", testMigrationRuleExprUpdated),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "metadata.0.properties.owner", testMetadataOwnerUpdated),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createSchemaStub, fmt.Sprintf("POST %s", createSchemaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateSchemaStub, fmt.Sprintf("POST %s", createSchemaPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteSchemaStub, fmt.Sprintf("DELETE %s", deleteSchemaPathUpdated), expectedCountOne)
+}
+
+func testAccCheckLatestSchemaWithRuleSetConfig(confluentCloudBaseUrl, mockServerUrl, migrationRuleExpr, owner string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_schema" "%s" {
+	  schema_registry_cluster {
+        id = "%s"
+      }
+      rest_endpoint = "%s"
+      credentials {
+        key = "%s"
+        secret = "%s"
+	  }
+
+	  subject_name = "%s"
+	  format = "%s"
+      schema = "%s"
+
+	  metadata {
+		properties = {
+		  "owner": "%s"
+		}
+	  }
+
+	  ruleset {
+		domain_rules {
+		  name = "checkSsnLen"
+		  kind = "CONDITION"
+		  type = "CEL"
+		  mode = "WRITE"
+		  expr = "size(message.ssn) == 9"
+		}
+		migration_rules {
+		  name = "upgradeSize"
+		  kind = "TRANSFORM"
+		  type = "JSONATA"
+		  mode = "UPGRADE"
+		  expr = "%s"
+		}
+	  }
+	}
+	`, confluentCloudBaseUrl, testSchemaResourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret,
+		testSubjectName, testFormat, testSchemaContent, owner, migrationRuleExpr)
+}
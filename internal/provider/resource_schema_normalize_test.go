@@ -0,0 +1,157 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sr "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	// The schema as it's returned by Schema Registry
+	registeredNormalizedSchema = `{"type":"record","name":"User","namespace":"io.confluent","fields":[{"name":"id","type":"string"},{"name":"address","type":"io.confluent.Address"}]}`
+	// The same schema as it's formatted in the TF config
+	configuredFormattedSchema = `{
+  "type": "record",
+  "namespace": "io.confluent",
+  "name": "User",
+  "fields": [
+    { "name": "id", "type": "string" },
+    { "name": "address", "type": "io.confluent.Address" }
+  ]
+}
+`
+)
+
+func TestSchemaNormalizeDiffSuppress(t *testing.T) {
+	tests := []struct {
+		name               string
+		format             string
+		normalize          bool
+		configuredSchema   string
+		expectedSchemaDiff bool
+	}{
+		{"whitespace only with normalize", avroFormat, true, configuredFormattedSchema, false},
+		{"whitespace only with normalize for JSON", jsonFormat, true, configuredFormattedSchema, false},
+		{"whitespace only without normalize", avroFormat, false, configuredFormattedSchema, true},
+		{"semantic change with normalize", avroFormat, true, strings.Replace(configuredFormattedSchema, `"string"`, `"int"`, 1), true},
+		{"PROTOBUF with normalize", protobufFormat, true, "syntax = \"proto3\";\n\nmessage User {}\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registeredSchema := registeredNormalizedSchema
+			if tt.format == protobufFormat {
+				registeredSchema = "syntax = \"proto3\";\nmessage User {}\n"
+			}
+			reference := map[string]interface{}{
+				paramName:        "io.confluent.Address",
+				paramSubjectName: "address-value",
+				paramVersion:     1,
+			}
+			referenceHash := schema.HashResource(schemaResource().Schema[paramSchemaReference].Elem.(*schema.Resource))(reference)
+			state := &terraform.InstanceState{
+				ID: "lsrc-abc123/test2/latest",
+				Attributes: map[string]string{
+					paramSubjectName:              "test2",
+					paramFormat:                   tt.format,
+					paramSchema:                   registeredSchema,
+					paramNormalize:                fmt.Sprintf("%t", tt.normalize),
+					paramHardDelete:               "false",
+					paramRecreateOnUpdate:         "false",
+					paramSkipValidationDuringPlan: "false",
+					fmt.Sprintf("%s.#", paramSchemaReference):                                      "1",
+					fmt.Sprintf("%s.%d.%s", paramSchemaReference, referenceHash, paramName):        "io.confluent.Address",
+					fmt.Sprintf("%s.%d.%s", paramSchemaReference, referenceHash, paramSubjectName): "address-value",
+					fmt.Sprintf("%s.%d.%s", paramSchemaReference, referenceHash, paramVersion):     "1",
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				paramSubjectName:     "test2",
+				paramFormat:          tt.format,
+				paramSchema:          tt.configuredSchema,
+				paramNormalize:       tt.normalize,
+				paramSchemaReference: []interface{}{reference},
+			})
+			diff, err := schemaResource().Diff(context.Background(), state, config, &Client{})
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			hasSchemaDiff := false
+			if diff != nil {
+				_, hasSchemaDiff = diff.Attributes[paramSchema]
+			}
+			if hasSchemaDiff != tt.expectedSchemaDiff {
+				t.Fatalf("expected a diff of %q to be %t, got %#v", paramSchema, tt.expectedSchemaDiff, diff)
+			}
+			if !tt.expectedSchemaDiff && diff != nil {
+				// Optional+Computed blocks that are omitted in the config are reported as computed by the legacy diff,
+				// Terraform keeps their prior state instead
+				for key, attributeDiff := range diff.Attributes {
+					if !attributeDiff.NewComputed {
+						t.Fatalf("expected a clean plan, got a diff of %q: %#v", key, attributeDiff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteSchemaCreateNormalize(t *testing.T) {
+	for _, shouldNormalize := range []bool{true, false} {
+		t.Run(fmt.Sprintf("normalize=%t", shouldNormalize), func(t *testing.T) {
+			var receivedNormalize string
+			var receivedRequest sr.RegisterSchemaRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				receivedNormalize = r.URL.Query().Get(paramNormalize)
+				body, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &receivedRequest)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprint(w, `{"id": 100001}`)
+			}))
+			defer server.Close()
+
+			c := SchemaRegistryRestClientFactory{ctx: context.Background(), userAgent: "test"}.CreateSchemaRegistryRestClient(server.URL, "lsrc-abc123", "key", "secret", false)
+			request := sr.NewRegisterSchemaRequest()
+			request.SetSchemaType(avroFormat)
+			request.SetSchema(configuredFormattedSchema)
+			request.SetReferences([]sr.SchemaReference{{Name: sr.PtrString("io.confluent.Address"), Subject: sr.PtrString("address-value"), Version: sr.PtrInt32(1)}})
+
+			registeredSchema, _, err := executeSchemaCreate(context.Background(), c, request, "test2", shouldNormalize)
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if registeredSchema.GetId() != 100001 {
+				t.Fatalf("expected schema ID 100001, got %d", registeredSchema.GetId())
+			}
+			if receivedNormalize != fmt.Sprintf("%t", shouldNormalize) {
+				t.Fatalf("expected normalize=%t query parameter, got %q", shouldNormalize, receivedNormalize)
+			}
+			if len(receivedRequest.GetReferences()) != 1 || receivedRequest.GetReferences()[0].GetSubject() != "address-value" {
+				t.Fatalf("expected the schema references to be sent as is, got %#v", receivedRequest.GetReferences())
+			}
+		})
+	}
+}
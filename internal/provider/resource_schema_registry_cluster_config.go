@@ -52,6 +52,12 @@ func schemaRegistryClusterConfigResource() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptedCompatibilityLevels, false),
 			},
+			paramCompatibilityGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the metadata property that is used to group schema versions for compatibility checks, for example, `application.major.version`.",
+			},
 		},
 	}
 }
@@ -71,11 +77,16 @@ func schemaRegistryClusterConfigCreate(ctx context.Context, d *schema.ResourceDa
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet)
 
-	if _, ok := d.GetOk(paramCompatibilityLevel); ok {
-		compatibilityLevel := d.Get(paramCompatibilityLevel).(string)
-
+	compatibilityLevel, isCompatibilityLevelSet := d.GetOk(paramCompatibilityLevel)
+	compatibilityGroup, isCompatibilityGroupSet := d.GetOk(paramCompatibilityGroup)
+	if isCompatibilityLevelSet || isCompatibilityGroupSet {
 		createConfigRequest := sr.NewConfigUpdateRequest()
-		createConfigRequest.SetCompatibility(compatibilityLevel)
+		if isCompatibilityLevelSet {
+			createConfigRequest.SetCompatibility(compatibilityLevel.(string))
+		}
+		if isCompatibilityGroupSet {
+			createConfigRequest.SetCompatibilityGroup(compatibilityGroup.(string))
+		}
 		createModeRequestJson, err := json.Marshal(createConfigRequest)
 		if err != nil {
 			return diag.Errorf("error creating Schema Registry Cluster Config: error marshaling %#v to json: %s", createConfigRequest, createDescriptiveError(err))
@@ -102,6 +113,29 @@ func schemaRegistryClusterConfigCreate(ctx context.Context, d *schema.ResourceDa
 func schemaRegistryClusterConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Schema Registry Cluster Config %q", d.Id()), map[string]interface{}{schemaRegistryClusterConfigLoggingKey: d.Id()})
 
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Registry Cluster Config: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Registry Cluster Config: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Registry Cluster Config: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet)
+
+	// The top-level config can't be removed, so revert the compatibility level to the Schema Registry default instead.
+	resetConfigRequest := sr.NewConfigUpdateRequest()
+	resetConfigRequest.SetCompatibility(compatibilityLevelBackward)
+	_, _, err = executeSchemaRegistryClusterConfigUpdate(ctx, schemaRegistryRestClient, resetConfigRequest)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Registry Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	SleepIfNotTestMode(schemaRegistryAPIWaitAfterCreateOrDelete, meta.(*Client).isAcceptanceTestMode)
 	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Schema Registry Cluster Config %q", d.Id()), map[string]interface{}{schemaRegistryClusterConfigLoggingKey: d.Id()})
 
 	return nil
@@ -186,6 +220,9 @@ func readSchemaRegistryClusterConfigAndSetAttributes(ctx context.Context, d *sch
 	if err := d.Set(paramCompatibilityLevel, schemaRegistryClusterConfig.GetCompatibilityLevel()); err != nil {
 		return nil, err
 	}
+	if err := d.Set(paramCompatibilityGroup, schemaRegistryClusterConfig.GetCompatibilityGroup()); err != nil {
+		return nil, err
+	}
 
 	if !c.isMetadataSetInProviderBlock {
 		if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
@@ -205,13 +242,17 @@ func readSchemaRegistryClusterConfigAndSetAttributes(ctx context.Context, d *sch
 }
 
 func schemaRegistryClusterConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramCompatibilityLevel) {
-		return diag.Errorf("error updating Schema Registry Cluster Config %q: only %q and %q blocks can be updated for Schema Registry Cluster Config", d.Id(), paramCredentials, paramCompatibilityLevel)
+	if d.HasChangesExcept(paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup) {
+		return diag.Errorf("error updating Schema Registry Cluster Config %q: only %q block, %q and %q attributes can be updated for Schema Registry Cluster Config", d.Id(), paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup)
 	}
-	if d.HasChange(paramCompatibilityLevel) {
-		updatedCompatibilityLevel := d.Get(paramCompatibilityLevel).(string)
+	if d.HasChanges(paramCompatibilityLevel, paramCompatibilityGroup) {
 		updateConfigRequest := sr.NewConfigUpdateRequest()
-		updateConfigRequest.SetCompatibility(updatedCompatibilityLevel)
+		if d.HasChange(paramCompatibilityLevel) {
+			updateConfigRequest.SetCompatibility(d.Get(paramCompatibilityLevel).(string))
+		}
+		if d.HasChange(paramCompatibilityGroup) {
+			updateConfigRequest.SetCompatibilityGroup(d.Get(paramCompatibilityGroup).(string))
+		}
 		restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
 		if err != nil {
 			return diag.Errorf("error updating Schema Registry Cluster Config: %s", createDescriptiveError(err))
@@ -35,8 +35,9 @@ const (
 	testSchemaRegistryClusterCompatibilityLevelResourceLabel = "test_subject_compatibility_level_resource_label"
 	testSchemaRegistryClusterCompatibilityLevel              = "FULL"
 	testUpdatedSchemaRegistryClusterCompatibilityLevel       = "BACKWARD_TRANSITIVE"
+	testSchemaRegistryClusterCompatibilityGroup              = "application.major.version"
 
-	testNumberOfSchemaRegistryClusterCompatibilityLevelResourceAttributes = "5"
+	testNumberOfSchemaRegistryClusterCompatibilityLevelResourceAttributes = "6"
 )
 
 var fullSchemaRegistryClusterCompatibilityLevelResourceLabel = fmt.Sprintf("confluent_schema_registry_cluster_config.%s", testSchemaRegistryClusterCompatibilityLevelResourceLabel)
@@ -113,6 +114,19 @@ func TestAccSchemaRegistryClusterCompatibilityLevelWithEnhancedProviderBlock(t *
 		)
 	_ = wiremockClient.StubFor(deleteSchemaRegistryClusterCompatibilityLevelStub)
 
+	// Deleting the resource reverts the compatibility level to the default one
+	resetSchemaRegistryClusterCompatibilityLevelStub := wiremock.Put(wiremock.URLPathEqualTo(updateSchemaRegistryClusterCompatibilityLevelPath)).
+		InScenario(schemaRegistryClusterCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenUpdated).
+		WithBodyPattern(wiremock.EqualToJson(`{"compatibility":"BACKWARD"}`)).
+		WillSetStateTo(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenDeleted).
+		WillReturn(
+			`{"compatibility":"BACKWARD"}`,
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(resetSchemaRegistryClusterCompatibilityLevelStub)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviderFactories,
@@ -165,7 +179,8 @@ func TestAccSchemaRegistryClusterCompatibilityLevelWithEnhancedProviderBlock(t *
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT (CREATE) %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, createSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT %s", updateSchemaRegistryClusterCompatibilityLevelPath), 3)
+	checkStubCount(t, wiremockClient, resetSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT (RESET) %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountOne)
 	checkStubCount(t, wiremockClient, deleteSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("DELETE %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountZero)
 }
 
@@ -97,6 +97,19 @@ func TestAccSchemaRegistryClusterCompatibilityLevel(t *testing.T) {
 		)
 	_ = wiremockClient.StubFor(deleteSchemaRegistryClusterCompatibilityLevelStub)
 
+	// Deleting the resource reverts the compatibility level to the default one
+	resetSchemaRegistryClusterCompatibilityLevelStub := wiremock.Put(wiremock.URLPathEqualTo(updateSchemaRegistryClusterCompatibilityLevelPath)).
+		InScenario(schemaRegistryClusterCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenUpdated).
+		WithBodyPattern(wiremock.EqualToJson(`{"compatibility":"BACKWARD"}`)).
+		WillSetStateTo(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenDeleted).
+		WillReturn(
+			`{"compatibility":"BACKWARD"}`,
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(resetSchemaRegistryClusterCompatibilityLevelStub)
+
 	// Set fake values for secrets since those are required for importing
 	_ = os.Setenv("IMPORT_SCHEMA_REGISTRY_API_KEY", testSchemaRegistryUpdatedKey)
 	_ = os.Setenv("IMPORT_SCHEMA_REGISTRY_API_SECRET", testSchemaRegistryUpdatedSecret)
@@ -157,10 +170,105 @@ func TestAccSchemaRegistryClusterCompatibilityLevel(t *testing.T) {
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT (CREATE) %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, createSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT %s", updateSchemaRegistryClusterCompatibilityLevelPath), 3)
+	checkStubCount(t, wiremockClient, resetSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT (RESET) %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountOne)
 	checkStubCount(t, wiremockClient, deleteSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("DELETE %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountZero)
 }
 
+func TestAccSchemaRegistryClusterCompatibilityLevelDrift(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaRegistryClusterCompatibilityLevelTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaRegistryClusterCompatibilityLevelTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createSchemaRegistryClusterCompatibilityLevelStub := wiremock.Put(wiremock.URLPathEqualTo(updateSchemaRegistryClusterCompatibilityLevelPath)).
+		InScenario(schemaRegistryClusterCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(fmt.Sprintf(`{"compatibility":%q,"compatibilityGroup":%q}`, compatibilityLevelFullTransitive, testSchemaRegistryClusterCompatibilityGroup))).
+		WillSetStateTo(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(createSchemaRegistryClusterCompatibilityLevelStub)
+
+	readCreatedSchemaRegistryClusterCompatibilityLevelResponse, _ := ioutil.ReadFile("../testdata/schema_registry_cluster_compatibility_level/read_created_full_transitive_schema_registry_cluster_compatibility_level.json")
+	readCreatedSchemaRegistryClusterCompatibilityLevelStub := wiremock.Get(wiremock.URLPathEqualTo(updateSchemaRegistryClusterCompatibilityLevelPath)).
+		InScenario(schemaRegistryClusterCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenCreated).
+		WillReturn(
+			string(readCreatedSchemaRegistryClusterCompatibilityLevelResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(readCreatedSchemaRegistryClusterCompatibilityLevelStub)
+
+	// The compatibility level was changed to NONE outside of Terraform
+	readDriftedSchemaRegistryClusterCompatibilityLevelResponse, _ := ioutil.ReadFile("../testdata/schema_registry_cluster_compatibility_level/read_drifted_schema_registry_cluster_compatibility_level.json")
+	readDriftedSchemaRegistryClusterCompatibilityLevelStub := wiremock.Get(wiremock.URLPathEqualTo(updateSchemaRegistryClusterCompatibilityLevelPath)).
+		InScenario(schemaRegistryClusterCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenCreated).
+		WillReturn(
+			string(readDriftedSchemaRegistryClusterCompatibilityLevelResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+
+	resetSchemaRegistryClusterCompatibilityLevelStub := wiremock.Put(wiremock.URLPathEqualTo(updateSchemaRegistryClusterCompatibilityLevelPath)).
+		InScenario(schemaRegistryClusterCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenCreated).
+		WithBodyPattern(wiremock.EqualToJson(`{"compatibility":"BACKWARD"}`)).
+		WillSetStateTo(scenarioStateSchemaRegistryClusterCompatibilityLevelHasBeenDeleted).
+		WillReturn(
+			`{"compatibility":"BACKWARD"}`,
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(resetSchemaRegistryClusterCompatibilityLevelStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSchemaRegistryClusterCompatibilityLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSchemaRegistryClusterCompatibilityGroupConfig(confluentCloudBaseUrl, mockSchemaRegistryClusterCompatibilityLevelTestServerUrl, compatibilityLevelFullTransitive, testSchemaRegistryClusterCompatibilityGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaRegistryClusterCompatibilityLevelExists(fullSchemaRegistryClusterCompatibilityLevelResourceLabel),
+					resource.TestCheckResourceAttr(fullSchemaRegistryClusterCompatibilityLevelResourceLabel, "id", testStreamGovernanceClusterId),
+					resource.TestCheckResourceAttr(fullSchemaRegistryClusterCompatibilityLevelResourceLabel, "compatibility_level", compatibilityLevelFullTransitive),
+					resource.TestCheckResourceAttr(fullSchemaRegistryClusterCompatibilityLevelResourceLabel, "compatibility_group", testSchemaRegistryClusterCompatibilityGroup),
+				),
+			},
+			{
+				PreConfig: func() {
+					_ = wiremockClient.DeleteStub(readCreatedSchemaRegistryClusterCompatibilityLevelStub)
+					_ = wiremockClient.StubFor(readDriftedSchemaRegistryClusterCompatibilityLevelStub)
+				},
+				Config:             testAccCheckSchemaRegistryClusterCompatibilityGroupConfig(confluentCloudBaseUrl, mockSchemaRegistryClusterCompatibilityLevelTestServerUrl, compatibilityLevelFullTransitive, testSchemaRegistryClusterCompatibilityGroup),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT (CREATE) %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, resetSchemaRegistryClusterCompatibilityLevelStub, fmt.Sprintf("PUT (RESET) %s", updateSchemaRegistryClusterCompatibilityLevelPath), expectedCountOne)
+}
+
 func testAccCheckSchemaRegistryClusterCompatibilityLevelConfig(confluentCloudBaseUrl, mockServerUrl, compatibilityLevel, schemaRegistryKey, schemaRegistrySecret string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
@@ -180,3 +288,24 @@ func testAccCheckSchemaRegistryClusterCompatibilityLevelConfig(confluentCloudBas
 	}
 	`, confluentCloudBaseUrl, testSchemaRegistryClusterCompatibilityLevelResourceLabel, schemaRegistryKey, schemaRegistrySecret, mockServerUrl, testStreamGovernanceClusterId, compatibilityLevel)
 }
+
+func testAccCheckSchemaRegistryClusterCompatibilityGroupConfig(confluentCloudBaseUrl, mockServerUrl, compatibilityLevel, compatibilityGroup string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_schema_registry_cluster_config" "%s" {
+	  credentials {
+	    key = "%s"
+	    secret = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+
+	  compatibility_level = "%s"
+	  compatibility_group = "%s"
+	}
+	`, confluentCloudBaseUrl, testSchemaRegistryClusterCompatibilityLevelResourceLabel, testSchemaRegistryKey, testSchemaRegistrySecret, mockServerUrl, testStreamGovernanceClusterId, compatibilityLevel, compatibilityGroup)
+}
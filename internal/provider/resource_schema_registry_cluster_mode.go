@@ -27,6 +27,11 @@ import (
 	"regexp"
 )
 
+const (
+	paramForce             = "force"
+	paramForceDefaultValue = false
+)
+
 func schemaRegistryClusterModeResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: schemaRegistryClusterModeCreate,
@@ -52,6 +57,12 @@ func schemaRegistryClusterModeResource() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptedModes, false),
 			},
+			paramForce: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramForceDefaultValue,
+				Description: "Controls whether mode changes are sent with `force=true`, which Schema Registry requires for some transitions, for example, switching a non-empty Schema Registry cluster to `IMPORT` mode.",
+			},
 		},
 	}
 }
@@ -82,7 +93,7 @@ func schemaRegistryClusterModeCreate(ctx context.Context, d *schema.ResourceData
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Creating new Schema Registry Cluster Mode: %s", createModeRequestJson))
 
-		_, _, err = executeSchemaRegistryClusterModeUpdate(ctx, schemaRegistryRestClient, createModeRequest)
+		_, _, err = executeSchemaRegistryClusterModeUpdate(ctx, schemaRegistryRestClient, createModeRequest, d.Get(paramForce).(bool))
 
 		if err != nil {
 			return diag.Errorf("error creating Schema Registry Cluster Mode: %s", createDescriptiveError(err))
@@ -102,6 +113,31 @@ func schemaRegistryClusterModeCreate(ctx context.Context, d *schema.ResourceData
 func schemaRegistryClusterModeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Schema Registry Cluster Mode %q", d.Id()), map[string]interface{}{schemaRegistryClusterModeLoggingKey: d.Id()})
 
+	// There is no DELETE for the top-level mode, so restore the default READWRITE mode instead
+	if d.Get(paramMode).(string) != modeReadWrite {
+		restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error deleting Schema Registry Cluster Mode: %s", createDescriptiveError(err))
+		}
+		clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error deleting Schema Registry Cluster Mode: %s", createDescriptiveError(err))
+		}
+		clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error deleting Schema Registry Cluster Mode: %s", createDescriptiveError(err))
+		}
+		schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet)
+
+		deleteModeRequest := sr.NewModeUpdateRequest()
+		deleteModeRequest.SetMode(modeReadWrite)
+		_, _, err = executeSchemaRegistryClusterModeUpdate(ctx, schemaRegistryRestClient, deleteModeRequest, d.Get(paramForce).(bool))
+		if err != nil {
+			return diag.Errorf("error deleting Schema Registry Cluster Mode %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		SleepIfNotTestMode(schemaRegistryAPIWaitAfterCreateOrDelete, meta.(*Client).isAcceptanceTestMode)
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Schema Registry Cluster Mode %q", d.Id()), map[string]interface{}{schemaRegistryClusterModeLoggingKey: d.Id()})
 
 	return nil
@@ -187,6 +223,13 @@ func readSchemaRegistryClusterModeAndSetAttributes(ctx context.Context, d *schem
 		return nil, err
 	}
 
+	// Explicitly set paramForce to the default value if unset
+	if _, ok := d.GetOk(paramForce); !ok {
+		if err := d.Set(paramForce, paramForceDefaultValue); err != nil {
+			return nil, createDescriptiveError(err)
+		}
+	}
+
 	if !c.isMetadataSetInProviderBlock {
 		if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
 			return nil, err
@@ -205,8 +248,8 @@ func readSchemaRegistryClusterModeAndSetAttributes(ctx context.Context, d *schem
 }
 
 func schemaRegistryClusterModeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramMode) {
-		return diag.Errorf("error updating Schema Registry Cluster Mode %q: only %q and %q blocks can be updated for Schema Registry Cluster Mode", d.Id(), paramCredentials, paramMode)
+	if d.HasChangesExcept(paramCredentials, paramMode, paramForce) {
+		return diag.Errorf("error updating Schema Registry Cluster Mode %q: only %q, %q and %q blocks can be updated for Schema Registry Cluster Mode", d.Id(), paramCredentials, paramMode, paramForce)
 	}
 	if d.HasChange(paramMode) {
 		updatedMode := d.Get(paramMode).(string)
@@ -231,7 +274,7 @@ func schemaRegistryClusterModeUpdate(ctx context.Context, d *schema.ResourceData
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Updating Schema Registry Cluster Mode %q: %s", d.Id(), updateModeRequestJson), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
 
-		_, _, err = executeSchemaRegistryClusterModeUpdate(ctx, schemaRegistryRestClient, updateModeRequest)
+		_, _, err = executeSchemaRegistryClusterModeUpdate(ctx, schemaRegistryRestClient, updateModeRequest, d.Get(paramForce).(bool))
 		if err != nil {
 			return diag.Errorf("error updating Schema Registry Cluster Mode: %s", createDescriptiveError(err))
 		}
@@ -241,6 +284,10 @@ func schemaRegistryClusterModeUpdate(ctx context.Context, d *schema.ResourceData
 	return schemaRegistryClusterModeRead(ctx, d, meta)
 }
 
-func executeSchemaRegistryClusterModeUpdate(ctx context.Context, c *SchemaRegistryRestClient, requestData *sr.ModeUpdateRequest) (sr.ModeUpdateRequest, *http.Response, error) {
-	return c.apiClient.ModesV1Api.UpdateTopLevelMode(c.apiContext(ctx)).ModeUpdateRequest(*requestData).Execute()
+func executeSchemaRegistryClusterModeUpdate(ctx context.Context, c *SchemaRegistryRestClient, requestData *sr.ModeUpdateRequest, force bool) (sr.ModeUpdateRequest, *http.Response, error) {
+	request := c.apiClient.ModesV1Api.UpdateTopLevelMode(c.apiContext(ctx)).ModeUpdateRequest(*requestData)
+	if force {
+		request = request.Force(force)
+	}
+	return request.Execute()
 }
@@ -36,7 +36,7 @@ const (
 	testSchemaRegistryClusterMode              = "READWRITE"
 	testUpdatedSchemaRegistryClusterMode       = "READONLY"
 
-	testNumberOfSchemaRegistryClusterModeResourceAttributes = "5"
+	testNumberOfSchemaRegistryClusterModeResourceAttributes = "6"
 )
 
 var fullSchemaRegistryClusterModeResourceLabel = fmt.Sprintf("confluent_schema_registry_cluster_mode.%s", testSchemaRegistryClusterModeResourceLabel)
@@ -102,6 +102,18 @@ func TestAccSchemaRegistryClusterModeWithEnhancedProviderBlock(t *testing.T) {
 			http.StatusOK,
 		))
 
+	// Deleting the resource restores the default READWRITE mode
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo(updateSchemaRegistryClusterModePath)).
+		InScenario(schemaRegistryClusterModeScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterModeHasBeenUpdated).
+		WithBodyPattern(wiremock.EqualToJson(`{"mode":"READWRITE"}`)).
+		WillSetStateTo(scenarioStateSchemaRegistryClusterModeHasBeenDeleted).
+		WillReturn(
+			`{"mode":"READWRITE"}`,
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	deleteSchemaRegistryClusterModeStub := wiremock.Delete(wiremock.URLPathEqualTo(updateSchemaRegistryClusterModePath)).
 		InScenario(schemaRegistryClusterModeScenarioName).
 		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterModeHasBeenUpdated).
@@ -165,7 +177,7 @@ func TestAccSchemaRegistryClusterModeWithEnhancedProviderBlock(t *testing.T) {
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createSchemaRegistryClusterModeStub, fmt.Sprintf("PUT (CREATE) %s", updateSchemaRegistryClusterModePath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, createSchemaRegistryClusterModeStub, fmt.Sprintf("PUT %s", updateSchemaRegistryClusterModePath), expectedCountThree)
 	checkStubCount(t, wiremockClient, deleteSchemaRegistryClusterModeStub, fmt.Sprintf("DELETE %s", updateSchemaRegistryClusterModePath), expectedCountZero)
 }
 
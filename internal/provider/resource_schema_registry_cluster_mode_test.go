@@ -86,6 +86,18 @@ func TestAccSchemaRegistryClusterMode(t *testing.T) {
 			http.StatusOK,
 		))
 
+	// Deleting the resource restores the default READWRITE mode
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo(updateSchemaRegistryClusterModePath)).
+		InScenario(schemaRegistryClusterModeScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterModeHasBeenUpdated).
+		WithBodyPattern(wiremock.EqualToJson(`{"mode":"READWRITE"}`)).
+		WillSetStateTo(scenarioStateSchemaRegistryClusterModeHasBeenDeleted).
+		WillReturn(
+			`{"mode":"READWRITE"}`,
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	deleteSchemaRegistryClusterModeStub := wiremock.Delete(wiremock.URLPathEqualTo(updateSchemaRegistryClusterModePath)).
 		InScenario(schemaRegistryClusterModeScenarioName).
 		WhenScenarioStateIs(scenarioStateSchemaRegistryClusterModeHasBeenUpdated).
@@ -157,7 +169,7 @@ func TestAccSchemaRegistryClusterMode(t *testing.T) {
 		},
 	})
 
-	checkStubCount(t, wiremockClient, createSchemaRegistryClusterModeStub, fmt.Sprintf("PUT (CREATE) %s", updateSchemaRegistryClusterModePath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, createSchemaRegistryClusterModeStub, fmt.Sprintf("PUT %s", updateSchemaRegistryClusterModePath), expectedCountThree)
 	checkStubCount(t, wiremockClient, deleteSchemaRegistryClusterModeStub, fmt.Sprintf("DELETE %s", updateSchemaRegistryClusterModePath), expectedCountZero)
 }
 
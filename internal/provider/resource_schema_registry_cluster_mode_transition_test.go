@@ -0,0 +1,179 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Schema Registry rejects switching a cluster with existing subjects to IMPORT mode unless force=true is set
+const importModeWithoutForceResponse = `{"error_code": 42205, "message": "Cannot import since found existing subjects"}`
+
+type schemaRegistryClusterModeTestServer struct {
+	*httptest.Server
+	mode        string
+	putRequests int
+	lastForce   string
+}
+
+func newSchemaRegistryClusterModeTestServer(t *testing.T, initialMode string) *schemaRegistryClusterModeTestServer {
+	s := &schemaRegistryClusterModeTestServer{mode: initialMode}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != updateSchemaRegistryClusterModePath {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprintf(w, `{"mode":%q}`, s.mode)
+		case http.MethodPut:
+			s.putRequests++
+			s.lastForce = r.URL.Query().Get(paramForce)
+			var body struct {
+				Mode string `json:"mode"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if body.Mode == modeImport && s.mode != modeImport && s.lastForce != "true" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = fmt.Fprint(w, importModeWithoutForceResponse)
+				return
+			}
+			s.mode = body.Mode
+			_, _ = fmt.Fprintf(w, `{"mode":%q}`, s.mode)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	return s
+}
+
+func newSchemaRegistryClusterModeTestData(t *testing.T, restEndpoint, mode string, force bool) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, schemaRegistryClusterModeResource().Schema, map[string]interface{}{
+		paramRestEndpoint: restEndpoint,
+		paramCredentials: []interface{}{map[string]interface{}{
+			paramKey:    "key",
+			paramSecret: "secret",
+		}},
+		paramSchemaRegistryCluster: []interface{}{map[string]interface{}{
+			paramId: testStreamGovernanceClusterId,
+		}},
+		paramMode:  mode,
+		paramForce: force,
+	})
+	d.SetId(testStreamGovernanceClusterId)
+	return d
+}
+
+func newSchemaRegistryClusterModeTestClient() *Client {
+	return &Client{
+		schemaRegistryRestClientFactory: &SchemaRegistryRestClientFactory{ctx: context.Background(), userAgent: "test"},
+		isAcceptanceTestMode:            true,
+	}
+}
+
+func TestSchemaRegistryClusterModeTransitions(t *testing.T) {
+	tests := []struct {
+		name          string
+		from          string
+		to            string
+		force         bool
+		expectedForce string
+		expectError   bool
+	}{
+		{"READWRITE to READONLY", modeReadWrite, modeReadOnly, false, "", false},
+		{"READONLY to READWRITE", modeReadOnly, modeReadWrite, false, "", false},
+		{"READWRITE to IMPORT with force", modeReadWrite, modeImport, true, "true", false},
+		{"READWRITE to IMPORT without force", modeReadWrite, modeImport, false, "", true},
+		{"IMPORT to READWRITE", modeImport, modeReadWrite, false, "", false},
+		{"IMPORT to READONLY with force", modeImport, modeReadOnly, true, "true", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSchemaRegistryClusterModeTestServer(t, tt.from)
+			defer server.Close()
+			d := newSchemaRegistryClusterModeTestData(t, server.URL, tt.to, tt.force)
+
+			diags := schemaRegistryClusterModeCreate(context.Background(), d, newSchemaRegistryClusterModeTestClient())
+			if diags.HasError() != tt.expectError {
+				t.Fatalf("expected error: %t, got: %v", tt.expectError, diags)
+			}
+			if server.lastForce != tt.expectedForce {
+				t.Fatalf("expected %q query parameter to be %q, got %q", paramForce, tt.expectedForce, server.lastForce)
+			}
+			if tt.expectError {
+				if server.mode != tt.from {
+					t.Fatalf("expected mode to remain %q, got %q", tt.from, server.mode)
+				}
+				return
+			}
+			if server.mode != tt.to {
+				t.Fatalf("expected mode %q on the server, got %q", tt.to, server.mode)
+			}
+			if got := d.Get(paramMode).(string); got != tt.to {
+				t.Fatalf("expected mode %q in TF state, got %q", tt.to, got)
+			}
+		})
+	}
+}
+
+func TestSchemaRegistryClusterModeDeleteRestoresReadWrite(t *testing.T) {
+	tests := []struct {
+		name                string
+		mode                string
+		force               bool
+		expectedPutRequests int
+		expectedForce       string
+	}{
+		{"READONLY", modeReadOnly, false, 1, ""},
+		{"IMPORT with force", modeImport, true, 1, "true"},
+		{"READWRITE", modeReadWrite, false, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSchemaRegistryClusterModeTestServer(t, tt.mode)
+			defer server.Close()
+			d := newSchemaRegistryClusterModeTestData(t, server.URL, tt.mode, tt.force)
+
+			if diags := schemaRegistryClusterModeDelete(context.Background(), d, newSchemaRegistryClusterModeTestClient()); diags.HasError() {
+				t.Fatalf("expected no error, got: %v", diags)
+			}
+			if server.mode != modeReadWrite {
+				t.Fatalf("expected mode %q after delete, got %q", modeReadWrite, server.mode)
+			}
+			if server.putRequests != tt.expectedPutRequests {
+				t.Fatalf("expected %d PUT requests, got %d", tt.expectedPutRequests, server.putRequests)
+			}
+			if server.lastForce != tt.expectedForce {
+				t.Fatalf("expected %q query parameter to be %q, got %q", paramForce, tt.expectedForce, server.lastForce)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	softDeletedSubjectName = "test2"
+	// Schema Registry returns this error for subjects whose versions have all been soft deleted
+	softDeletedSubjectResponse = `{"error_code": 40401, "message": "Subject 'test2' not found."}`
+	// GET /schemas doesn't include soft deleted schema versions, so only version #1 is returned
+	schemasWithoutSoftDeletedVersionResponse = `[{"subject": "test2", "version": 1, "id": 100001, "schema": "{\"type\":\"record\",\"name\":\"User\",\"fields\":[]}"}]`
+)
+
+func newSoftDeletedSchemaTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case fmt.Sprintf("/subjects/%s/versions/latest", softDeletedSubjectName):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, softDeletedSubjectResponse)
+		case "/schemas":
+			_, _ = fmt.Fprint(w, schemasWithoutSoftDeletedVersionResponse)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestReadSoftDeletedSchemaRemovesItFromState(t *testing.T) {
+	server := newSoftDeletedSchemaTestServer(t)
+	defer server.Close()
+	c := SchemaRegistryRestClientFactory{ctx: context.Background(), userAgent: "test"}.CreateSchemaRegistryRestClient(server.URL, "lsrc-abc123", "key", "secret", false)
+
+	tests := []struct {
+		name             string
+		schemaIdentifier string
+	}{
+		// All versions of the subject have been soft deleted
+		{"latest", latestSchemaVersionAndPlaceholderForSchemaIdentifier},
+		// The referenced version (#2) has been soft deleted
+		{"specific version", "100002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, schemaResource().Schema, map[string]interface{}{})
+			d.SetId(fmt.Sprintf("lsrc-abc123/%s/%s", softDeletedSubjectName, tt.schemaIdentifier))
+
+			if _, err := readSchemaRegistryConfigAndSetAttributes(context.Background(), d, c, softDeletedSubjectName, tt.schemaIdentifier); err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if d.Id() != "" {
+				t.Fatalf("expected soft deleted Schema to be removed from TF state, got ID %q", d.Id())
+			}
+		})
+	}
+}
+
+func TestImportSoftDeletedSchemaFails(t *testing.T) {
+	server := newSoftDeletedSchemaTestServer(t)
+	defer server.Close()
+	c := SchemaRegistryRestClientFactory{ctx: context.Background(), userAgent: "test"}.CreateSchemaRegistryRestClient(server.URL, "lsrc-abc123", "key", "secret", false)
+
+	d := schema.TestResourceDataRaw(t, schemaResource().Schema, map[string]interface{}{})
+	d.SetId(fmt.Sprintf("lsrc-abc123/%s/latest", softDeletedSubjectName))
+	d.MarkNewResource()
+
+	if _, err := readSchemaRegistryConfigAndSetAttributes(context.Background(), d, c, softDeletedSubjectName, latestSchemaVersionAndPlaceholderForSchemaIdentifier); err == nil {
+		t.Fatal("expected an error when importing a soft deleted Schema, got nil")
+	}
+}
@@ -17,6 +17,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	sr "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -375,3 +377,66 @@ func testAccCheckSchemaExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestResourceSchemaNestedReferencesRoundTrip(t *testing.T) {
+	// order.proto -> customer.proto -> address.proto
+	// Every Schema only lists its direct references, nested references are listed by the referenced Schemas.
+	protobufSchemas := []map[string]interface{}{
+		{
+			paramSubjectName: "address-value",
+			paramFormat:      protobufFormat,
+			paramSchema:      "syntax = \"proto3\";\npackage io.confluent;\n\nmessage Address {\n  string street = 1;\n}\n",
+		},
+		{
+			paramSubjectName: "customer-value",
+			paramFormat:      protobufFormat,
+			paramSchema:      "syntax = \"proto3\";\npackage io.confluent;\nimport \"address.proto\";\n\nmessage Customer {\n  string name = 1;\n  Address address = 2;\n}\n",
+			paramSchemaReference: []interface{}{
+				map[string]interface{}{
+					paramName:        "address.proto",
+					paramSubjectName: "address-value",
+					paramVersion:     1,
+				},
+			},
+		},
+		{
+			paramSubjectName: "order-value",
+			paramFormat:      protobufFormat,
+			paramSchema:      "syntax = \"proto3\";\npackage io.confluent;\nimport \"customer.proto\";\nimport \"address.proto\";\n\nmessage Order {\n  Customer customer = 1;\n  Address shipping_address = 2;\n}\n",
+			paramSchemaReference: []interface{}{
+				map[string]interface{}{
+					paramName:        "customer.proto",
+					paramSubjectName: "customer-value",
+					paramVersion:     2,
+				},
+				map[string]interface{}{
+					paramName:        "address.proto",
+					paramSubjectName: "address-value",
+					paramVersion:     1,
+				},
+			},
+		},
+	}
+
+	for _, protobufSchema := range protobufSchemas {
+		subjectName := protobufSchema[paramSubjectName].(string)
+		t.Run(subjectName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, schemaResource().Schema, protobufSchema)
+			expectedReferences := d.Get(paramSchemaReference).(*schema.Set)
+
+			// Emulate registering the Schema and reading it back from Schema Registry
+			srSchema := sr.NewSchema()
+			srSchema.SetReferences(buildSchemaReferences(expectedReferences.List()))
+
+			readBack := schema.TestResourceDataRaw(t, schemaResource().Schema, map[string]interface{}{})
+			if err := readBack.Set(paramSchemaReference, buildTfSchemaReferences(srSchema.GetReferences())); err != nil {
+				t.Fatalf("error setting %q: %s", paramSchemaReference, err)
+			}
+			actualReferences := readBack.Get(paramSchemaReference).(*schema.Set)
+
+			if !expectedReferences.Equal(actualReferences) {
+				t.Fatalf("expected %q to be %#v, got %#v", paramSchemaReference, expectedReferences.List(), actualReferences.List())
+			}
+		})
+	}
+}
@@ -73,6 +73,7 @@ func TestAccServiceAccount(t *testing.T) {
 
 	readUpdatedSaResponse, _ := ioutil.ReadFile("../testdata/service_account/read_updated_sa.json")
 	patchSaStub := wiremock.Patch(wiremock.URLPathEqualTo("/iam/v2/service-accounts/sa-1jjv26")).
+		WithBodyPattern(wiremock.MatchingJsonPath("$[?(@.description == 'The updated description of service account')]")).
 		InScenario(saScenarioName).
 		WhenScenarioStateIs(scenarioStateSaHasBeenCreated).
 		WillSetStateTo(scenarioStateSaDescriptionHaveBeenUpdated).
@@ -168,6 +169,51 @@ func TestAccServiceAccount(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteSaStub, "DELETE /iam/v2/service-accounts/sa-1jjv26", expectedCountOne)
 }
 
+func TestResourceServiceAccountDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "sa-1jjv26",
+		Attributes: map[string]string{
+			"id":           "sa-1jjv26",
+			"api_version":  saApiVersion,
+			"kind":         saKind,
+			"display_name": "test_service_account_display_name",
+			"description":  "The initial description of service account",
+		},
+	}
+
+	tests := []struct {
+		name                string
+		displayName         string
+		description         string
+		expectedRequiresNew bool
+	}{
+		{"description is updated in place", "test_service_account_display_name", "The updated description of service account", false},
+		{"description is cleared in place", "test_service_account_display_name", "", false},
+		{"display_name forces replacement", "test_service_account_display_name_updated", "The initial description of service account", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"display_name": tt.displayName,
+			}
+			if tt.description != "" {
+				raw["description"] = tt.description
+			}
+			diff, err := serviceAccountResource().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), &Client{})
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+			if diff == nil || diff.Empty() {
+				t.Fatal("expected a non-empty diff")
+			}
+			if diff.RequiresNew() != tt.expectedRequiresNew {
+				t.Fatalf("expected RequiresNew=%t, got %t: %#v", tt.expectedRequiresNew, diff.RequiresNew(), diff.Attributes)
+			}
+		})
+	}
+}
+
 func testAccCheckServiceAccountDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 	// Loop through the resources in state, verifying each service account is destroyed
@@ -30,6 +30,7 @@ import (
 
 const (
 	paramCompatibilityLevel = "compatibility_level"
+	paramCompatibilityGroup = "compatibility_group"
 
 	compatibilityLevelBackward           = "BACKWARD"
 	compatibilityLevelBackwardTransitive = "BACKWARD_TRANSITIVE"
@@ -76,6 +77,12 @@ func subjectConfigResource() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptedCompatibilityLevels, false),
 			},
+			paramCompatibilityGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the metadata property that is used to group schema versions of the subject for compatibility checks, for example, `application.major.version`.",
+			},
 		},
 	}
 }
@@ -96,11 +103,16 @@ func subjectConfigCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet)
 	subjectName := d.Get(paramSubjectName).(string)
 
-	if _, ok := d.GetOk(paramCompatibilityLevel); ok {
-		compatibilityLevel := d.Get(paramCompatibilityLevel).(string)
-
+	compatibilityLevel, isCompatibilityLevelSet := d.GetOk(paramCompatibilityLevel)
+	compatibilityGroup, isCompatibilityGroupSet := d.GetOk(paramCompatibilityGroup)
+	if isCompatibilityLevelSet || isCompatibilityGroupSet {
 		createConfigRequest := sr.NewConfigUpdateRequest()
-		createConfigRequest.SetCompatibility(compatibilityLevel)
+		if isCompatibilityLevelSet {
+			createConfigRequest.SetCompatibility(compatibilityLevel.(string))
+		}
+		if isCompatibilityGroupSet {
+			createConfigRequest.SetCompatibilityGroup(compatibilityGroup.(string))
+		}
 		createConfigRequestJson, err := json.Marshal(createConfigRequest)
 		if err != nil {
 			return diag.Errorf("error creating Subject Config: error marshaling %#v to json: %s", createConfigRequest, createDescriptiveError(err))
@@ -143,8 +155,13 @@ func subjectConfigDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	subjectName := d.Get(paramSubjectName).(string)
 
 	// Deletes the specified subject-level compatibility level config and reverts to the global default.
-	_, _, err = schemaRegistryRestClient.apiClient.ConfigV1Api.DeleteSubjectConfig(schemaRegistryRestClient.apiContext(ctx), subjectName).Execute()
+	_, resp, err := schemaRegistryRestClient.apiClient.ConfigV1Api.DeleteSubjectConfig(schemaRegistryRestClient.apiContext(ctx), subjectName).Execute()
 
+	// The subject doesn't have a subject-level override (for example, neither compatibility_level nor compatibility_group were set)
+	if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		tflog.Debug(ctx, fmt.Sprintf("Finished deleting Subject Config %q: Subject Config has no subject-level override", d.Id()), map[string]interface{}{subjectConfigLoggingKey: d.Id()})
+		return nil
+	}
 	if err != nil {
 		return diag.Errorf("error deleting Subject Config %q: %s", d.Id(), createDescriptiveError(err))
 	}
@@ -246,6 +263,9 @@ func readSubjectConfigAndSetAttributes(ctx context.Context, d *schema.ResourceDa
 	if err := d.Set(paramCompatibilityLevel, subjectConfig.GetCompatibilityLevel()); err != nil {
 		return nil, err
 	}
+	if err := d.Set(paramCompatibilityGroup, subjectConfig.GetCompatibilityGroup()); err != nil {
+		return nil, err
+	}
 
 	if !c.isMetadataSetInProviderBlock {
 		if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
@@ -265,13 +285,17 @@ func readSubjectConfigAndSetAttributes(ctx context.Context, d *schema.ResourceDa
 }
 
 func subjectConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramCompatibilityLevel) {
-		return diag.Errorf("error updating Subject Config %q: only %q and %q blocks can be updated for Subject Config", d.Id(), paramCredentials, paramCompatibilityLevel)
+	if d.HasChangesExcept(paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup) {
+		return diag.Errorf("error updating Subject Config %q: only %q block, %q and %q attributes can be updated for Subject Config", d.Id(), paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup)
 	}
-	if d.HasChange(paramCompatibilityLevel) {
-		updatedCompatibilityLevel := d.Get(paramCompatibilityLevel).(string)
+	if d.HasChanges(paramCompatibilityLevel, paramCompatibilityGroup) {
 		updateConfigRequest := sr.NewConfigUpdateRequest()
-		updateConfigRequest.SetCompatibility(updatedCompatibilityLevel)
+		if d.HasChange(paramCompatibilityLevel) {
+			updateConfigRequest.SetCompatibility(d.Get(paramCompatibilityLevel).(string))
+		}
+		if d.HasChange(paramCompatibilityGroup) {
+			updateConfigRequest.SetCompatibilityGroup(d.Get(paramCompatibilityGroup).(string))
+		}
 		restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
 		if err != nil {
 			return diag.Errorf("error updating Schema: %s", createDescriptiveError(err))
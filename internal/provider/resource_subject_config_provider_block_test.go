@@ -35,8 +35,10 @@ const (
 	testSubjectCompatibilityLevelResourceLabel = "test_subject_compatibility_level_resource_label"
 	testSubjectCompatibilityLevel              = "FULL"
 	testUpdatedSubjectCompatibilityLevel       = "BACKWARD_TRANSITIVE"
+	testSubjectCompatibilityGroup              = "application.major.version"
+	testUpdatedSubjectCompatibilityGroup       = "application.version"
 
-	testNumberOfSubjectCompatibilityLevelResourceAttributes = "6"
+	testNumberOfSubjectCompatibilityLevelResourceAttributes = "7"
 )
 
 var fullSubjectCompatibilityLevelResourceLabel = fmt.Sprintf("confluent_subject_config.%s", testSubjectCompatibilityLevelResourceLabel)
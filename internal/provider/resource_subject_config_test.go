@@ -165,6 +165,182 @@ func TestAccSubjectCompatibilityLevel(t *testing.T) {
 	checkStubCount(t, wiremockClient, deleteSubjectCompatibilityLevelStub, fmt.Sprintf("DELETE %s", updateSubjectCompatibilityLevelPath), expectedCountOne)
 }
 
+func TestAccSubjectCompatibilityGroup(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSubjectCompatibilityLevelTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSubjectCompatibilityLevelTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createSubjectCompatibilityGroupStub := wiremock.Put(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		InScenario(subjectCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(fmt.Sprintf(`{"compatibility":%q,"compatibilityGroup":%q}`, compatibilityLevelBackward, testSubjectCompatibilityGroup))).
+		WillSetStateTo(scenarioStateSubjectCompatibilityLevelHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(createSubjectCompatibilityGroupStub)
+
+	readCreatedSubjectCompatibilityGroupResponse, _ := ioutil.ReadFile("../testdata/subject_compatibility_level/read_created_subject_compatibility_group.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		InScenario(subjectCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSubjectCompatibilityLevelHasBeenCreated).
+		WillReturn(
+			string(readCreatedSubjectCompatibilityGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// Only the changed attribute is sent
+	updateSubjectCompatibilityGroupStub := wiremock.Put(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		InScenario(subjectCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSubjectCompatibilityLevelHasBeenCreated).
+		WithBodyPattern(wiremock.EqualToJson(fmt.Sprintf(`{"compatibilityGroup":%q}`, testUpdatedSubjectCompatibilityGroup))).
+		WillSetStateTo(scenarioStateSubjectCompatibilityLevelHasBeenUpdated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateSubjectCompatibilityGroupStub)
+
+	readUpdatedSubjectCompatibilityGroupResponse, _ := ioutil.ReadFile("../testdata/subject_compatibility_level/read_updated_subject_compatibility_group.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		InScenario(subjectCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSubjectCompatibilityLevelHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedSubjectCompatibilityGroupResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteSubjectCompatibilityGroupStub := wiremock.Delete(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		InScenario(subjectCompatibilityLevelScenarioName).
+		WhenScenarioStateIs(scenarioStateSubjectCompatibilityLevelHasBeenUpdated).
+		WillSetStateTo(scenarioStateSubjectCompatibilityLevelHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(deleteSubjectCompatibilityGroupStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSubjectCompatibilityLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSubjectCompatibilityGroupConfig(confluentCloudBaseUrl, mockSubjectCompatibilityLevelTestServerUrl, testSubjectCompatibilityGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubjectCompatibilityLevelExists(fullSubjectCompatibilityLevelResourceLabel),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "id", fmt.Sprintf("%s/%s", testStreamGovernanceClusterId, testSubjectName)),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "compatibility_level", compatibilityLevelBackward),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "compatibility_group", testSubjectCompatibilityGroup),
+				),
+			},
+			{
+				Config: testAccCheckSubjectCompatibilityGroupConfig(confluentCloudBaseUrl, mockSubjectCompatibilityLevelTestServerUrl, testUpdatedSubjectCompatibilityGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubjectCompatibilityLevelExists(fullSubjectCompatibilityLevelResourceLabel),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "id", fmt.Sprintf("%s/%s", testStreamGovernanceClusterId, testSubjectName)),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "compatibility_level", compatibilityLevelBackward),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "compatibility_group", testUpdatedSubjectCompatibilityGroup),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createSubjectCompatibilityGroupStub, fmt.Sprintf("PUT (CREATE) %s", updateSubjectCompatibilityLevelPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateSubjectCompatibilityGroupStub, fmt.Sprintf("PUT (UPDATE) %s", updateSubjectCompatibilityLevelPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteSubjectCompatibilityGroupStub, fmt.Sprintf("DELETE %s", updateSubjectCompatibilityLevelPath), expectedCountOne)
+}
+
+func TestAccSubjectCompatibilityLevelInheritedFromGlobal(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSubjectCompatibilityLevelTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSubjectCompatibilityLevelTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createSubjectCompatibilityLevelStub := wiremock.Put(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(createSubjectCompatibilityLevelStub)
+
+	// The subject doesn't have a subject-level override, so the global compatibility level is returned
+	readGlobalSubjectCompatibilityLevelResponse, _ := ioutil.ReadFile("../testdata/subject_compatibility_level/read_global_subject_compatibility_level.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		WithQueryParam("defaultToGlobal", wiremock.EqualTo("true")).
+		WillReturn(
+			string(readGlobalSubjectCompatibilityLevelResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedSubjectCompatibilityLevelResponse, _ := ioutil.ReadFile("../testdata/subject_compatibility_level/read_deleted_subject_compatibility_level.json")
+	deleteSubjectCompatibilityLevelStub := wiremock.Delete(wiremock.URLPathEqualTo(updateSubjectCompatibilityLevelPath)).
+		WillReturn(
+			string(readDeletedSubjectCompatibilityLevelResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		)
+	_ = wiremockClient.StubFor(deleteSubjectCompatibilityLevelStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSubjectCompatibilityLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSubjectConfigWithoutOverrideConfig(confluentCloudBaseUrl, mockSubjectCompatibilityLevelTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubjectCompatibilityLevelExists(fullSubjectCompatibilityLevelResourceLabel),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "id", fmt.Sprintf("%s/%s", testStreamGovernanceClusterId, testSubjectName)),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "compatibility_level", compatibilityLevelForward),
+					resource.TestCheckResourceAttr(fullSubjectCompatibilityLevelResourceLabel, "compatibility_group", ""),
+				),
+			},
+			{
+				// The inherited compatibility level shouldn't cause a diff
+				Config:   testAccCheckSubjectConfigWithoutOverrideConfig(confluentCloudBaseUrl, mockSubjectCompatibilityLevelTestServerUrl),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createSubjectCompatibilityLevelStub, fmt.Sprintf("PUT %s", updateSubjectCompatibilityLevelPath), expectedCountZero)
+	checkStubCount(t, wiremockClient, deleteSubjectCompatibilityLevelStub, fmt.Sprintf("DELETE %s", updateSubjectCompatibilityLevelPath), expectedCountOne)
+}
+
 func testAccCheckSubjectCompatibilityLevelConfig(confluentCloudBaseUrl, mockServerUrl, compatibilityLevel, schemaRegistryKey, schemaRegistrySecret string) string {
 	return fmt.Sprintf(`
 	provider "confluent" {
@@ -185,3 +361,45 @@ func testAccCheckSubjectCompatibilityLevelConfig(confluentCloudBaseUrl, mockServ
 	}
 	`, confluentCloudBaseUrl, testSubjectCompatibilityLevelResourceLabel, schemaRegistryKey, schemaRegistrySecret, mockServerUrl, testStreamGovernanceClusterId, testSubjectName, compatibilityLevel)
 }
+
+func testAccCheckSubjectCompatibilityGroupConfig(confluentCloudBaseUrl, mockServerUrl, compatibilityGroup string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_subject_config" "%s" {
+	  credentials {
+	    key = "%s"
+	    secret = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+
+	  subject_name = "%s"
+	  compatibility_level = "%s"
+	  compatibility_group = "%s"
+	}
+	`, confluentCloudBaseUrl, testSubjectCompatibilityLevelResourceLabel, testSchemaRegistryKey, testSchemaRegistrySecret, mockServerUrl, testStreamGovernanceClusterId, testSubjectName, compatibilityLevelBackward, compatibilityGroup)
+}
+
+func testAccCheckSubjectConfigWithoutOverrideConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_subject_config" "%s" {
+	  credentials {
+	    key = "%s"
+	    secret = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+
+	  subject_name = "%s"
+	}
+	`, confluentCloudBaseUrl, testSubjectCompatibilityLevelResourceLabel, testSchemaRegistryKey, testSchemaRegistrySecret, mockServerUrl, testStreamGovernanceClusterId, testSubjectName)
+}
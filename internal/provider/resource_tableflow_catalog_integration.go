@@ -0,0 +1,414 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramSuspended    = "suspended"
+	paramAwsGlue      = "aws_glue"
+	paramSnowflake    = "snowflake"
+	paramEndpoint     = "endpoint"
+	paramClientId     = "client_id"
+	paramClientSecret = "client_secret"
+	paramWarehouse    = "warehouse"
+	paramAllowedScope = "allowed_scope"
+
+	catalogIntegrationsPath = "/tableflow/v1/catalog-integrations"
+	kindAwsGlue             = "AwsGlue"
+	kindSnowflake           = "Snowflake"
+)
+
+// tableflowCatalogIntegration represents tableflow.v1.CatalogIntegration since ccloud-sdk-go-v2 doesn't have a Tableflow SDK yet
+type tableflowCatalogIntegration struct {
+	ApiVersion string                           `json:"api_version,omitempty"`
+	Kind       string                           `json:"kind,omitempty"`
+	Id         string                           `json:"id,omitempty"`
+	Spec       *tableflowCatalogIntegrationSpec `json:"spec,omitempty"`
+	Status     *tableflowStatus                 `json:"status,omitempty"`
+}
+
+type tableflowCatalogIntegrationSpec struct {
+	DisplayName  string                             `json:"display_name,omitempty"`
+	Suspended    *bool                              `json:"suspended,omitempty"`
+	Config       *tableflowCatalogIntegrationConfig `json:"config,omitempty"`
+	Environment  *tableflowObjectRef                `json:"environment,omitempty"`
+	KafkaCluster *tableflowObjectRef                `json:"kafka_cluster,omitempty"`
+}
+
+type tableflowCatalogIntegrationConfig struct {
+	Kind                  string `json:"kind"`
+	ProviderIntegrationId string `json:"provider_integration_id,omitempty"`
+	Endpoint              string `json:"endpoint,omitempty"`
+	ClientId              string `json:"client_id,omitempty"`
+	ClientSecret          string `json:"client_secret,omitempty"`
+	Warehouse             string `json:"warehouse,omitempty"`
+	AllowedScope          string `json:"allowed_scope,omitempty"`
+}
+
+func catalogIntegrationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: catalogIntegrationCreate,
+		ReadContext:   catalogIntegrationRead,
+		UpdateContext: catalogIntegrationUpdate,
+		DeleteContext: catalogIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: catalogIntegrationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the catalog integration.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramEnvironment:  environmentSchema(),
+			paramKafkaCluster: requiredKafkaClusterBlockSchema(),
+			paramSuspended: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the catalog integration should be suspended.",
+			},
+			paramAwsGlue:   awsGlueCatalogIntegrationSchema(),
+			paramSnowflake: snowflakeCatalogIntegrationSchema(),
+		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
+	}
+}
+
+func awsGlueCatalogIntegrationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{paramAwsGlue, paramSnowflake},
+		Description:  "The catalog integration connection configuration for AWS Glue.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramProviderIntegrationId: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The ID of the Provider Integration Confluent Cloud uses to access AWS Glue.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^cspi-"), "the Provider Integration ID must be of the form 'cspi-'"),
+				},
+			},
+		},
+	}
+}
+
+func snowflakeCatalogIntegrationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{paramAwsGlue, paramSnowflake},
+		Description:  "The catalog integration connection configuration for Snowflake Open Catalog.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramEndpoint: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The catalog integration connection endpoint for Snowflake Open Catalog.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^https://"), "the endpoint must start with 'https://'"),
+				},
+				paramClientId: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					Description:  "The client ID of the catalog integration.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				paramClientSecret: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					Description:  "The client secret of the catalog integration.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				paramWarehouse: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Warehouse name of the Snowflake Open Catalog.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				paramAllowedScope: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "Allowed scope of the Snowflake Open Catalog.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func catalogIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	displayName := d.Get(paramDisplayName).(string)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Catalog Integration: %s", createDescriptiveError(err))
+	}
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+
+	var config *tableflowCatalogIntegrationConfig
+	if _, isAwsGlue := d.GetOk(paramAwsGlue); isAwsGlue {
+		config = &tableflowCatalogIntegrationConfig{
+			Kind:                  kindAwsGlue,
+			ProviderIntegrationId: extractStringValueFromBlock(d, paramAwsGlue, paramProviderIntegrationId),
+		}
+	} else {
+		config = &tableflowCatalogIntegrationConfig{
+			Kind:         kindSnowflake,
+			Endpoint:     extractStringValueFromBlock(d, paramSnowflake, paramEndpoint),
+			ClientId:     extractStringValueFromBlock(d, paramSnowflake, paramClientId),
+			ClientSecret: extractStringValueFromBlock(d, paramSnowflake, paramClientSecret),
+			Warehouse:    extractStringValueFromBlock(d, paramSnowflake, paramWarehouse),
+			AllowedScope: extractStringValueFromBlock(d, paramSnowflake, paramAllowedScope),
+		}
+	}
+
+	spec := &tableflowCatalogIntegrationSpec{
+		DisplayName:  displayName,
+		Config:       config,
+		Environment:  &tableflowObjectRef{Id: environmentId},
+		KafkaCluster: &tableflowObjectRef{Id: clusterId, Environment: environmentId},
+	}
+	// The catalog integration is created in the active state, and then suspended if requested
+	suspended := d.Get(paramSuspended).(bool)
+	createCatalogIntegrationRequest := &tableflowCatalogIntegration{Spec: spec}
+	// Omit the client secret from logs
+	nonsensitiveConfig := *config
+	nonsensitiveConfig.ClientSecret = ""
+	nonsensitiveSpec := *spec
+	nonsensitiveSpec.Config = &nonsensitiveConfig
+	nonsensitiveCreateCatalogIntegrationRequestJson, err := json.Marshal(tableflowCatalogIntegration{Spec: &nonsensitiveSpec})
+	if err != nil {
+		return diag.Errorf("error creating Catalog Integration: error marshaling %#v to json: %s", nonsensitiveSpec, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Catalog Integration: %s", nonsensitiveCreateCatalogIntegrationRequestJson))
+
+	var createdCatalogIntegration tableflowCatalogIntegration
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodPost, catalogIntegrationsPath, createCatalogIntegrationRequest, &createdCatalogIntegration); err != nil {
+		return diag.Errorf("error creating Catalog Integration %q: %s", displayName, createDescriptiveError(err))
+	}
+	d.SetId(createdCatalogIntegration.Id)
+
+	if suspended {
+		if err := executeCatalogIntegrationUpdate(ctx, c, environmentId, clusterId, d.Id(), &tableflowCatalogIntegrationSpec{Suspended: &suspended}); err != nil {
+			return diag.Errorf("error suspending Catalog Integration %q: %s", d.Id(), createDescriptiveError(err))
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+
+	return catalogIntegrationRead(ctx, d, meta)
+}
+
+func executeCatalogIntegrationRead(ctx context.Context, c *Client, environmentId, clusterId, catalogIntegrationId string) (tableflowCatalogIntegration, *http.Response, error) {
+	var catalogIntegration tableflowCatalogIntegration
+	resp, err := executeCloudApiRequest(ctx, c, http.MethodGet, catalogIntegrationPath(environmentId, clusterId, catalogIntegrationId), nil, &catalogIntegration)
+	return catalogIntegration, resp, err
+}
+
+func executeCatalogIntegrationUpdate(ctx context.Context, c *Client, environmentId, clusterId, catalogIntegrationId string, spec *tableflowCatalogIntegrationSpec) error {
+	spec.Environment = &tableflowObjectRef{Id: environmentId}
+	spec.KafkaCluster = &tableflowObjectRef{Id: clusterId, Environment: environmentId}
+	updateCatalogIntegrationRequest := &tableflowCatalogIntegration{Spec: spec}
+	updateCatalogIntegrationRequestJson, err := json.Marshal(updateCatalogIntegrationRequest)
+	if err != nil {
+		return fmt.Errorf("error marshaling %#v to json: %s", updateCatalogIntegrationRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updating Catalog Integration %q: %s", catalogIntegrationId, updateCatalogIntegrationRequestJson), map[string]interface{}{catalogIntegrationLoggingKey: catalogIntegrationId})
+
+	_, err = executeCloudApiRequest(ctx, c, http.MethodPatch, catalogIntegrationPath(environmentId, clusterId, catalogIntegrationId), updateCatalogIntegrationRequest, nil)
+	return err
+}
+
+func catalogIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+	if _, err := readCatalogIntegrationAndSetAttributes(ctx, d, meta, environmentId, clusterId, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Catalog Integration %q: %s", d.Id(), createDescriptiveError(err)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+
+	return nil
+}
+
+func readCatalogIntegrationAndSetAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentId, clusterId, catalogIntegrationId string) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	catalogIntegration, resp, err := executeCatalogIntegrationRead(ctx, c, environmentId, clusterId, catalogIntegrationId)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Catalog Integration %q: %s", catalogIntegrationId, createDescriptiveError(err)), map[string]interface{}{catalogIntegrationLoggingKey: catalogIntegrationId})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Catalog Integration %q in TF state because Catalog Integration could not be found on the server", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+			d.SetId("")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	catalogIntegrationJson, err := json.Marshal(catalogIntegration)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Catalog Integration %q: error marshaling %#v to json: %s", catalogIntegrationId, catalogIntegration, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Catalog Integration %q: %s", catalogIntegrationId, catalogIntegrationJson), map[string]interface{}{catalogIntegrationLoggingKey: catalogIntegrationId})
+
+	if _, err := setCatalogIntegrationAttributes(d, catalogIntegration, environmentId, clusterId); err != nil {
+		return nil, createDescriptiveError(err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func catalogIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramDisplayName, paramSuspended) {
+		return diag.Errorf("error updating Catalog Integration %q: only %q and %q attributes can be updated for Catalog Integration", d.Id(), paramDisplayName, paramSuspended)
+	}
+
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+
+	spec := &tableflowCatalogIntegrationSpec{}
+	if d.HasChange(paramDisplayName) {
+		spec.DisplayName = d.Get(paramDisplayName).(string)
+	}
+	if d.HasChange(paramSuspended) {
+		suspended := d.Get(paramSuspended).(bool)
+		spec.Suspended = &suspended
+	}
+	if err := executeCatalogIntegrationUpdate(ctx, c, environmentId, clusterId, d.Id(), spec); err != nil {
+		return diag.Errorf("error updating Catalog Integration %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+
+	return catalogIntegrationRead(ctx, d, meta)
+}
+
+func catalogIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodDelete, catalogIntegrationPath(environmentId, clusterId, d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting Catalog Integration %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+
+	return nil
+}
+
+func catalogIntegrationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+
+	envIDAndClusterIDAndCatalogIntegrationId := d.Id()
+	parts := strings.Split(envIDAndClusterIDAndCatalogIntegrationId, "/")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("error importing Catalog Integration: invalid format: expected '<env ID>/<Kafka cluster ID>/<Catalog Integration ID>'")
+	}
+
+	environmentId := parts[0]
+	clusterId := parts[1]
+	catalogIntegrationId := parts[2]
+	d.SetId(catalogIntegrationId)
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readCatalogIntegrationAndSetAttributes(ctx, d, meta, environmentId, clusterId, catalogIntegrationId); err != nil {
+		return nil, fmt.Errorf("error importing Catalog Integration %q: %s", d.Id(), err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Catalog Integration %q", d.Id()), map[string]interface{}{catalogIntegrationLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func setCatalogIntegrationAttributes(d *schema.ResourceData, catalogIntegration tableflowCatalogIntegration, environmentId, clusterId string) (*schema.ResourceData, error) {
+	if catalogIntegration.Spec == nil {
+		return nil, fmt.Errorf("the response is missing %q", "spec")
+	}
+	if err := d.Set(paramDisplayName, catalogIntegration.Spec.DisplayName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramSuspended, catalogIntegration.Spec.Suspended != nil && *catalogIntegration.Spec.Suspended); err != nil {
+		return nil, err
+	}
+	if config := catalogIntegration.Spec.Config; config != nil {
+		switch config.Kind {
+		case kindAwsGlue:
+			if err := d.Set(paramAwsGlue, []interface{}{map[string]interface{}{
+				paramProviderIntegrationId: config.ProviderIntegrationId,
+			}}); err != nil {
+				return nil, err
+			}
+		case kindSnowflake:
+			// Client credentials are not returned by the server, so they're read from the TF state
+			if err := d.Set(paramSnowflake, []interface{}{map[string]interface{}{
+				paramEndpoint:     config.Endpoint,
+				paramClientId:     extractStringValueFromBlock(d, paramSnowflake, paramClientId),
+				paramClientSecret: extractStringValueFromBlock(d, paramSnowflake, paramClientSecret),
+				paramWarehouse:    config.Warehouse,
+				paramAllowedScope: config.AllowedScope,
+			}}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, environmentId, d); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, clusterId, d); err != nil {
+		return nil, err
+	}
+	d.SetId(catalogIntegration.Id)
+	return d, nil
+}
+
+func catalogIntegrationPath(environmentId, clusterId, catalogIntegrationId string) string {
+	return fmt.Sprintf("%s/%s?%s", catalogIntegrationsPath, url.PathEscape(catalogIntegrationId), url.Values{"environment": {environmentId}, "spec.kafka_cluster": {clusterId}}.Encode())
+}
@@ -0,0 +1,228 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateCatalogIntegrationHasBeenCreated = "The new Catalog Integration has been just created"
+	scenarioStateCatalogIntegrationHasBeenUpdated = "The new Catalog Integration has been updated"
+	scenarioStateCatalogIntegrationHasBeenDeleted = "The new Catalog Integration has been deleted"
+	catalogIntegrationScenarioName                = "confluent_tableflow_catalog_integration Resource Lifecycle"
+
+	catalogIntegrationId                    = "tci-abc123"
+	catalogIntegrationDisplayName           = "glue_catalog_integration"
+	catalogIntegrationUpdatedDisplayName    = "glue_catalog_integration_suspended"
+	catalogIntegrationEnvironmentId         = "env-00000"
+	catalogIntegrationKafkaClusterId        = "lkc-00000"
+	catalogIntegrationProviderIntegrationId = "cspi-4xg0q"
+	catalogIntegrationResourceLabel         = "test_catalog_integration_resource_label"
+)
+
+var catalogIntegrationUrlPath = fmt.Sprintf("/tableflow/v1/catalog-integrations/%s", catalogIntegrationId)
+
+func TestAccCatalogIntegrationAwsGlue(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createCatalogIntegrationResponse, _ := ioutil.ReadFile("../testdata/tableflow_catalog_integration/create_catalog_integration.json")
+	createCatalogIntegrationStub := wiremock.Post(wiremock.URLPathEqualTo("/tableflow/v1/catalog-integrations")).
+		InScenario(catalogIntegrationScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(`"kind":"AwsGlue","provider_integration_id":"cspi-4xg0q"`)).
+		WillSetStateTo(scenarioStateCatalogIntegrationHasBeenCreated).
+		WillReturn(
+			string(createCatalogIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createCatalogIntegrationStub)
+
+	readCreatedCatalogIntegrationResponse, _ := ioutil.ReadFile("../testdata/tableflow_catalog_integration/read_created_catalog_integration.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(catalogIntegrationUrlPath)).
+		InScenario(catalogIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(catalogIntegrationEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(catalogIntegrationKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateCatalogIntegrationHasBeenCreated).
+		WillReturn(
+			string(readCreatedCatalogIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedCatalogIntegrationResponse, _ := ioutil.ReadFile("../testdata/tableflow_catalog_integration/read_updated_catalog_integration.json")
+	updateCatalogIntegrationStub := wiremock.Patch(wiremock.URLPathEqualTo(catalogIntegrationUrlPath)).
+		InScenario(catalogIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(catalogIntegrationEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(catalogIntegrationKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateCatalogIntegrationHasBeenCreated).
+		WithBodyPattern(wiremock.Contains(`"suspended":true`)).
+		WillSetStateTo(scenarioStateCatalogIntegrationHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedCatalogIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateCatalogIntegrationStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(catalogIntegrationUrlPath)).
+		InScenario(catalogIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(catalogIntegrationEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(catalogIntegrationKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateCatalogIntegrationHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedCatalogIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedCatalogIntegrationResponse, _ := ioutil.ReadFile("../testdata/tableflow_catalog_integration/read_deleted_catalog_integration.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(catalogIntegrationUrlPath)).
+		InScenario(catalogIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(catalogIntegrationEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(catalogIntegrationKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateCatalogIntegrationHasBeenDeleted).
+		WillReturn(
+			string(readDeletedCatalogIntegrationResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteCatalogIntegrationStub := wiremock.Delete(wiremock.URLPathEqualTo(catalogIntegrationUrlPath)).
+		InScenario(catalogIntegrationScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(catalogIntegrationEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(catalogIntegrationKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateCatalogIntegrationHasBeenUpdated).
+		WillSetStateTo(scenarioStateCatalogIntegrationHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteCatalogIntegrationStub)
+
+	fullCatalogIntegrationResourceLabel := fmt.Sprintf("confluent_tableflow_catalog_integration.%s", catalogIntegrationResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCatalogIntegrationDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCatalogIntegrationAwsGlueConfig(mockServerUrl, catalogIntegrationDisplayName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "id", catalogIntegrationId),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "display_name", catalogIntegrationDisplayName),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "suspended", "false"),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "environment.0.id", catalogIntegrationEnvironmentId),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "kafka_cluster.0.id", catalogIntegrationKafkaClusterId),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "aws_glue.#", "1"),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "aws_glue.0.provider_integration_id", catalogIntegrationProviderIntegrationId),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "snowflake.#", "0"),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullCatalogIntegrationResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.RootModule().Resources
+					catalogIntegrationId := resources[fullCatalogIntegrationResourceLabel].Primary.ID
+					environmentId := resources[fullCatalogIntegrationResourceLabel].Primary.Attributes["environment.0.id"]
+					clusterId := resources[fullCatalogIntegrationResourceLabel].Primary.Attributes["kafka_cluster.0.id"]
+					return environmentId + "/" + clusterId + "/" + catalogIntegrationId, nil
+				},
+			},
+			{
+				Config: testAccCheckCatalogIntegrationAwsGlueConfig(mockServerUrl, catalogIntegrationUpdatedDisplayName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "id", catalogIntegrationId),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "display_name", catalogIntegrationUpdatedDisplayName),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "suspended", "true"),
+					resource.TestCheckResourceAttr(fullCatalogIntegrationResourceLabel, "aws_glue.0.provider_integration_id", catalogIntegrationProviderIntegrationId),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createCatalogIntegrationStub, "POST /tableflow/v1/catalog-integrations", expectedCountOne)
+	checkStubCount(t, wiremockClient, updateCatalogIntegrationStub, fmt.Sprintf("PATCH %s", catalogIntegrationUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteCatalogIntegrationStub, fmt.Sprintf("DELETE %s", catalogIntegrationUrlPath), expectedCountOne)
+}
+
+func testAccCheckCatalogIntegrationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each Catalog Integration is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_tableflow_catalog_integration" {
+			continue
+		}
+		deletedCatalogIntegration, response, err := executeCatalogIntegrationRead(context.Background(), c, rs.Primary.Attributes["environment.0.id"], rs.Primary.Attributes["kafka_cluster.0.id"], rs.Primary.ID)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedCatalogIntegration.Id == rs.Primary.ID {
+			return fmt.Errorf("Catalog Integration (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckCatalogIntegrationAwsGlueConfig(mockServerUrl, displayName string, suspended bool) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_tableflow_catalog_integration" "%s" {
+		display_name = "%s"
+		suspended    = %t
+		environment {
+			id = "%s"
+		}
+		kafka_cluster {
+			id = "%s"
+		}
+		aws_glue {
+			provider_integration_id = "%s"
+		}
+	}
+	`, mockServerUrl, catalogIntegrationResourceLabel, displayName, suspended, catalogIntegrationEnvironmentId, catalogIntegrationKafkaClusterId, catalogIntegrationProviderIntegrationId)
+}
@@ -0,0 +1,387 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	paramTableFormats          = "table_formats"
+	paramRetentionMs           = "retention_ms"
+	paramBucketName            = "bucket_name"
+	paramBucketRegion          = "bucket_region"
+	paramProviderIntegrationId = "provider_integration_id"
+
+	tableflowTopicsPath       = "/tableflow/v1/tableflow-topics"
+	tableflowStorageByobAws   = "ByobAws"
+	tableflowStorageManaged   = "Managed"
+	tableflowAPICreateTimeout = 1 * time.Hour
+	tableflowAPIUpdateTimeout = 1 * time.Hour
+)
+
+var acceptedTableFormats = []string{"ICEBERG", "DELTA"}
+
+// tableflowTopic represents tableflow.v1.TableflowTopic since ccloud-sdk-go-v2 doesn't have a Tableflow SDK yet
+type tableflowTopic struct {
+	ApiVersion string              `json:"api_version,omitempty"`
+	Kind       string              `json:"kind,omitempty"`
+	Spec       *tableflowTopicSpec `json:"spec,omitempty"`
+	Status     *tableflowStatus    `json:"status,omitempty"`
+}
+
+type tableflowTopicSpec struct {
+	DisplayName  string                 `json:"display_name,omitempty"`
+	Storage      *tableflowTopicStorage `json:"storage,omitempty"`
+	TableFormats []string               `json:"table_formats,omitempty"`
+	Config       *tableflowTopicConfig  `json:"config,omitempty"`
+	Environment  *tableflowObjectRef    `json:"environment,omitempty"`
+	KafkaCluster *tableflowObjectRef    `json:"kafka_cluster,omitempty"`
+}
+
+type tableflowTopicStorage struct {
+	Kind                  string `json:"kind"`
+	BucketName            string `json:"bucket_name,omitempty"`
+	BucketRegion          string `json:"bucket_region,omitempty"`
+	ProviderIntegrationId string `json:"provider_integration_id,omitempty"`
+}
+
+type tableflowTopicConfig struct {
+	RetentionMs string `json:"retention_ms,omitempty"`
+}
+
+type tableflowObjectRef struct {
+	Id          string `json:"id"`
+	Environment string `json:"environment,omitempty"`
+}
+
+type tableflowStatus struct {
+	Phase        string `json:"phase,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+func tableflowTopicResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: tableflowTopicCreate,
+		ReadContext:   tableflowTopicRead,
+		UpdateContext: tableflowTopicUpdate,
+		DeleteContext: tableflowTopicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: tableflowTopicImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the Kafka topic for which Tableflow is enabled.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramEnvironment:  environmentSchema(),
+			paramKafkaCluster: requiredKafkaClusterBlockSchema(),
+			paramTableFormats: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The supported table formats for the Tableflow-enabled topic.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(acceptedTableFormats, false),
+				},
+			},
+			paramRetentionMs: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The max age of snapshots (Iceberg) or versions (Delta) (snapshot/version expiration) to keep on the table in milliseconds for the Tableflow-enabled topic.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "the retention must be a number of milliseconds"),
+			},
+			paramStorage: tableflowTopicStorageSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tableflowAPICreateTimeout),
+			Update: schema.DefaultTimeout(tableflowAPIUpdateTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
+	}
+}
+
+func tableflowTopicStorageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "The bring-your-own-storage (BYOS) configuration of the Tableflow-enabled topic. Confluent Managed Storage is used when omitted.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramBucketName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The bucket name.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				paramProviderIntegrationId: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The ID of the Provider Integration Confluent Cloud uses to access the bucket.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^cspi-"), "the Provider Integration ID must be of the form 'cspi-'"),
+				},
+				paramBucketRegion: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The bucket region.",
+				},
+			},
+		},
+	}
+}
+
+func tableflowTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	displayName := d.Get(paramDisplayName).(string)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Tableflow Topic: %s", createDescriptiveError(err))
+	}
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+
+	storage := &tableflowTopicStorage{Kind: tableflowStorageManaged}
+	if _, isByobAws := d.GetOk(paramStorage); isByobAws {
+		storage = &tableflowTopicStorage{
+			Kind:                  tableflowStorageByobAws,
+			BucketName:            extractStringValueFromBlock(d, paramStorage, paramBucketName),
+			ProviderIntegrationId: extractStringValueFromBlock(d, paramStorage, paramProviderIntegrationId),
+		}
+	}
+
+	createTableflowTopicRequest := &tableflowTopic{
+		Spec: &tableflowTopicSpec{
+			DisplayName:  displayName,
+			Storage:      storage,
+			TableFormats: convertToStringSlice(d.Get(paramTableFormats).(*schema.Set).List()),
+			Config:       &tableflowTopicConfig{RetentionMs: d.Get(paramRetentionMs).(string)},
+			Environment:  &tableflowObjectRef{Id: environmentId},
+			KafkaCluster: &tableflowObjectRef{Id: clusterId, Environment: environmentId},
+		},
+	}
+	createTableflowTopicRequestJson, err := json.Marshal(createTableflowTopicRequest)
+	if err != nil {
+		return diag.Errorf("error creating Tableflow Topic: error marshaling %#v to json: %s", createTableflowTopicRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Tableflow Topic: %s", createTableflowTopicRequestJson))
+
+	var createdTableflowTopic tableflowTopic
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodPost, tableflowTopicsPath, createTableflowTopicRequest, &createdTableflowTopic); err != nil {
+		return diag.Errorf("error creating Tableflow Topic %q: %s", displayName, createDescriptiveError(err))
+	}
+	d.SetId(displayName)
+
+	if err := waitForTableflowTopicToProvision(ctx, c, environmentId, clusterId, displayName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Tableflow Topic %q to provision: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	createdTableflowTopicJson, err := json.Marshal(createdTableflowTopic)
+	if err != nil {
+		return diag.Errorf("error creating Tableflow Topic %q: error marshaling %#v to json: %s", d.Id(), createdTableflowTopic, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Tableflow Topic %q: %s", d.Id(), createdTableflowTopicJson), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	return tableflowTopicRead(ctx, d, meta)
+}
+
+func executeTableflowTopicRead(ctx context.Context, c *Client, environmentId, clusterId, displayName string) (tableflowTopic, *http.Response, error) {
+	var topic tableflowTopic
+	resp, err := executeCloudApiRequest(ctx, c, http.MethodGet, tableflowTopicPath(environmentId, clusterId, displayName), nil, &topic)
+	return topic, resp, err
+}
+
+func tableflowTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Tableflow Topic %q", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+	if _, err := readTableflowTopicAndSetAttributes(ctx, d, meta, environmentId, clusterId, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Tableflow Topic %q: %s", d.Id(), createDescriptiveError(err)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Tableflow Topic %q", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	return nil
+}
+
+func readTableflowTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, environmentId, clusterId, displayName string) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	topic, resp, err := executeTableflowTopicRead(ctx, c, environmentId, clusterId, displayName)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Tableflow Topic %q: %s", displayName, createDescriptiveError(err)), map[string]interface{}{tableflowTopicLoggingKey: displayName})
+
+		isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Tableflow Topic %q in TF state because Tableflow Topic could not be found on the server", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+			d.SetId("")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	topicJson, err := json.Marshal(topic)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Tableflow Topic %q: error marshaling %#v to json: %s", displayName, topic, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Tableflow Topic %q: %s", displayName, topicJson), map[string]interface{}{tableflowTopicLoggingKey: displayName})
+
+	if _, err := setTableflowTopicAttributes(d, topic, environmentId, clusterId); err != nil {
+		return nil, createDescriptiveError(err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func tableflowTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramTableFormats, paramRetentionMs) {
+		return diag.Errorf("error updating Tableflow Topic %q: only %q and %q attributes can be updated for Tableflow Topic", d.Id(), paramTableFormats, paramRetentionMs)
+	}
+
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+
+	updateTableflowTopicRequest := &tableflowTopic{
+		Spec: &tableflowTopicSpec{
+			TableFormats: convertToStringSlice(d.Get(paramTableFormats).(*schema.Set).List()),
+			Config:       &tableflowTopicConfig{RetentionMs: d.Get(paramRetentionMs).(string)},
+			Environment:  &tableflowObjectRef{Id: environmentId},
+			KafkaCluster: &tableflowObjectRef{Id: clusterId, Environment: environmentId},
+		},
+	}
+	updateTableflowTopicRequestJson, err := json.Marshal(updateTableflowTopicRequest)
+	if err != nil {
+		return diag.Errorf("error updating Tableflow Topic %q: error marshaling %#v to json: %s", d.Id(), updateTableflowTopicRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updating Tableflow Topic %q: %s", d.Id(), updateTableflowTopicRequestJson), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	var updatedTableflowTopic tableflowTopic
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodPatch, tableflowTopicPath(environmentId, clusterId, d.Id()), updateTableflowTopicRequest, &updatedTableflowTopic); err != nil {
+		return diag.Errorf("error updating Tableflow Topic %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	if err := waitForTableflowTopicToProvision(ctx, c, environmentId, clusterId, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for Tableflow Topic %q to update: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	updatedTableflowTopicJson, err := json.Marshal(updatedTableflowTopic)
+	if err != nil {
+		return diag.Errorf("error updating Tableflow Topic %q: error marshaling %#v to json: %s", d.Id(), updatedTableflowTopic, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating Tableflow Topic %q: %s", d.Id(), updatedTableflowTopicJson), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	return tableflowTopicRead(ctx, d, meta)
+}
+
+func tableflowTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Tableflow Topic %q", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+	c := meta.(*Client)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+
+	if _, err := executeCloudApiRequest(ctx, c, http.MethodDelete, tableflowTopicPath(environmentId, clusterId, d.Id()), nil, nil); err != nil {
+		return diag.Errorf("error deleting Tableflow Topic %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Tableflow Topic %q", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	return nil
+}
+
+func tableflowTopicImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Tableflow Topic %q", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+
+	envIDAndClusterIDAndTopicName := d.Id()
+	parts := strings.Split(envIDAndClusterIDAndTopicName, "/")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("error importing Tableflow Topic: invalid format: expected '<env ID>/<Kafka cluster ID>/<topic name>'")
+	}
+
+	environmentId := parts[0]
+	clusterId := parts[1]
+	displayName := parts[2]
+	d.SetId(displayName)
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readTableflowTopicAndSetAttributes(ctx, d, meta, environmentId, clusterId, displayName); err != nil {
+		return nil, fmt.Errorf("error importing Tableflow Topic %q: %s", d.Id(), err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Tableflow Topic %q", d.Id()), map[string]interface{}{tableflowTopicLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func setTableflowTopicAttributes(d *schema.ResourceData, topic tableflowTopic, environmentId, clusterId string) (*schema.ResourceData, error) {
+	if topic.Spec == nil {
+		return nil, fmt.Errorf("the response is missing %q", "spec")
+	}
+	if err := d.Set(paramDisplayName, topic.Spec.DisplayName); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramTableFormats, topic.Spec.TableFormats); err != nil {
+		return nil, err
+	}
+	if topic.Spec.Config != nil {
+		if err := d.Set(paramRetentionMs, topic.Spec.Config.RetentionMs); err != nil {
+			return nil, err
+		}
+	}
+	storage := []interface{}{}
+	if topic.Spec.Storage != nil && topic.Spec.Storage.Kind == tableflowStorageByobAws {
+		storage = append(storage, map[string]interface{}{
+			paramBucketName:            topic.Spec.Storage.BucketName,
+			paramBucketRegion:          topic.Spec.Storage.BucketRegion,
+			paramProviderIntegrationId: topic.Spec.Storage.ProviderIntegrationId,
+		})
+	}
+	if err := d.Set(paramStorage, storage); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, environmentId, d); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, clusterId, d); err != nil {
+		return nil, err
+	}
+	d.SetId(topic.Spec.DisplayName)
+	return d, nil
+}
+
+func tableflowTopicPath(environmentId, clusterId, displayName string) string {
+	return fmt.Sprintf("%s/%s?%s", tableflowTopicsPath, url.PathEscape(displayName), url.Values{"environment": {environmentId}, "spec.kafka_cluster": {clusterId}}.Encode())
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	scenarioStateTableflowTopicHasBeenCreated = "The new Tableflow Topic has been just created"
+	scenarioStateTableflowTopicHasBeenUpdated = "The new Tableflow Topic has been updated"
+	scenarioStateTableflowTopicHasBeenDeleted = "The new Tableflow Topic has been deleted"
+	tableflowTopicScenarioName                = "confluent_tableflow_topic Resource Lifecycle"
+
+	tableflowTopicDisplayName           = "orders"
+	tableflowTopicEnvironmentId         = "env-00000"
+	tableflowTopicKafkaClusterId        = "lkc-00000"
+	tableflowTopicRetentionMs           = "604800000"
+	tableflowTopicBucketName            = "bucket_1"
+	tableflowTopicBucketRegion          = "us-east-1"
+	tableflowTopicProviderIntegrationId = "cspi-4xg0q"
+	tableflowTopicResourceLabel         = "test_tableflow_topic_resource_label"
+)
+
+var tableflowTopicUrlPath = fmt.Sprintf("/tableflow/v1/tableflow-topics/%s", tableflowTopicDisplayName)
+
+func TestAccTableflowTopic(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createTableflowTopicResponse, _ := ioutil.ReadFile("../testdata/tableflow_topic/create_tableflow_topic.json")
+	createTableflowTopicStub := wiremock.Post(wiremock.URLPathEqualTo("/tableflow/v1/tableflow-topics")).
+		InScenario(tableflowTopicScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains(`"provider_integration_id":"cspi-4xg0q"`)).
+		WillSetStateTo(scenarioStateTableflowTopicHasBeenCreated).
+		WillReturn(
+			string(createTableflowTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTableflowTopicStub)
+
+	readCreatedTableflowTopicResponse, _ := ioutil.ReadFile("../testdata/tableflow_topic/read_created_tableflow_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(tableflowTopicUrlPath)).
+		InScenario(tableflowTopicScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(tableflowTopicEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(tableflowTopicKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateTableflowTopicHasBeenCreated).
+		WillReturn(
+			string(readCreatedTableflowTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUpdatedTableflowTopicResponse, _ := ioutil.ReadFile("../testdata/tableflow_topic/read_updated_tableflow_topic.json")
+	updateTableflowTopicStub := wiremock.Patch(wiremock.URLPathEqualTo(tableflowTopicUrlPath)).
+		InScenario(tableflowTopicScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(tableflowTopicEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(tableflowTopicKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateTableflowTopicHasBeenCreated).
+		WithBodyPattern(wiremock.Contains("DELTA")).
+		WillSetStateTo(scenarioStateTableflowTopicHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedTableflowTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(updateTableflowTopicStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(tableflowTopicUrlPath)).
+		InScenario(tableflowTopicScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(tableflowTopicEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(tableflowTopicKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateTableflowTopicHasBeenUpdated).
+		WillReturn(
+			string(readUpdatedTableflowTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedTableflowTopicResponse, _ := ioutil.ReadFile("../testdata/tableflow_topic/read_deleted_tableflow_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(tableflowTopicUrlPath)).
+		InScenario(tableflowTopicScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(tableflowTopicEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(tableflowTopicKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateTableflowTopicHasBeenDeleted).
+		WillReturn(
+			string(readDeletedTableflowTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	deleteTableflowTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(tableflowTopicUrlPath)).
+		InScenario(tableflowTopicScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(tableflowTopicEnvironmentId)).
+		WithQueryParam("spec.kafka_cluster", wiremock.EqualTo(tableflowTopicKafkaClusterId)).
+		WhenScenarioStateIs(scenarioStateTableflowTopicHasBeenUpdated).
+		WillSetStateTo(scenarioStateTableflowTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTableflowTopicStub)
+
+	fullTableflowTopicResourceLabel := fmt.Sprintf("confluent_tableflow_topic.%s", tableflowTopicResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTableflowTopicDestroy,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTableflowTopicConfig(mockServerUrl, `["ICEBERG"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "id", tableflowTopicDisplayName),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "display_name", tableflowTopicDisplayName),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "environment.0.id", tableflowTopicEnvironmentId),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "kafka_cluster.0.id", tableflowTopicKafkaClusterId),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "table_formats.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fullTableflowTopicResourceLabel, "table_formats.*", "ICEBERG"),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "retention_ms", tableflowTopicRetentionMs),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "storage.#", "1"),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "storage.0.bucket_name", tableflowTopicBucketName),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "storage.0.bucket_region", tableflowTopicBucketRegion),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "storage.0.provider_integration_id", tableflowTopicProviderIntegrationId),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullTableflowTopicResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.RootModule().Resources
+					topicName := resources[fullTableflowTopicResourceLabel].Primary.ID
+					environmentId := resources[fullTableflowTopicResourceLabel].Primary.Attributes["environment.0.id"]
+					clusterId := resources[fullTableflowTopicResourceLabel].Primary.Attributes["kafka_cluster.0.id"]
+					return environmentId + "/" + clusterId + "/" + topicName, nil
+				},
+			},
+			{
+				Config: testAccCheckTableflowTopicConfig(mockServerUrl, `["ICEBERG", "DELTA"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "id", tableflowTopicDisplayName),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "table_formats.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullTableflowTopicResourceLabel, "table_formats.*", "ICEBERG"),
+					resource.TestCheckTypeSetElemAttr(fullTableflowTopicResourceLabel, "table_formats.*", "DELTA"),
+					resource.TestCheckResourceAttr(fullTableflowTopicResourceLabel, "storage.0.bucket_name", tableflowTopicBucketName),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createTableflowTopicStub, "POST /tableflow/v1/tableflow-topics", expectedCountOne)
+	checkStubCount(t, wiremockClient, updateTableflowTopicStub, fmt.Sprintf("PATCH %s", tableflowTopicUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTableflowTopicStub, fmt.Sprintf("DELETE %s", tableflowTopicUrlPath), expectedCountOne)
+}
+
+func testAccCheckTableflowTopicDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+	// Loop through the resources in state, verifying each Tableflow Topic is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_tableflow_topic" {
+			continue
+		}
+		deletedTableflowTopic, response, err := executeTableflowTopicRead(context.Background(), c, rs.Primary.Attributes["environment.0.id"], rs.Primary.Attributes["kafka_cluster.0.id"], rs.Primary.ID)
+		if isNonKafkaRestApiResourceNotFound(response) {
+			return nil
+		} else if err == nil && deletedTableflowTopic.Spec != nil && deletedTableflowTopic.Spec.DisplayName == rs.Primary.ID {
+			return fmt.Errorf("Tableflow Topic (%q) still exists", rs.Primary.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccCheckTableflowTopicConfig(mockServerUrl, tableFormats string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	resource "confluent_tableflow_topic" "%s" {
+		display_name = "%s"
+		environment {
+			id = "%s"
+		}
+		kafka_cluster {
+			id = "%s"
+		}
+		table_formats = %s
+		storage {
+			bucket_name             = "%s"
+			provider_integration_id = "%s"
+		}
+	}
+	`, mockServerUrl, tableflowTopicResourceLabel, tableflowTopicDisplayName, tableflowTopicEnvironmentId, tableflowTopicKafkaClusterId, tableFormats, tableflowTopicBucketName, tableflowTopicProviderIntegrationId)
+}
@@ -70,8 +70,9 @@ func tagResource() *schema.Resource {
 			paramEntityTypes: {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
 				Computed:    true,
-				Description: "The entity type of the tag to be created.",
+				Description: "The entity types of the tag to be created.",
 			},
 			paramVersion: {
 				Type:        schema.TypeInt,
@@ -104,7 +105,7 @@ func tagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	tagRequest.SetName(tagName)
 	description := d.Get(paramDescription).(string)
 	tagRequest.SetDescription(description)
-	tagRequest.SetEntityTypes(defaultEntityTypes)
+	tagRequest.SetEntityTypes(extractTagEntityTypes(d))
 
 	request := schemaRegistryRestClient.dataCatalogApiClient.TypesV1Api.CreateTagDefs(schemaRegistryRestClient.dataCatalogApiContext(ctx))
 	request = request.TagDef([]dc.TagDef{tagRequest})
@@ -235,8 +236,8 @@ func tagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 }
 
 func tagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangeExcept(paramDescription) {
-		return diag.Errorf("error updating Tag %q: only %q attribute can be updated for Tag", d.Id(), paramDescription)
+	if d.HasChangesExcept(paramDescription, paramEntityTypes) {
+		return diag.Errorf("error updating Tag %q: only %q and %q attributes can be updated for Tag", d.Id(), paramDescription, paramEntityTypes)
 	}
 
 	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
@@ -260,6 +261,7 @@ func tagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	tagRequest.SetName(tagName)
 	description := d.Get(paramDescription).(string)
 	tagRequest.SetDescription(description)
+	tagRequest.SetEntityTypes(extractTagEntityTypes(d))
 
 	request := schemaRegistryRestClient.dataCatalogApiClient.TypesV1Api.UpdateTagDefs(schemaRegistryRestClient.dataCatalogApiContext(ctx))
 	request = request.TagDef([]dc.TagDef{tagRequest})
@@ -311,6 +313,14 @@ func tagImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
+func extractTagEntityTypes(d *schema.ResourceData) []string {
+	entityTypes := convertToStringSlice(d.Get(paramEntityTypes).(*schema.Set).List())
+	if len(entityTypes) == 0 {
+		return defaultEntityTypes
+	}
+	return entityTypes
+}
+
 func createTagId(clusterId, tagName string) string {
 	return fmt.Sprintf("%s/%s", clusterId, tagName)
 }
@@ -0,0 +1,163 @@
+// Copyright 2023 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const (
+	tagBindingKafkaTopicResourceScenarioName        = "confluent_tag_binding kafka_topic Resource Lifecycle"
+	scenarioStateTagBindingKafkaTopicHasBeenDeleted = "The kafka_topic tag binding has been deleted"
+	readCreatedTagBindingKafkaTopicUrlPath          = "/catalog/v1/entity/type/kafka_topic/name/lsrc-8wrx70:lkc-m80307:topic_0/tags"
+	deleteCreatedTagBindingKafkaTopicUrlPath        = "/catalog/v1/entity/type/kafka_topic/name/lsrc-8wrx70:lkc-m80307:topic_0/tags/tag1"
+	tagBindingKafkaTopicLabel                       = "confluent_tag_binding.topic"
+)
+
+func TestAccTagBindingKafkaTopic(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createTagBindingResponse, _ := ioutil.ReadFile("../testdata/tag/create_tag_binding_kafka_topic.json")
+	createTagBindingStub := wiremock.Post(wiremock.URLPathEqualTo(createTagBindingUrlPath)).
+		InScenario(tagBindingKafkaTopicResourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.Contains("kafka_topic")).
+		WillSetStateTo(scenarioStateTagBindingHasBeenCreated).
+		WillReturn(
+			string(createTagBindingResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createTagBindingStub)
+
+	readTagBindingResponse, _ := ioutil.ReadFile("../testdata/tag/read_tag_binding_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedTagBindingKafkaTopicUrlPath)).
+		InScenario(tagBindingKafkaTopicResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateTagBindingHasBeenCreated).
+		WillReturn(
+			string(readTagBindingResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteTagBindingStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteCreatedTagBindingKafkaTopicUrlPath)).
+		InScenario(tagBindingKafkaTopicResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateTagBindingHasBeenCreated).
+		WillSetStateTo(scenarioStateTagBindingKafkaTopicHasBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteTagBindingStub)
+
+	readDeletedTagBindingResponse, _ := ioutil.ReadFile("../testdata/tag/read_deleted_tag_binding_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedTagBindingKafkaTopicUrlPath)).
+		InScenario(tagBindingKafkaTopicResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateTagBindingKafkaTopicHasBeenDeleted).
+		WillReturn(
+			string(readDeletedTagBindingResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckTagBindingDestroy(s, mockServerUrl)
+		},
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: tagBindingKafkaTopicResourceConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tagBindingKafkaTopicLabel, "tag_name", "tag1"),
+					resource.TestCheckResourceAttr(tagBindingKafkaTopicLabel, "entity_name", "lsrc-8wrx70:lkc-m80307:topic_0"),
+					resource.TestCheckResourceAttr(tagBindingKafkaTopicLabel, "entity_type", "kafka_topic"),
+					resource.TestCheckResourceAttr(tagBindingKafkaTopicLabel, "id", "xxx/tag1/lsrc-8wrx70:lkc-m80307:topic_0/kafka_topic"),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      tagBindingKafkaTopicLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createTagBindingStub, fmt.Sprintf("POST %s", createTagBindingUrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteTagBindingStub, fmt.Sprintf("DELETE %s", deleteCreatedTagBindingKafkaTopicUrlPath), expectedCountOne)
+}
+
+func testAccCheckTagBindingDestroy(s *terraform.State, url string) error {
+	c := testAccProvider.Meta().(*Client).schemaRegistryRestClientFactory.CreateDataCatalogClient(url, "xxx", "x", "x", false)
+	// Loop through the resources in state, verifying each Tag Binding is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "confluent_tag_binding" {
+			continue
+		}
+		tagName := rs.Primary.Attributes[paramTagName]
+		entityName := rs.Primary.Attributes[paramEntityName]
+		entityType := rs.Primary.Attributes[paramEntityType]
+		tagBindings, _, err := c.dataCatalogApiClient.EntityV1Api.GetTags(c.dataCatalogApiContext(context.Background()), entityType, entityName).Execute()
+		if err != nil {
+			return err
+		}
+		if _, err := findTagBindingByTagName(tagBindings, tagName); err == nil {
+			return fmt.Errorf("tag binding (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func tagBindingKafkaTopicResourceConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+ 	provider "confluent" {
+ 	  schema_registry_id = "xxx"
+	  schema_registry_rest_endpoint = "%s" # optionally use SCHEMA_REGISTRY_REST_ENDPOINT env var
+	  schema_registry_api_key       = "x"       # optionally use SCHEMA_REGISTRY_API_KEY env var
+	  schema_registry_api_secret = "x"
+ 	}
+ 	resource "confluent_tag_binding" "topic" {
+	  tag_name = "tag1"
+	  entity_name = "lsrc-8wrx70:lkc-m80307:topic_0"
+	  entity_type = "kafka_topic"
+	}
+ 	`, mockServerUrl)
+}
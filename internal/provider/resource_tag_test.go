@@ -25,13 +25,14 @@ import (
 )
 
 const (
-	tagResourceScenarioName        = "confluent_tag Resource Lifecycle"
-	scenarioStateTagHasBeenCreated = "A new tag has been just created"
-	scenarioStateTagHasBeenPending = "A new tag has been just pending"
-	scenarioStateTagHasBeenUpdated = "A new tag has been just updated"
-	createTagUrlPath               = "/catalog/v1/types/tagdefs"
-	readCreatedTagUrlPath          = "/catalog/v1/types/tagdefs/test1"
-	tagLabel                       = "confluent_tag.mytag"
+	tagResourceScenarioName                       = "confluent_tag Resource Lifecycle"
+	scenarioStateTagHasBeenCreated                = "A new tag has been just created"
+	scenarioStateTagHasBeenPending                = "A new tag has been just pending"
+	scenarioStateTagHasBeenUpdated                = "A new tag has been just updated"
+	scenarioStateTagHasBeenUpdatedWithEntityTypes = "A new tag has been just updated with entity types"
+	createTagUrlPath                              = "/catalog/v1/types/tagdefs"
+	readCreatedTagUrlPath                         = "/catalog/v1/types/tagdefs/test1"
+	tagLabel                                      = "confluent_tag.mytag"
 )
 
 func TestAccTag(t *testing.T) {
@@ -103,6 +104,28 @@ func TestAccTag(t *testing.T) {
 			http.StatusOK,
 		))
 
+	updateTagEntityTypesResponse, _ := ioutil.ReadFile("../testdata/tag/update_tag_entity_types.json")
+	_ = wiremockClient.StubFor(wiremock.Put(wiremock.URLPathEqualTo(createTagUrlPath)).
+		InScenario(tagResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateTagHasBeenUpdated).
+		WithBodyPattern(wiremock.Contains("kafka_topic")).
+		WillSetStateTo(scenarioStateTagHasBeenUpdatedWithEntityTypes).
+		WillReturn(
+			string(updateTagEntityTypesResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	readUpdatedTagEntityTypesResponse, _ := ioutil.ReadFile("../testdata/tag/read_updated_tag_entity_types.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readCreatedTagUrlPath)).
+		InScenario(tagResourceScenarioName).
+		WhenScenarioStateIs(scenarioStateTagHasBeenUpdatedWithEntityTypes).
+		WillReturn(
+			string(readUpdatedTagEntityTypesResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
 	_ = wiremockClient.StubFor(wiremock.Delete(wiremock.URLPathEqualTo(readCreatedTagUrlPath)).
 		InScenario(tagResourceScenarioName).
 		WillReturn(
@@ -139,6 +162,18 @@ func TestAccTag(t *testing.T) {
 					resource.TestCheckResourceAttr(tagLabel, "entity_types.0", "cf_entity"),
 				),
 			},
+			{
+				Config: tagResourceUpdatedEntityTypesConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tagLabel, "id", "xxx/test1"),
+					resource.TestCheckResourceAttr(tagLabel, "name", "test1"),
+					resource.TestCheckResourceAttr(tagLabel, "description", "test1UpdatedDescription"),
+					resource.TestCheckResourceAttr(tagLabel, "version", "3"),
+					resource.TestCheckResourceAttr(tagLabel, "entity_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(tagLabel, "entity_types.*", "cf_entity"),
+					resource.TestCheckTypeSetElemAttr(tagLabel, "entity_types.*", "kafka_topic"),
+				),
+			},
 		},
 	})
 }
@@ -173,3 +208,19 @@ func tagResourceUpdatedConfig(mockServerUrl string) string {
 	}
  	`, mockServerUrl)
 }
+
+func tagResourceUpdatedEntityTypesConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+ 	provider "confluent" {
+ 	  schema_registry_id = "xxx"
+	  schema_registry_rest_endpoint = "%s" # optionally use SCHEMA_REGISTRY_REST_ENDPOINT env var
+	  schema_registry_api_key       = "x"       # optionally use SCHEMA_REGISTRY_API_KEY env var
+	  schema_registry_api_secret = "x"
+ 	}
+ 	resource "confluent_tag" "mytag" {
+	  name = "test1"
+	  description = "test1UpdatedDescription"
+	  entity_types = ["cf_entity", "kafka_topic"]
+	}
+ 	`, mockServerUrl)
+}
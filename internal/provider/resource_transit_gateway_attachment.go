@@ -21,6 +21,7 @@ import (
 	net "github.com/confluentinc/ccloud-sdk-go-v2/networking/v1"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
@@ -62,6 +63,7 @@ func transitGatewayAttachmentResource() *schema.Resource {
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(validateReferencedEnvironmentCustomizeDiff),
 	}
 }
 
@@ -70,7 +72,10 @@ func transitGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 
 	displayName := d.Get(paramDisplayName).(string)
 	networkId := extractStringValueFromBlock(d, paramNetwork, paramId)
-	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	environmentId, err := extractEnvironmentId(c, d)
+	if err != nil {
+		return diag.Errorf("error creating Transit Gateway Attachment: %s", createDescriptiveError(err))
+	}
 
 	isAwsTransitGatewayAttachment := len(d.Get(paramAws).([]interface{})) > 0
 
@@ -103,7 +108,7 @@ func transitGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	d.SetId(createdTransitGatewayAttachment.GetId())
 
-	if err := waitForTransitGatewayAttachmentToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForTransitGatewayAttachmentToProvision(ctx, c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Transit Gateway Attachment %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -207,7 +212,7 @@ func transitGatewayAttachmentDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("error deleting Transit Gateway Attachment %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForTransitGatewayAttachmentToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForTransitGatewayAttachmentToBeDeleted(ctx, c, environmentId, d.Id()); err != nil {
 		return diag.Errorf("error waiting for Transit Gateway Attachment %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
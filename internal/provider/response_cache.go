@@ -0,0 +1,68 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"sync"
+)
+
+// responseCache memoizes read-only lookups of Environments and Kafka Clusters for the lifetime of a single
+// provider instance (i.e., a single plan or apply), so that the same object isn't fetched again by every
+// resource that references it. A nil *responseCache is valid and disables caching.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]interface{}
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: make(map[string]interface{})}
+}
+
+func (rc *responseCache) get(key string) (interface{}, bool) {
+	if rc == nil {
+		return nil, false
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	value, ok := rc.entries[key]
+	return value, ok
+}
+
+func (rc *responseCache) set(key string, value interface{}) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries[key] = value
+}
+
+func (rc *responseCache) invalidate(key string) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	delete(rc.entries, key)
+}
+
+func environmentCacheKey(environmentId string) string {
+	return fmt.Sprintf("environment/%s", environmentId)
+}
+
+func kafkaClusterCacheKey(environmentId, clusterId string) string {
+	return fmt.Sprintf("kafka_cluster/%s/%s", environmentId, clusterId)
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	responseCacheTestEnvironmentId = "env-abc123"
+	responseCacheTestClusterId     = "lkc-abc123"
+)
+
+// newResponseCacheTestClient returns a Client pointed at a stub server that counts the GET requests it receives.
+func newResponseCacheTestClient(t *testing.T, cache *responseCache) (*Client, *int32) {
+	var getCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			atomic.AddInt32(&getCount, 1)
+			if r.URL.Path == fmt.Sprintf("/cmk/v2/clusters/%s", responseCacheTestClusterId) {
+				_, _ = fmt.Fprintf(w, `{"id": %q, "spec": {"display_name": "basic", "http_endpoint": "https://pkc-00000.us-east-1.aws.confluent.cloud:443"}}`, responseCacheTestClusterId)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"id": %q, "display_name": "prod"}`, responseCacheTestEnvironmentId)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	orgCfg := org.NewConfiguration()
+	orgCfg.Servers[0].URL = server.URL
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = server.URL
+	return &Client{
+		orgClient:            org.NewAPIClient(orgCfg),
+		cmkClient:            cmk.NewAPIClient(cmkCfg),
+		isAcceptanceTestMode: true,
+		responseCache:        cache,
+	}, &getCount
+}
+
+func TestResponseCacheEnvironmentLookup(t *testing.T) {
+	ctx := context.Background()
+	c, getCount := newResponseCacheTestClient(t, newResponseCache())
+
+	for i := 0; i < 2; i++ {
+		environment, err := executeCachedEnvironmentRead(ctx, c, responseCacheTestEnvironmentId)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if environment.GetDisplayName() != "prod" {
+			t.Fatalf("expected display_name %q, got %q", "prod", environment.GetDisplayName())
+		}
+	}
+	if got := atomic.LoadInt32(getCount); got != 1 {
+		t.Fatalf("expected 1 GET request for 2 lookups of the same Environment, got %d", got)
+	}
+
+	// Any write to the Environment drops its cached copy
+	d := schema.TestResourceDataRaw(t, environmentResource().Schema, map[string]interface{}{})
+	d.SetId(responseCacheTestEnvironmentId)
+	if diags := environmentDelete(ctx, d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if _, err := executeCachedEnvironmentRead(ctx, c, responseCacheTestEnvironmentId); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(getCount); got != 2 {
+		t.Fatalf("expected 2 GET requests after the Environment was deleted, got %d", got)
+	}
+}
+
+func TestResponseCacheKafkaClusterLookup(t *testing.T) {
+	ctx := context.Background()
+	c, getCount := newResponseCacheTestClient(t, newResponseCache())
+
+	for i := 0; i < 2; i++ {
+		restEndpoint, err := fetchHttpEndpointOfKafkaCluster(ctx, c, responseCacheTestEnvironmentId, responseCacheTestClusterId)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if restEndpoint != "https://pkc-00000.us-east-1.aws.confluent.cloud:443" {
+			t.Fatalf("unexpected rest_endpoint %q", restEndpoint)
+		}
+	}
+	if got := atomic.LoadInt32(getCount); got != 1 {
+		t.Fatalf("expected 1 GET request for 2 lookups of the same Kafka Cluster, got %d", got)
+	}
+
+	// Kafka Clusters are scoped to an Environment
+	if _, err := executeCachedKafkaRead(ctx, c, "env-def456", responseCacheTestClusterId); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(getCount); got != 2 {
+		t.Fatalf("expected 2 GET requests for lookups in different Environments, got %d", got)
+	}
+}
+
+func TestResponseCacheDisabled(t *testing.T) {
+	ctx := context.Background()
+	c, getCount := newResponseCacheTestClient(t, nil)
+
+	for i := 0; i < 2; i++ {
+		if _, err := executeCachedEnvironmentRead(ctx, c, responseCacheTestEnvironmentId); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got := atomic.LoadInt32(getCount); got != 2 {
+		t.Fatalf("expected 2 GET requests when the response cache is disabled, got %d", got)
+	}
+}
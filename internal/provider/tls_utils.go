@@ -0,0 +1,90 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	paramCaBundle   = "ca_bundle"
+	paramClientCert = "client_cert"
+	paramClientKey  = "client_key"
+	pemBlockPrefix  = "-----BEGIN"
+)
+
+// loadPEM returns PEM-encoded data that is either passed inline or stored in a file located at the given path.
+func loadPEM(attributeName, value string) ([]byte, error) {
+	if strings.Contains(value, pemBlockPrefix) {
+		return []byte(value), nil
+	}
+	data, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %q file: %s", attributeName, err)
+	}
+	return data, nil
+}
+
+// buildTLSConfig creates a TLS configuration that trusts system root CAs as well as CAs from caBundle and
+// presents a client certificate for mTLS when clientCert and clientKey are set.
+// It returns nil when none of the arguments are set so that the default TLS configuration is used.
+func buildTLSConfig(caBundle, clientCert, clientKey string) (*tls.Config, error) {
+	if caBundle == "" && clientCert == "" && clientKey == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caBundle != "" {
+		caBundlePEM, err := loadPEM(paramCaBundle, caBundle)
+		if err != nil {
+			return nil, err
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caBundlePEM) {
+			return nil, fmt.Errorf("%q must contain at least one PEM-encoded certificate", paramCaBundle)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			return nil, fmt.Errorf("%q and %q must be set together", paramClientCert, paramClientKey)
+		}
+		clientCertPEM, err := loadPEM(paramClientCert, clientCert)
+		if err != nil {
+			return nil, err
+		}
+		clientKeyPEM, err := loadPEM(paramClientKey, clientKey)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error loading %q and %q: %s", paramClientCert, paramClientKey, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	privateKey  *ecdsa.PrivateKey
+	certPEM     string
+	keyPEM      string
+}
+
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *testCertificate) *testCertificate {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parentCertificate, parentKey := template, privateKey
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.privateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCertificate, &privateKey.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{
+		certificate: certificate,
+		privateKey:  privateKey,
+		certPEM:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:      string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})),
+	}
+}
+
+func newTestCertificateTemplate(serialNumber int64, commonName string, isCA bool) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+	return template
+}
+
+// newTestTLSServer starts a server with a certificate issued by a private CA that optionally requires client certificates
+// issued by the same CA.
+func newTestTLSServer(t *testing.T, ca *testCertificate, requireClientCert bool) *httptest.Server {
+	serverCert := newTestCertificate(t, newTestCertificateTemplate(2, "server", false), ca)
+	keyPair, err := tls.X509KeyPair([]byte(serverCert.certPEM), []byte(serverCert.keyPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{keyPair}}
+	if requireClientCert {
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(ca.certificate)
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+		server.TLS.ClientCAs = clientCAs
+	}
+	server.StartTLS()
+	return server
+}
+
+func sendTestRequest(tlsConfig *tls.Config, url string) error {
+	client := NewRetryableClientFactory(context.Background(), WithMaxRetries(0), WithTLSConfig(tlsConfig)).CreateRetryableClient()
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func TestBuildTLSConfigTrustsCustomCA(t *testing.T) {
+	ca := newTestCertificate(t, newTestCertificateTemplate(1, "test-ca", true), nil)
+	server := newTestTLSServer(t, ca, false)
+	defer server.Close()
+
+	if err := sendTestRequest(nil, server.URL); err == nil || !strings.Contains(err.Error(), "x509") {
+		t.Fatalf("Expected a certificate verification error without a custom CA bundle, got %v", err)
+	}
+
+	caBundlePath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caBundlePath, []byte(ca.certPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for name, caBundle := range map[string]string{"file": caBundlePath, "inline": ca.certPEM} {
+		t.Run(name, func(t *testing.T) {
+			tlsConfig, err := buildTLSConfig(caBundle, "", "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := sendTestRequest(tlsConfig, server.URL); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestBuildTLSConfigWithClientCertificate(t *testing.T) {
+	ca := newTestCertificate(t, newTestCertificateTemplate(1, "test-ca", true), nil)
+	clientCert := newTestCertificate(t, newTestCertificateTemplate(3, "client", false), ca)
+	server := newTestTLSServer(t, ca, true)
+	defer server.Close()
+
+	tlsConfig, err := buildTLSConfig(ca.certPEM, "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := sendTestRequest(tlsConfig, server.URL); err == nil {
+		t.Fatalf("Expected an error without a client certificate")
+	}
+
+	tlsConfig, err = buildTLSConfig(ca.certPEM, clientCert.certPEM, clientCert.keyPEM)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := sendTestRequest(tlsConfig, server.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestBuildTLSConfigErrors(t *testing.T) {
+	ca := newTestCertificate(t, newTestCertificateTemplate(1, "test-ca", true), nil)
+	clientCert := newTestCertificate(t, newTestCertificateTemplate(3, "client", false), ca)
+
+	tests := []struct {
+		name          string
+		caBundle      string
+		clientCert    string
+		clientKey     string
+		expectedError string
+	}{
+		{name: "missing CA bundle file", caBundle: filepath.Join(t.TempDir(), "missing.pem"), expectedError: "error reading \"ca_bundle\" file"},
+		{name: "CA bundle without certificates", caBundle: "-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----\n", expectedError: "must contain at least one PEM-encoded certificate"},
+		{name: "client certificate without key", clientCert: clientCert.certPEM, expectedError: "must be set together"},
+		{name: "mismatched client key", clientCert: clientCert.certPEM, clientKey: ca.keyPEM, expectedError: "error loading \"client_cert\" and \"client_key\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildTLSConfig(tt.caBundle, tt.clientCert, tt.clientKey)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+			}
+		})
+	}
+
+	if tlsConfig, err := buildTLSConfig("", "", ""); tlsConfig != nil || err != nil {
+		t.Fatalf("Expected no TLS configuration, got (%v, %v)", tlsConfig, err)
+	}
+}
@@ -17,6 +17,7 @@ package provider
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
 	byok "github.com/confluentinc/ccloud-sdk-go-v2/byok/v1"
@@ -115,8 +116,21 @@ const (
 	schemaRegistryKekKey                      = "kek_id"
 	schemaRegistryDekKey                      = "dek_id"
 	entityAttributesLoggingKey                = "entity_attributes_id"
+	ipFilterLoggingKey                        = "ip_filter_id"
+	ipGroupLoggingKey                         = "ip_group_id"
+	certificateAuthorityLoggingKey            = "certificate_authority_id"
+	certificatePoolLoggingKey                 = "certificate_pool_id"
+	providerIntegrationLoggingKey             = "provider_integration_id"
+	tableflowTopicLoggingKey                  = "tableflow_topic_id"
+	catalogIntegrationLoggingKey              = "catalog_integration_id"
+	flinkArtifactLoggingKey                   = "flink_artifact_id"
 )
 
+// isCloudApiAccessible returns true when Cloud API requests are authenticated, either with the Cloud API Key or with OAuth tokens.
+func (c *Client) isCloudApiAccessible() bool {
+	return c.cloudApiKey != "" || c.isOAuthEnabled
+}
+
 func (c *Client) apiKeysApiContext(ctx context.Context) context.Context {
 	if c.cloudApiKey != "" && c.cloudApiSecret != "" {
 		return context.WithValue(context.Background(), apikeys.ContextBasicAuth, apikeys.BasicAuth{
@@ -527,6 +541,89 @@ func createDescriptiveError(err error) error {
 	return fmt.Errorf(errorMessage)
 }
 
+// apiRequestConfig holds the settings of a ccloud-sdk-go-v2 Configuration (endpoint, HTTP client, and headers)
+// along with the basic auth credentials that are needed to send a request directly.
+type apiRequestConfig struct {
+	serverUrl     string
+	userAgent     string
+	defaultHeader map[string]string
+	httpClient    *http.Client
+	userName      string
+	password      string
+}
+
+type apiFailure struct {
+	Errors []struct {
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+// executeApiRequest sends a request directly for endpoints that ccloud-sdk-go-v2 doesn't support yet.
+// The error contains the status and either errors[0].detail or the raw body of the response.
+func executeApiRequest(ctx context.Context, cfg apiRequestConfig, method, path string, requestBody, responseBody interface{}) (*http.Response, error) {
+	var body io.Reader
+	if requestBody != nil {
+		requestBodyJson, err := json.Marshal(requestBody)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling %#v to json: %s", requestBody, createDescriptiveError(err))
+		}
+		body = bytes.NewReader(requestBodyJson)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, cfg.serverUrl+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", cfg.userAgent)
+	for header, value := range cfg.defaultHeader {
+		req.Header.Set(header, value)
+	}
+	if cfg.userName != "" {
+		req.SetBasicAuth(cfg.userName, cfg.password)
+	}
+	resp, err := cfg.httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	responseBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, fmt.Errorf("%s: error reading response body: %s", resp.Status, err)
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var failure apiFailure
+		if err := json.Unmarshal(responseBodyBytes, &failure); err == nil && len(failure.Errors) > 0 && failure.Errors[0].Detail != "" {
+			return resp, fmt.Errorf("%s: %s", resp.Status, failure.Errors[0].Detail)
+		}
+		if len(responseBodyBytes) > 0 {
+			return resp, fmt.Errorf("%s: %s", resp.Status, responseBodyBytes)
+		}
+		return resp, fmt.Errorf("%s", resp.Status)
+	}
+	if responseBody != nil && len(responseBodyBytes) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(responseBodyBytes))
+		// Keep large numbers (for example, 9007199254740993) intact
+		decoder.UseNumber()
+		if err := decoder.Decode(responseBody); err != nil {
+			return resp, fmt.Errorf("error unmarshaling %q: %s", responseBodyBytes, err)
+		}
+	}
+	return resp, nil
+}
+
+// executeCloudApiRequest sends a request to Confluent Cloud API directly for APIs that ccloud-sdk-go-v2 doesn't support yet
+func executeCloudApiRequest(ctx context.Context, c *Client, method, path string, requestBody, responseBody interface{}) (*http.Response, error) {
+	cfg := c.cloudApiRequestConfig
+	if c.cloudApiKey != "" && c.cloudApiSecret != "" {
+		cfg.userName = c.cloudApiKey
+		cfg.password = c.cloudApiSecret
+	}
+	return executeApiRequest(ctx, cfg, method, path, requestBody, responseBody)
+}
+
 // Reports whether the response has http.StatusForbidden status due to an invalid Cloud API Key vs other reasons
 // which is useful to distinguish from scenarios where http.StatusForbidden represents http.StatusNotFound for
 // security purposes.
@@ -684,6 +781,25 @@ func extractPageToken(nextPageUrlString string) (string, error) {
 	return pageToken, nil
 }
 
+// paginate walks all pages of a list API: listPage is called with the page token of every page (empty for the first page),
+// is expected to collect the items of that page, and returns the metadata.next URL, which is empty for the last page.
+func paginate(listPage func(pageToken string) (string, error)) error {
+	pageToken := ""
+	for {
+		nextPageUrlString, err := listPage(pageToken)
+		if err != nil {
+			return err
+		}
+		if nextPageUrlString == "" {
+			return nil
+		}
+		pageToken, err = extractPageToken(nextPageUrlString)
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func verifyListValues(values, acceptedValues []string, ignoreCase bool) error {
 	for _, actualValue := range values {
 		found := stringInSlice(actualValue, acceptedValues, ignoreCase)
@@ -775,10 +891,14 @@ func uploadFile(url, filePath string, formFields map[string]any) error {
 	client := &http.Client{
 		Timeout: 20 * time.Minute,
 	}
-	_, err = sling.New().Client(client).Base(url).Set("Content-Type", writer.FormDataContentType()).Post("").Body(&buffer).ReceiveSuccess(nil)
+	resp, err := sling.New().Client(client).Base(url).Set("Content-Type", writer.FormDataContentType()).Post("").Body(&buffer).ReceiveSuccess(nil)
 	if err != nil {
 		return err
 	}
+	// sling doesn't return an error for non-2xx responses, for example, when the presigned URL has expired
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %q", resp.Status)
+	}
 
 	return nil
 }
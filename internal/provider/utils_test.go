@@ -16,7 +16,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
@@ -97,6 +100,306 @@ func TestExtractOrgIdFromResourceName(t *testing.T) {
 	}
 }
 
+func TestNormalizePrincipal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{
+			input:    "sa-abc123",
+			expected: "User:sa-abc123",
+			err:      nil,
+		},
+		{
+			input:    "u-abc123",
+			expected: "User:u-abc123",
+			err:      nil,
+		},
+		{
+			input:    "User:sa-abc123",
+			expected: "User:sa-abc123",
+			err:      nil,
+		},
+		{
+			input:    "User:u-abc123",
+			expected: "User:u-abc123",
+			err:      nil,
+		},
+		{
+			input:    "User:pool-abc123",
+			expected: "User:pool-abc123",
+			err:      nil,
+		},
+		{
+			input:    "User:group-abc123",
+			expected: "User:group-abc123",
+			err:      nil,
+		},
+		{
+			input:    "User:*",
+			expected: "User:*",
+			err:      nil,
+		},
+		{
+			input:    "pool-abc123",
+			expected: "",
+			err: fmt.Errorf("invalid principal \"pool-abc123\": the principal must be a service account or user ID (for example, 'sa-abc123' or 'u-abc123') " +
+				"or start with 'User:sa-' or 'User:u-' or 'User:pool-' or 'User:group-' or be 'User:*'"),
+		},
+		{
+			input:    "User:abc123",
+			expected: "",
+			err: fmt.Errorf("invalid principal \"User:abc123\": the principal must be a service account or user ID (for example, 'sa-abc123' or 'u-abc123') " +
+				"or start with 'User:sa-' or 'User:u-' or 'User:pool-' or 'User:group-' or be 'User:*'"),
+		},
+		{
+			input:    "Group:sa-abc123",
+			expected: "",
+			err: fmt.Errorf("invalid principal \"Group:sa-abc123\": the principal must be a service account or user ID (for example, 'sa-abc123' or 'u-abc123') " +
+				"or start with 'User:sa-' or 'User:u-' or 'User:pool-' or 'User:group-' or be 'User:*'"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := normalizePrincipal(tt.input)
+			if !reflect.DeepEqual(err, tt.err) {
+				t.Fatalf("Unexpected error: expected %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Fatalf("Unexpected result: expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestValidateIdentityPoolFilter(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+	}{
+		{
+			input: "claims.aud==\"confluent\" && claims.group!=\"invalid_group\"",
+			err:   nil,
+		},
+		{
+			input: "has(claims.groups) && (\"engineering\" in claims.groups || claims.sub.startsWith(\"sa-\"))",
+			err:   nil,
+		},
+		{
+			input: "claims[\"custom-claim\"] == 'value (with parentheses'",
+			err:   nil,
+		},
+		{
+			input: "",
+			err:   fmt.Errorf("invalid filter: expected a non-empty CEL expression"),
+		},
+		{
+			input: "(claims.aud==\"confluent\"",
+			err:   fmt.Errorf("invalid filter \"(claims.aud==\\\"confluent\\\"\": unclosed '(' at position 0"),
+		},
+		{
+			input: "claims.aud==\"confluent\")",
+			err:   fmt.Errorf("invalid filter \"claims.aud==\\\"confluent\\\")\": unexpected ')' at position 23"),
+		},
+		{
+			input: "claims.aud==\"confluent",
+			err:   fmt.Errorf("invalid filter \"claims.aud==\\\"confluent\": unterminated string literal starting at position 12"),
+		},
+		{
+			input: "claims.groups.exists(g, g.startsWith(\"eng\"))",
+			err:   nil,
+		},
+		{
+			input: "claims.groups.all(g, g.endsWith(\"@example.com\")) && claims.roles.exists_one(r, r == \"admin\")",
+			err:   nil,
+		},
+		{
+			input: "claims.groups.filter(g, g.startsWith(\"eng\")).map(g, g.size()).size() > 0",
+			err:   nil,
+		},
+		{
+			input: "true",
+			err:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			err := validateIdentityPoolFilter(tt.input)
+			if !reflect.DeepEqual(err, tt.err) {
+				t.Fatalf("Unexpected error: expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestBuildRoleBindingCrnPattern(t *testing.T) {
+	kafkaClusterRbacCrn := "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123/cloud-cluster=lkc-abc123"
+	tests := []struct {
+		name                string
+		kafkaClusterRbacCrn string
+		resourceType        string
+		resourceName        string
+		patternType         string
+		expected            string
+		err                 error
+	}{
+		{
+			name:                "literal topic",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "TOPIC",
+			resourceName:        "orders",
+			patternType:         "LITERAL",
+			expected:            kafkaClusterRbacCrn + "/kafka=lkc-abc123/topic=orders",
+			err:                 nil,
+		},
+		{
+			name:                "topic with the default pattern type",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "TOPIC",
+			resourceName:        "orders",
+			patternType:         "",
+			expected:            kafkaClusterRbacCrn + "/kafka=lkc-abc123/topic=orders",
+			err:                 nil,
+		},
+		{
+			name:                "prefixed topic",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "TOPIC",
+			resourceName:        "orders_",
+			patternType:         "PREFIXED",
+			expected:            kafkaClusterRbacCrn + "/kafka=lkc-abc123/topic=orders_*",
+			err:                 nil,
+		},
+		{
+			name:                "prefixed group",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "GROUP",
+			resourceName:        "connect-",
+			patternType:         "PREFIXED",
+			expected:            kafkaClusterRbacCrn + "/kafka=lkc-abc123/group=connect-*",
+			err:                 nil,
+		},
+		{
+			name:                "literal transactional id",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "TRANSACTIONAL_ID",
+			resourceName:        "producer-1",
+			patternType:         "LITERAL",
+			expected:            kafkaClusterRbacCrn + "/kafka=lkc-abc123/transactional-id=producer-1",
+			err:                 nil,
+		},
+		{
+			name:                "cluster",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "CLUSTER",
+			resourceName:        "",
+			patternType:         "",
+			expected:            kafkaClusterRbacCrn + "/kafka=lkc-abc123",
+			err:                 nil,
+		},
+		{
+			name:                "cluster with a resource name",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "CLUSTER",
+			resourceName:        "kafka-cluster",
+			patternType:         "",
+			expected:            "",
+			err:                 fmt.Errorf("\"resource_name\" and \"pattern_type\" must not be set when \"resource_type\" is \"CLUSTER\""),
+		},
+		{
+			name:                "topic without a resource name",
+			kafkaClusterRbacCrn: kafkaClusterRbacCrn,
+			resourceType:        "TOPIC",
+			resourceName:        "",
+			patternType:         "LITERAL",
+			expected:            "",
+			err:                 fmt.Errorf("\"resource_name\" must be set when \"resource_type\" is \"TOPIC\""),
+		},
+		{
+			name:                "environment CRN",
+			kafkaClusterRbacCrn: "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123",
+			resourceType:        "TOPIC",
+			resourceName:        "orders",
+			patternType:         "LITERAL",
+			expected:            "",
+			err:                 fmt.Errorf("invalid \"kafka_cluster_rbac_crn\" \"crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123\": the CRN must be of the form 'crn://.../cloud-cluster=lkc-abc123'"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := buildRoleBindingCrnPattern(tt.kafkaClusterRbacCrn, tt.resourceType, tt.resourceName, tt.patternType)
+			if !reflect.DeepEqual(err, tt.err) {
+				t.Fatalf("Unexpected error: expected %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Fatalf("Unexpected result: expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestExtractRoleBindingImportId(t *testing.T) {
+	tests := []struct {
+		name     string
+		importId string
+		expected string
+		err      error
+	}{
+		{
+			name:     "raw ID",
+			importId: "rb-OOXL7",
+			expected: "rb-OOXL7",
+			err:      nil,
+		},
+		{
+			name:     "raw ID with surrounding whitespace",
+			importId: " rb-OOXL7\n",
+			expected: "rb-OOXL7",
+			err:      nil,
+		},
+		{
+			name:     "empty ID",
+			importId: "",
+			expected: "",
+			err:      fmt.Errorf("invalid format: expected the ID of the Role Binding, for example, 'rb-abc123', got \"\""),
+		},
+		{
+			name:     "ID without the prefix",
+			importId: "OOXL7",
+			expected: "",
+			err:      fmt.Errorf("invalid format: expected the ID of the Role Binding, for example, 'rb-abc123', got \"OOXL7\""),
+		},
+		{
+			name:     "composite ID",
+			importId: "env-abc123/rb-OOXL7",
+			expected: "",
+			err:      fmt.Errorf("invalid format: expected the ID of the Role Binding, for example, 'rb-abc123', got \"env-abc123/rb-OOXL7\""),
+		},
+		{
+			name:     "CRN pattern",
+			importId: "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123",
+			expected: "",
+			err:      fmt.Errorf("invalid format: expected the ID of the Role Binding, for example, 'rb-abc123', got \"crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123\""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := extractRoleBindingImportId(tt.importId)
+			if !reflect.DeepEqual(err, tt.err) {
+				t.Fatalf("Unexpected error: expected %v, got %v", tt.err, err)
+			}
+			if result != tt.expected {
+				t.Fatalf("Unexpected result: expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestCanUpdateEntityName(t *testing.T) {
 	tests := []struct {
 		entityType    string
@@ -228,3 +531,114 @@ func TestIsSchemaRegistryApiKey(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]struct {
+		items       []string
+		nextPageUrl string
+	}{
+		"":           {items: []string{"sa-1", "sa-2"}, nextPageUrl: "https://api.confluent.cloud/iam/v2/service-accounts?page_size=2&page_token=token-2"},
+		"token-2":    {items: []string{"sa-3", "sa-4"}, nextPageUrl: "https://api.confluent.cloud/iam/v2/service-accounts?page_size=2&page_token=token-3"},
+		"token-3":    {items: []string{"sa-5"}, nextPageUrl: ""},
+		"bad-cursor": {items: []string{"sa-6"}, nextPageUrl: "https://api.confluent.cloud/iam/v2/service-accounts?page_size=2"},
+	}
+
+	var items, pageTokens []string
+	err := paginate(func(pageToken string) (string, error) {
+		pageTokens = append(pageTokens, pageToken)
+		page := pages[pageToken]
+		items = append(items, page.items...)
+		return page.nextPageUrl, nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expectedPageTokens := []string{"", "token-2", "token-3"}; !reflect.DeepEqual(pageTokens, expectedPageTokens) {
+		t.Fatalf("Expected page tokens %v, got %v", expectedPageTokens, pageTokens)
+	}
+	if expectedItems := []string{"sa-1", "sa-2", "sa-3", "sa-4", "sa-5"}; !reflect.DeepEqual(items, expectedItems) {
+		t.Fatalf("Expected items %v, got %v", expectedItems, items)
+	}
+
+	err = paginate(func(pageToken string) (string, error) {
+		return pages["bad-cursor"].nextPageUrl, nil
+	})
+	if err == nil {
+		t.Fatal("Expected an error for the next page URL without a page token")
+	}
+
+	listErr := fmt.Errorf("429 Too Many Requests")
+	err = paginate(func(pageToken string) (string, error) {
+		return "", listErr
+	})
+	if err != listErr {
+		t.Fatalf("Expected error %v, got %v", listErr, err)
+	}
+}
+
+func TestExecuteApiRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if userName, password, ok := r.BasicAuth(); !ok || userName != "key" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("User-Agent") != "terraform-provider-confluent" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"detail":"unexpected User-Agent"}]}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"offset":9007199254740993}`))
+	}))
+	defer server.Close()
+	cfg := apiRequestConfig{
+		serverUrl:  server.URL,
+		userAgent:  "terraform-provider-confluent",
+		httpClient: server.Client(),
+		userName:   "key",
+		password:   "secret",
+	}
+
+	var responseBody map[string]interface{}
+	if _, err := executeApiRequest(context.Background(), cfg, http.MethodGet, "/", nil, &responseBody); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if offset := responseBody["offset"]; offset != json.Number("9007199254740993") {
+		t.Fatalf("Expected offset 9007199254740993, got %v", offset)
+	}
+
+	cfg.userAgent = "unknown"
+	if _, err := executeApiRequest(context.Background(), cfg, http.MethodGet, "/", nil, &responseBody); err == nil || err.Error() != "400 Bad Request: unexpected User-Agent" {
+		t.Fatalf("Expected error %q, got: %v", "400 Bad Request: unexpected User-Agent", err)
+	}
+
+	cfg.userName = ""
+	resp, err := executeApiRequest(context.Background(), cfg, http.MethodGet, "/", nil, &responseBody)
+	if err == nil || err.Error() != "401 Unauthorized" || !ResponseHasExpectedStatusCode(resp, http.StatusUnauthorized) {
+		t.Fatalf("Expected error %q, got: %v", "401 Unauthorized", err)
+	}
+}
+
+func TestExecuteCloudApiRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if userName, password, ok := r.BasicAuth(); !ok || userName != "key" || password != "secret" || r.URL.Path != "/tableflow/v1/tableflow-topics" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	c := &Client{
+		cloudApiRequestConfig: apiRequestConfig{serverUrl: server.URL, httpClient: server.Client()},
+		cloudApiKey:           "key",
+		cloudApiSecret:        "secret",
+	}
+
+	if _, err := executeCloudApiRequest(context.Background(), c, http.MethodGet, "/tableflow/v1/tableflow-topics", nil, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	c.cloudApiSecret = ""
+	if _, err := executeCloudApiRequest(context.Background(), c, http.MethodGet, "/tableflow/v1/tableflow-topics", nil, nil); err == nil {
+		t.Fatal("Expected an error without the Cloud API Secret")
+	}
+}
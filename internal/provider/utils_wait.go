@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"math/rand"
 	"net/http"
 	"time"
 )
@@ -118,282 +119,337 @@ func waitForCreatedCloudApiKeyToSync(ctx context.Context, c *Client, cloudApiKey
 }
 
 func waitForKafkaClusterToProvision(ctx context.Context, c *Client, environmentId, clusterId, clusterType string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateProvisioned},
 		Refresh: kafkaClusterProvisionStatus(c.cmkApiContext(ctx), c, environmentId, clusterId),
 		// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#provisioning-time
-		Timeout:      getTimeoutFor(clusterType),
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Timeout: getTimeoutFor(clusterType),
+		Delay:   delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Cluster %q provisioning status to become %q", clusterId, stateProvisioned), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
-	if _, err := stateConf.WaitForStateContext(c.cmkApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
+		return err
+	}
+	return nil
+}
+
+func waitForKsqlClusterToProvision(ctx context.Context, c *Client, environmentId, clusterId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateProvisioned},
+		Refresh: ksqlClusterProvisionStatus(c.ksqlApiContext(ctx), c, environmentId, clusterId),
+		Timeout: timeout,
+		Delay:   delay,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for ksqlDB Cluster %q provisioning status to become %v", clusterId, []string{stateUp, stateProvisioned}), map[string]interface{}{ksqlClusterLoggingKey: clusterId})
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForKsqlClusterToProvision(ctx context.Context, c *Client, environmentId, clusterId string) error {
+func waitForKsqlClusterToBeResized(ctx context.Context, c *Client, environmentId, clusterId string, csu int32, timeout time.Duration) error {
 	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{stateProvisioning},
 		Target:       []string{stateProvisioned},
-		Refresh:      ksqlClusterProvisionStatus(c.ksqlApiContext(ctx), c, environmentId, clusterId),
-		Timeout:      ksqlCreateTimeout,
+		Refresh:      ksqlClusterResizeStatus(c.ksqlApiContext(ctx), c, environmentId, clusterId, csu),
+		Timeout:      timeout,
 		Delay:        delay,
 		PollInterval: pollInterval,
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Waiting for ksqlDB Cluster %q provisioning status to become %v", clusterId, []string{stateUp, stateProvisioned}), map[string]interface{}{ksqlClusterLoggingKey: clusterId})
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for ksqlDB Cluster %q to be resized to %d CSUs", clusterId, csu), map[string]interface{}{ksqlClusterLoggingKey: clusterId})
 	if _, err := stateConf.WaitForStateContext(c.ksqlApiContext(ctx)); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForPrivateLinkAccessToProvision(ctx context.Context, c *Client, environmentId, privateLinkAccessId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+func waitForEnvironmentStreamGovernancePackageToBeUpdated(ctx context.Context, c *Client, environmentId, streamGovernancePackage string, timeout time.Duration) error {
+	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 30*time.Second, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
-		Pending:      []string{stateProvisioning},
-		Target:       []string{stateReady},
-		Refresh:      privateLinkAccessProvisionStatus(c.netApiContext(ctx), c, environmentId, privateLinkAccessId),
-		Timeout:      networkingAPICreateTimeout,
+		Pending:      []string{stateInProgress},
+		Target:       []string{stateDone},
+		Refresh:      environmentStreamGovernancePackageUpdateStatus(ctx, c, environmentId, streamGovernancePackage),
+		Timeout:      timeout,
 		Delay:        delay,
 		PollInterval: pollInterval,
 	}
 
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Stream Governance package of Environment %q to become %q", environmentId, streamGovernancePackage), map[string]interface{}{environmentLoggingKey: environmentId})
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func waitForPrivateLinkAccessToProvision(ctx context.Context, c *Client, environmentId, privateLinkAccessId string) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateReady},
+		Refresh: privateLinkAccessProvisionStatus(c.netApiContext(ctx), c, environmentId, privateLinkAccessId),
+		Timeout: networkingAPICreateTimeout,
+		Delay:   delay,
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Private Link Access %q provisioning status to become %q", privateLinkAccessId, stateReady), map[string]interface{}{privateLinkAccessLoggingKey: privateLinkAccessId})
-	if _, err := stateConf.WaitForStateContext(c.netApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForPrivateLinkAttachmentToProvision(ctx context.Context, c *Client, environmentId, privateLinkAttachmentId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
-		Pending:      []string{stateProvisioning},
-		Target:       []string{stateReady, stateWaitingForConnections},
-		Refresh:      privateLinkAttachmentProvisionStatus(c.netPLApiContext(ctx), c, environmentId, privateLinkAttachmentId),
-		Timeout:      networkingAPICreateTimeout,
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateReady, stateWaitingForConnections},
+		Refresh: privateLinkAttachmentProvisionStatus(c.netPLApiContext(ctx), c, environmentId, privateLinkAttachmentId),
+		Timeout: networkingAPICreateTimeout,
+		Delay:   delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Private Link Attachment %q provisioning status to become %q", privateLinkAttachmentId, stateWaitingForConnections), map[string]interface{}{privateLinkAttachmentLoggingKey: privateLinkAttachmentId})
-	if _, err := stateConf.WaitForStateContext(c.netPLApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForPrivateLinkAttachmentConnectionToProvision(ctx context.Context, c *Client, environmentId, privateLinkAttachmentConnectionId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
-		Pending:      []string{stateProvisioning},
-		Target:       []string{stateReady},
-		Refresh:      privateLinkAttachmentConnectionProvisionStatus(c.netPLApiContext(ctx), c, environmentId, privateLinkAttachmentConnectionId),
-		Timeout:      networkingAPICreateTimeout,
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateReady},
+		Refresh: privateLinkAttachmentConnectionProvisionStatus(c.netPLApiContext(ctx), c, environmentId, privateLinkAttachmentConnectionId),
+		Timeout: networkingAPICreateTimeout,
+		Delay:   delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Private Link Attachment Connection %q provisioning status to become %q", privateLinkAttachmentConnectionId, stateReady), map[string]interface{}{privateLinkAttachmentConnectionLoggingKey: privateLinkAttachmentConnectionId})
-	if _, err := stateConf.WaitForStateContext(c.netPLApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForNetworkLinkServiceToProvision(ctx context.Context, c *Client, environmentId, nlsId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady},
 		Refresh: nlsProvisionStatus(c.netApiContext(ctx), c, environmentId, nlsId),
 		Timeout: networkingAPICreateTimeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Network Link Service %q provisioning status to become %q", nlsId, stateReady), map[string]interface{}{networkLinkServiceLoggingKey: nlsId})
-	if _, err := stateConf.WaitForStateContext(c.netApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForNetworkToProvision(ctx context.Context, c *Client, environmentId, networkId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady},
 		Refresh: networkProvisionStatus(c.netApiContext(ctx), c, environmentId, networkId),
 		Timeout: networkingAPICreateTimeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Network %q provisioning status to become %q", networkId, stateReady), map[string]interface{}{networkLoggingKey: networkId})
-	if _, err := stateConf.WaitForStateContext(c.netApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForFlinkStatementToProvision(ctx context.Context, c *FlinkRestClient, statementName string, isAcceptanceTestMode bool) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 10*time.Second, isAcceptanceTestMode)
+func waitForFlinkStatementToProvision(ctx context.Context, c *FlinkRestClient, statementName string, timeout time.Duration, isAcceptanceTestMode bool) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 10*time.Second, isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
-		Pending:      []string{statePending},
-		Target:       []string{stateRunning, stateCompleted},
-		Refresh:      flinkStatementProvisionStatus(c.apiContext(ctx), c, statementName),
-		Timeout:      statementsAPICreateTimeout,
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Pending: []string{statePending},
+		Target:  []string{stateRunning, stateCompleted},
+		Refresh: flinkStatementProvisionStatus(c.apiContext(ctx), c, statementName),
+		Timeout: timeout,
+		Delay:   delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Flink Statement %q provisioning status to become %q", statementName, stateReady), map[string]interface{}{flinkStatementLoggingKey: statementName})
-	if _, err := stateConf.WaitForStateContext(c.apiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForNetworkLinkEndpointToProvision(ctx context.Context, c *Client, environmentId, nleId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, statePendingAccept, stateInactive},
 		Refresh: nleProvisionStatus(c.netApiContext(ctx), c, environmentId, nleId),
 		Timeout: networkingAPICreateTimeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Network Link Endpoint %q provisioning status to become %q", nleId, stateReady), map[string]interface{}{networkLinkEndpointLoggingKey: nleId})
-	if _, err := stateConf.WaitForStateContext(c.netApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForDnsRecordToProvision(ctx context.Context, c *Client, environmentId, dnsRecordId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+func waitForDnsRecordToProvision(ctx context.Context, c *Client, environmentId, dnsRecordId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, stateCreated},
 		Refresh: dnsRecordProvisionStatus(c.netAPApiContext(ctx), c, environmentId, dnsRecordId),
-		Timeout: networkingAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for DNS Record %q provisioning status to become %q", dnsRecordId, stateCreated), map[string]interface{}{dnsRecordKey: dnsRecordId})
-	if _, err := stateConf.WaitForStateContext(c.netAPApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForDnsForwarderToProvision(ctx context.Context, c *Client, environmentId, dnsForwarderId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, stateCreated},
 		Refresh: dnsForwarderProvisionStatus(c.netDnsApiContext(ctx), c, environmentId, dnsForwarderId),
 		Timeout: networkingAPICreateTimeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for DNS Forwarder %q provisioning status to become %q", dnsForwarderId, stateReady), map[string]interface{}{accessPointKey: dnsForwarderId})
-	if _, err := stateConf.WaitForStateContext(c.netAPApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForAccessPointToProvision(ctx context.Context, c *Client, environmentId, accessPointId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+func waitForAccessPointToProvision(ctx context.Context, c *Client, environmentId, accessPointId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, statePendingAccept},
 		Refresh: accessPointProvisionStatus(c.netAPApiContext(ctx), c, environmentId, accessPointId),
-		Timeout: networkingAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Access Point %q provisioning status to become %q", accessPointId, stateReady), map[string]interface{}{accessPointKey: accessPointId})
-	if _, err := stateConf.WaitForStateContext(c.netAPApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForComputePoolToProvision(ctx context.Context, c *Client, environmentId, computePoolId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+func waitForAccessPointToBeDeleted(ctx context.Context, c *Client, environmentId, accessPointId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{stateInProgress},
+		Target:  []string{stateDone},
+		Refresh: accessPointDeleteStatus(c.netAPApiContext(ctx), c, environmentId, accessPointId),
+		Timeout: timeout,
+		Delay:   delay,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Access Point %q to be deleted", accessPointId), map[string]interface{}{accessPointKey: accessPointId})
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
+		return err
+	}
+	return nil
+}
+
+func waitForComputePoolToProvision(ctx context.Context, c *Client, environmentId, computePoolId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateProvisioned},
 		Refresh: computePoolProvisionStatus(c.fcpmApiContext(ctx), c, environmentId, computePoolId),
-		Timeout: fcpmAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Flink Compute Pool %q provisioning status to become %q", computePoolId, stateProvisioned), map[string]interface{}{computePoolLoggingKey: computePoolId})
-	if _, err := stateConf.WaitForStateContext(c.fcpmApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
+		return err
+	}
+	return nil
+}
+
+func waitForTableflowTopicToProvision(ctx context.Context, c *Client, environmentId, clusterId, displayName string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statePending},
+		Target:  []string{stateRunning},
+		Refresh: tableflowTopicProvisionStatus(ctx, c, environmentId, clusterId, displayName),
+		Timeout: timeout,
+		Delay:   delay,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Tableflow Topic %q provisioning status to become %q", displayName, stateRunning), map[string]interface{}{tableflowTopicLoggingKey: displayName})
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForAnySchemaRegistryClusterToProvision(ctx context.Context, c *Client, environmentId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 5*time.Second, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 5*time.Second, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		// stateProvisioning applies when the Schema Registry Cluster is either in the 'provisioning' status or has not yet been created.
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateProvisioned},
 		Refresh: anySchemaRegistryClusterProvisionStatus(c.srcmApiContext(ctx), c, environmentId),
 		// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#provisioning-time
-		Timeout:      10 * time.Minute,
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Timeout: 10 * time.Minute,
+		Delay:   delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for the start of Schema Registry Cluster provisioning, followed by a status update to %q", stateProvisioned))
 
-	if _, err := stateConf.WaitForStateContext(c.srcmApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
 }
 
 func waitForConnectorToProvision(ctx context.Context, c *Client, displayName, environmentId, clusterId string) error {
-	delay, pollInterval := getDelayAndPollInterval(6*time.Minute, 1*time.Minute, c.isAcceptanceTestMode)
+	delay, backoff := getDelayAndProvisioningBackoff(6*time.Minute, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		// Allow PROVISIONING -> DEGRADED -> RUNNING transition
-		Pending:      []string{stateProvisioning, stateDegraded},
-		Target:       []string{stateRunning},
-		Refresh:      connectorProvisionStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId),
-		Timeout:      connectAPICreateTimeout,
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Pending: []string{stateProvisioning, stateDegraded},
+		Target:  []string{stateRunning},
+		Refresh: connectorProvisionStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId),
+		Timeout: connectAPICreateTimeout,
+		Delay:   delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Connector %q=%q provisioning status to become %q", paramDisplayName, displayName, stateRunning))
-	if _, err := stateConf.WaitForStateContext(c.connectApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
@@ -433,27 +489,26 @@ func waitForKafkaMirrorTopicToChangeStatus(ctx context.Context, c *KafkaRestClie
 	}
 
 	kafkaMirrorTopicId := createKafkaMirrorTopicId(clusterId, linkName, mirrorTopicName)
-	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Mirror Topic %q to be deleted", kafkaMirrorTopicId), map[string]interface{}{kafkaMirrorTopicLoggingKey: kafkaMirrorTopicId})
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Mirror Topic %q status to become %q", kafkaMirrorTopicId, targetStatus), map[string]interface{}{kafkaMirrorTopicLoggingKey: kafkaMirrorTopicId})
 	if _, err := stateConf.WaitForStateContext(c.apiContext(ctx)); err != nil {
 		return err
 	}
 	return nil
 }
 
-func waitForPeeringToProvision(ctx context.Context, c *Client, environmentId, peeringId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+func waitForPeeringToProvision(ctx context.Context, c *Client, environmentId, peeringId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, statePendingAccept},
 		Refresh: peeringProvisionStatus(c.netApiContext(ctx), c, environmentId, peeringId),
-		Timeout: networkingAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Peering %q provisioning status to become %q", peeringId, statePendingAccept), map[string]interface{}{networkLoggingKey: peeringId})
-	if _, err := stateConf.WaitForStateContext(c.netApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
@@ -539,20 +594,19 @@ func waitForSchemaExporterToProvision(ctx context.Context, c *SchemaRegistryRest
 	return nil
 }
 
-func waitForTransitGatewayAttachmentToProvision(ctx context.Context, c *Client, environmentId, transitGatewayAttachmentId string) error {
-	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+func waitForTransitGatewayAttachmentToProvision(ctx context.Context, c *Client, environmentId, transitGatewayAttachmentId string, timeout time.Duration) error {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, statePendingAccept},
 		Refresh: transitGatewayAttachmentProvisionStatus(c.netApiContext(ctx), c, environmentId, transitGatewayAttachmentId),
-		Timeout: networkingAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
-		Delay:        delay,
-		PollInterval: pollInterval,
+		Delay: delay,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Waiting for Transit Gateway Attachment %q provisioning status to become %q", transitGatewayAttachmentId, statePendingAccept), map[string]interface{}{transitGatewayAttachmentLoggingKey: transitGatewayAttachmentId})
-	if _, err := stateConf.WaitForStateContext(c.netApiContext(ctx)); err != nil {
+	if _, err := waitForStateWithBackoff(ctx, stateConf, backoff); err != nil {
 		return err
 	}
 	return nil
@@ -577,6 +631,24 @@ func waitForKafkaClusterCkuUpdateToComplete(ctx context.Context, c *Client, envi
 	return nil
 }
 
+func waitForKafkaClusterTypeUpdateToComplete(ctx context.Context, c *Client, environmentId, clusterId, clusterType string) error {
+	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{stateInProgress},
+		Target:       []string{stateDone},
+		Refresh:      kafkaClusterTypeUpdateStatus(c.cmkApiContext(ctx), c, environmentId, clusterId, clusterType),
+		Timeout:      getTimeoutFor(clusterType),
+		Delay:        delay,
+		PollInterval: pollInterval,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Cluster %q to be upgraded to %q", clusterId, clusterType), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+	if _, err := stateConf.WaitForStateContext(c.cmkApiContext(ctx)); err != nil {
+		return err
+	}
+	return nil
+}
+
 func waitForDnsRecordToBeDeleted(ctx context.Context, c *Client, environmentId, dnsRecordId string) error {
 	delay, pollInterval := getDelayAndPollInterval(1*time.Minute, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
@@ -896,7 +968,32 @@ func kafkaClusterCkuUpdateStatus(ctx context.Context, c *Client, environmentId s
 		// because the change is still pending, for example
 		// Use desiredCku on the off chance that API will not work as expected (i.e., spec.cku = status.cku during expansion).
 		// CAPAC-293
-		if cluster.Status.GetCku() == cluster.Spec.Config.CmkV2Dedicated.Cku && cluster.Status.GetCku() == desiredCku {
+		// The same check works for both expansion and shrink, the latter additionally waits for
+		// the cluster to return to PROVISIONED phase once the partitions have been moved off the removed CKUs.
+		if cluster.Status.GetPhase() == stateFailed {
+			return nil, stateFailed, fmt.Errorf("kafka Cluster %q CKU update to %d CKUs failed: provisioning status is %q", clusterId, desiredCku, stateFailed)
+		}
+		if cluster.Status.GetCku() == cluster.Spec.Config.CmkV2Dedicated.Cku && cluster.Status.GetCku() == desiredCku && cluster.Status.GetPhase() == stateProvisioned {
+			return cluster, stateDone, nil
+		}
+		return cluster, stateInProgress, nil
+	}
+}
+
+func kafkaClusterTypeUpdateStatus(ctx context.Context, c *Client, environmentId string, clusterId string, desiredClusterType string) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		cluster, _, err := executeKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka Cluster %q: %s", clusterId, createDescriptiveError(err)), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+			return nil, stateUnknown, err
+		}
+
+		clusterType := extractClusterTypeFromConfig(cluster.Spec.GetConfig())
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Cluster %q to be upgraded to %q: current type is %q, provisioning status is %q", clusterId, desiredClusterType, clusterType, cluster.Status.GetPhase()), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+		if cluster.Status.GetPhase() == stateFailed {
+			return nil, stateFailed, fmt.Errorf("kafka Cluster %q upgrade to %q failed: provisioning status is %q", clusterId, desiredClusterType, stateFailed)
+		}
+		if clusterType == desiredClusterType && cluster.Status.GetPhase() == stateProvisioned {
 			return cluster, stateDone, nil
 		}
 		return cluster, stateInProgress, nil
@@ -941,6 +1038,46 @@ func ksqlClusterProvisionStatus(ctx context.Context, c *Client, environmentId, c
 	}
 }
 
+func ksqlClusterResizeStatus(ctx context.Context, c *Client, environmentId, clusterId string, csu int32) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		cluster, _, err := executeKsqlRead(c.ksqlApiContext(ctx), c, environmentId, clusterId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading ksqlDB Cluster %q: %s", clusterId, createDescriptiveError(err)), map[string]interface{}{ksqlClusterLoggingKey: clusterId})
+			return nil, stateUnknown, err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for ksqlDB Cluster %q to be resized to %d CSUs: current status is %q, current number of CSUs is %d", clusterId, csu, cluster.Status.GetPhase(), cluster.Spec.GetCsu()), map[string]interface{}{ksqlClusterLoggingKey: clusterId})
+		if cluster.Status.GetPhase() == stateFailed {
+			return nil, stateFailed, fmt.Errorf("ksqlDB Cluster %q provisioning status is %q", clusterId, stateFailed)
+		} else if cluster.Status.GetPhase() != stateProvisioning && cluster.Status.GetPhase() != stateProvisioned {
+			// ksqlDB Cluster is in an unexpected state
+			return nil, stateUnexpected, fmt.Errorf("ksqlDB Cluster %q is an unexpected state %q", clusterId, cluster.Status.GetPhase())
+		}
+		// The cluster might still report the previous number of CSUs right after the update request
+		if cluster.Status.GetPhase() == stateProvisioned && cluster.Spec.GetCsu() == csu {
+			return cluster, stateProvisioned, nil
+		}
+		return cluster, stateProvisioning, nil
+	}
+}
+
+func environmentStreamGovernancePackageUpdateStatus(ctx context.Context, c *Client, environmentId, streamGovernancePackage string) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		environment, _, err := executeEnvironmentRead(c.orgApiContext(ctx), c, environmentId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading Environment %q: %s", environmentId, createDescriptiveError(err)), map[string]interface{}{environmentLoggingKey: environmentId})
+			return nil, stateUnknown, err
+		}
+
+		currentPackage := environment.GetStreamGovernanceConfig().Package
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for Stream Governance package of Environment %q to become %q: current package is %q", environmentId, streamGovernancePackage, currentPackage), map[string]interface{}{environmentLoggingKey: environmentId})
+		if currentPackage == streamGovernancePackage {
+			return environment, stateDone, nil
+		}
+		return environment, stateInProgress, nil
+	}
+}
+
 func anySchemaRegistryClusterProvisionStatus(ctx context.Context, c *Client, environmentId string) resource.StateRefreshFunc {
 	return func() (result interface{}, s string, err error) {
 		clusters, err := loadSchemaRegistryClusters(c.srcmApiContext(ctx), c, environmentId)
@@ -1067,6 +1204,12 @@ func networkProvisionStatus(ctx context.Context, c *Client, environmentId string
 		}
 
 		tflog.Debug(ctx, fmt.Sprintf("Waiting for Network %q provisioning status to become %q: current status is %q", networkId, stateReady, network.Status.GetPhase()), map[string]interface{}{networkLoggingKey: networkId})
+		if network.Status.GetPhase() == stateReady && !areRequestedConnectionTypesActive(network) {
+			// Some connection types (e.g., TRANSITGATEWAY) go through additional backend validation after
+			// the network becomes READY, so wait until all requested connection types are active
+			tflog.Debug(ctx, fmt.Sprintf("Waiting for Network %q connection types %v to become active: current active connection types are %v", networkId, network.Spec.GetConnectionTypes().Items, network.Status.GetActiveConnectionTypes().Items), map[string]interface{}{networkLoggingKey: networkId})
+			return network, stateProvisioning, nil
+		}
 		if network.Status.GetPhase() == stateProvisioning || network.Status.GetPhase() == stateReady {
 			return network, network.Status.GetPhase(), nil
 		} else if network.Status.GetPhase() == stateFailed {
@@ -1126,8 +1269,8 @@ func accessPointProvisionStatus(ctx context.Context, c *Client, environmentId st
 		tflog.Debug(ctx, fmt.Sprintf("Waiting for Access Point %q provisioning status to become %q: current status is %q", accessPointId, stateReady, accessPoint.Status.GetPhase()), map[string]interface{}{accessPointKey: accessPointId})
 		if accessPoint.Status.GetPhase() == stateProvisioning || accessPoint.Status.GetPhase() == stateReady || accessPoint.Status.GetPhase() == statePendingAccept {
 			return accessPoint, accessPoint.Status.GetPhase(), nil
-		} else if accessPoint.Status.GetPhase() == stateFailed {
-			return nil, stateFailed, fmt.Errorf("access point %q provisioning status is %q: %s", accessPointId, stateFailed, accessPoint.Status.GetErrorMessage())
+		} else if accessPoint.Status.GetPhase() == stateFailed || accessPoint.Status.GetPhase() == stateError {
+			return nil, accessPoint.Status.GetPhase(), fmt.Errorf("access point %q provisioning status is %q: %s", accessPointId, accessPoint.Status.GetPhase(), accessPoint.Status.GetErrorMessage())
 		}
 		// Access Point is in an unexpected state
 		return nil, stateUnexpected, fmt.Errorf("access point %q is an unexpected state %q: %s", accessPointId, accessPoint.Status.GetPhase(), accessPoint.Status.GetErrorMessage())
@@ -1191,6 +1334,28 @@ func computePoolProvisionStatus(ctx context.Context, c *Client, environmentId st
 	}
 }
 
+func tableflowTopicProvisionStatus(ctx context.Context, c *Client, environmentId, clusterId, displayName string) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		topic, _, err := executeTableflowTopicRead(ctx, c, environmentId, clusterId, displayName)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading Tableflow Topic %q: %s", displayName, createDescriptiveError(err)), map[string]interface{}{tableflowTopicLoggingKey: displayName})
+			return nil, stateUnknown, err
+		}
+		if topic.Status == nil {
+			return nil, stateUnknown, fmt.Errorf("tableflow Topic %q is missing status", displayName)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for Tableflow Topic %q provisioning status to become %q: current status is %q", displayName, stateRunning, topic.Status.Phase), map[string]interface{}{tableflowTopicLoggingKey: displayName})
+		if topic.Status.Phase == statePending || topic.Status.Phase == stateRunning {
+			return topic, topic.Status.Phase, nil
+		} else if topic.Status.Phase == stateFailed {
+			return nil, stateFailed, fmt.Errorf("tableflow Topic %q provisioning status is %q: %s", displayName, stateFailed, topic.Status.ErrorMessage)
+		}
+		// Tableflow Topic is in an unexpected state
+		return nil, stateUnexpected, fmt.Errorf("tableflow Topic %q is an unexpected state %q", displayName, topic.Status.Phase)
+	}
+}
+
 func nleProvisionStatus(ctx context.Context, c *Client, environmentId string, nleId string) resource.StateRefreshFunc {
 	return func() (result interface{}, s string, err error) {
 		nle, _, err := executeNLERead(c.netApiContext(ctx), c, nleId, environmentId)
@@ -1264,6 +1429,10 @@ func peeringProvisionStatus(ctx context.Context, c *Client, environmentId string
 		if peering.Status.GetPhase() == stateProvisioning || peering.Status.GetPhase() == stateReady || peering.Status.GetPhase() == statePendingAccept {
 			return peering, peering.Status.GetPhase(), nil
 		} else if peering.Status.GetPhase() == stateFailed {
+			// Surface the error code too (e.g., when the peered VPC's CIDR overlaps with the network's CIDR)
+			if peering.Status.GetErrorCode() != "" {
+				return nil, stateFailed, fmt.Errorf("peering %q provisioning status is %q: %s: %s", peeringId, stateFailed, peering.Status.GetErrorCode(), peering.Status.GetErrorMessage())
+			}
 			return nil, stateFailed, fmt.Errorf("peering %q provisioning status is %q: %s", peeringId, stateFailed, peering.Status.GetErrorMessage())
 		}
 		// Peering is in an unexpected state
@@ -1391,6 +1560,26 @@ func transitGatewayAttachmentProvisionStatus(ctx context.Context, c *Client, env
 	}
 }
 
+func accessPointDeleteStatus(ctx context.Context, c *Client, environmentId, accessPointId string) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		accessPoint, resp, err := executeAccessPointRead(c.netAPApiContext(ctx), c, environmentId, accessPointId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading Access Point %q: %s", accessPointId, createDescriptiveError(err)), map[string]interface{}{accessPointKey: accessPointId})
+
+			isResourceNotFound := isNonKafkaRestApiResourceNotFound(resp)
+			if isResourceNotFound {
+				tflog.Debug(ctx, fmt.Sprintf("Finishing Access Point %q deletion process: Received %d status code when reading %q Access Point", accessPointId, resp.StatusCode, accessPointId), map[string]interface{}{accessPointKey: accessPointId})
+				return 0, stateDone, nil
+			} else {
+				tflog.Debug(ctx, fmt.Sprintf("Exiting Access Point %q deletion process: Failed when reading Access Point: %s", accessPointId, createDescriptiveError(err)), map[string]interface{}{accessPointKey: accessPointId})
+				return nil, stateFailed, err
+			}
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Performing Access Point %q deletion process: Access Point %d's status is %q", accessPointId, resp.StatusCode, accessPoint.Status.GetPhase()), map[string]interface{}{accessPointKey: accessPointId})
+		return accessPoint, stateInProgress, nil
+	}
+}
+
 func dnsRecordDeleteStatus(ctx context.Context, c *Client, environmentId, dnsRecordId string) resource.StateRefreshFunc {
 	return func() (result interface{}, s string, err error) {
 		dnsRecord, resp, err := executeDnsRecordRead(c.netAPApiContext(ctx), c, environmentId, dnsRecordId)
@@ -1580,3 +1769,125 @@ func getDelayAndPollInterval(delayNormal, pollIntervalNormal time.Duration, isAc
 	}
 	return delayNormal, pollIntervalNormal
 }
+
+const (
+	provisioningInitialPollInterval = 10 * time.Second
+	provisioningPollJitter          = 0.2
+	provisioningNotFoundChecks      = 20
+)
+
+// provisioningBackoff controls how often a long-running provisioning operation is polled: the interval starts at
+// initialInterval, doubles after every poll until it reaches maxInterval and is then randomized by up to +/- jitter
+// (a fraction of the interval) so that resources created in parallel don't poll the API in lockstep.
+type provisioningBackoff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	jitter          float64
+}
+
+func getDelayAndProvisioningBackoff(delayNormal, maxPollIntervalNormal time.Duration, isAcceptanceTestMode bool) (time.Duration, provisioningBackoff) {
+	if isAcceptanceTestMode {
+		return acceptanceTestModeWaitTime, provisioningBackoff{
+			initialInterval: acceptanceTestModePollInterval,
+			maxInterval:     acceptanceTestModePollInterval,
+		}
+	}
+	initialInterval := provisioningInitialPollInterval
+	if initialInterval > maxPollIntervalNormal {
+		initialInterval = maxPollIntervalNormal
+	}
+	return delayNormal, provisioningBackoff{
+		initialInterval: initialInterval,
+		maxInterval:     maxPollIntervalNormal,
+		jitter:          provisioningPollJitter,
+	}
+}
+
+// interval returns how long to wait after the given (zero-based) poll, random must return a value in [0, 1).
+func (b provisioningBackoff) interval(attempt int, random func() float64) time.Duration {
+	interval := b.initialInterval
+	for i := 0; i < attempt && interval < b.maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > b.maxInterval {
+		interval = b.maxInterval
+	}
+	if b.jitter > 0 {
+		delta := float64(interval) * b.jitter
+		interval = time.Duration(float64(interval) - delta + 2*delta*random())
+	}
+	return interval
+}
+
+// waitForStateWithBackoff is a drop-in replacement for resource.StateChangeConf.WaitForStateContext that polls
+// conf.Refresh according to backoff instead of conf.PollInterval. It honors conf.Delay, conf.Timeout and
+// conf.NotFoundChecks, returns the same error types and stops as soon as ctx is cancelled.
+// conf.ContinuousTargetOccurence is not supported.
+func waitForStateWithBackoff(ctx context.Context, conf *resource.StateChangeConf, backoff provisioningBackoff) (interface{}, error) {
+	var deadline time.Time
+	if conf.Timeout > 0 {
+		deadline = time.Now().Add(conf.Timeout)
+	}
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = provisioningNotFoundChecks
+	}
+
+	if err := sleepWithContext(ctx, conf.Delay); err != nil {
+		return nil, err
+	}
+
+	var lastResult interface{}
+	lastState := ""
+	notFoundTick := 0
+	for attempt := 0; ; attempt++ {
+		result, state, err := conf.Refresh()
+		if err != nil {
+			return result, err
+		}
+		if result == nil {
+			notFoundTick++
+			if notFoundTick > notFoundChecks {
+				return nil, &resource.NotFoundError{Retries: notFoundTick}
+			}
+		} else {
+			notFoundTick = 0
+			lastResult, lastState = result, state
+			if stringInSlice(state, conf.Target, false) {
+				return result, nil
+			}
+			if !stringInSlice(state, conf.Pending, false) {
+				return result, &resource.UnexpectedStateError{State: state, ExpectedState: conf.Target}
+			}
+		}
+
+		wait := backoff.interval(attempt, rand.Float64)
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return lastResult, &resource.TimeoutError{LastState: lastState, Timeout: conf.Timeout, ExpectedState: conf.Target}
+			}
+			if wait > remaining {
+				wait = remaining
+			}
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Current state is %q, waiting %s before the next check", lastState, wait))
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return lastResult, err
+		}
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
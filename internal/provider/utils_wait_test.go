@@ -0,0 +1,311 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	netap "github.com/confluentinc/ccloud-sdk-go-v2/networking-access-point/v1"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestProvisioningBackoffInterval(t *testing.T) {
+	backoff := provisioningBackoff{
+		initialInterval: 10 * time.Second,
+		maxInterval:     1 * time.Minute,
+	}
+	noJitter := func() float64 { return 0.5 }
+	expected := []time.Duration{
+		10 * time.Second,
+		20 * time.Second,
+		40 * time.Second,
+		1 * time.Minute,
+		1 * time.Minute,
+	}
+	for attempt, want := range expected {
+		if got := backoff.interval(attempt, noJitter); got != want {
+			t.Fatalf("attempt %d: expected %s, got %s", attempt, want, got)
+		}
+	}
+	// Very large attempt numbers must not overflow past maxInterval
+	if got := backoff.interval(1000, noJitter); got != backoff.maxInterval {
+		t.Fatalf("expected interval to be capped at %s, got %s", backoff.maxInterval, got)
+	}
+}
+
+func TestProvisioningBackoffIntervalJitter(t *testing.T) {
+	backoff := provisioningBackoff{
+		initialInterval: 10 * time.Second,
+		maxInterval:     1 * time.Minute,
+		jitter:          0.2,
+	}
+	if got := backoff.interval(0, func() float64 { return 0 }); got != 8*time.Second {
+		t.Fatalf("expected lower jitter bound of 8s, got %s", got)
+	}
+	if got := backoff.interval(0, func() float64 { return 0.5 }); got != 10*time.Second {
+		t.Fatalf("expected unjittered interval of 10s, got %s", got)
+	}
+	if got := backoff.interval(5, func() float64 { return 0.999999 }); got <= 1*time.Minute || got > 72*time.Second {
+		t.Fatalf("expected interval within the upper jitter bound of 72s, got %s", got)
+	}
+}
+
+func TestGetDelayAndProvisioningBackoff(t *testing.T) {
+	delay, backoff := getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, false)
+	if delay != 5*time.Second {
+		t.Fatalf("expected delay of 5s, got %s", delay)
+	}
+	if backoff != (provisioningBackoff{initialInterval: provisioningInitialPollInterval, maxInterval: 1 * time.Minute, jitter: provisioningPollJitter}) {
+		t.Fatalf("unexpected backoff: %+v", backoff)
+	}
+
+	// The initial interval never exceeds the max interval
+	_, backoff = getDelayAndProvisioningBackoff(5*time.Second, 5*time.Second, false)
+	if backoff.initialInterval != 5*time.Second {
+		t.Fatalf("expected initial interval of 5s, got %s", backoff.initialInterval)
+	}
+
+	delay, backoff = getDelayAndProvisioningBackoff(5*time.Second, 1*time.Minute, true)
+	if delay != acceptanceTestModeWaitTime {
+		t.Fatalf("expected delay of %s, got %s", acceptanceTestModeWaitTime, delay)
+	}
+	if backoff != (provisioningBackoff{initialInterval: acceptanceTestModePollInterval, maxInterval: acceptanceTestModePollInterval}) {
+		t.Fatalf("unexpected backoff: %+v", backoff)
+	}
+}
+
+func testProvisioningRefresh(states ...string) (resource.StateRefreshFunc, *int) {
+	calls := 0
+	return func() (interface{}, string, error) {
+		state := states[len(states)-1]
+		if calls < len(states) {
+			state = states[calls]
+		}
+		calls++
+		return state, state, nil
+	}, &calls
+}
+
+func TestWaitForStateWithBackoff(t *testing.T) {
+	refresh, calls := testProvisioningRefresh(stateProvisioning, stateProvisioning, stateProvisioned)
+	conf := &resource.StateChangeConf{
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateProvisioned},
+		Refresh: refresh,
+		Timeout: 10 * time.Second,
+	}
+	backoff := provisioningBackoff{initialInterval: time.Millisecond, maxInterval: 2 * time.Millisecond}
+
+	result, err := waitForStateWithBackoff(context.Background(), conf, backoff)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != stateProvisioned {
+		t.Fatalf("expected result %q, got %q", stateProvisioned, result)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 refresh calls, got %d", *calls)
+	}
+}
+
+func TestWaitForStateWithBackoffUnexpectedState(t *testing.T) {
+	refresh, _ := testProvisioningRefresh(stateProvisioning, stateFailed)
+	conf := &resource.StateChangeConf{
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateProvisioned},
+		Refresh: refresh,
+		Timeout: 10 * time.Second,
+	}
+	backoff := provisioningBackoff{initialInterval: time.Millisecond, maxInterval: time.Millisecond}
+
+	_, err := waitForStateWithBackoff(context.Background(), conf, backoff)
+	var unexpectedStateErr *resource.UnexpectedStateError
+	if !errors.As(err, &unexpectedStateErr) || unexpectedStateErr.State != stateFailed {
+		t.Fatalf("expected an unexpected state error for %q, got %v", stateFailed, err)
+	}
+}
+
+func TestWaitForStateWithBackoffTimeout(t *testing.T) {
+	refresh, _ := testProvisioningRefresh(stateProvisioning)
+	conf := &resource.StateChangeConf{
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateProvisioned},
+		Refresh: refresh,
+		Timeout: 20 * time.Millisecond,
+	}
+	backoff := provisioningBackoff{initialInterval: 5 * time.Millisecond, maxInterval: 5 * time.Millisecond}
+
+	_, err := waitForStateWithBackoff(context.Background(), conf, backoff)
+	var timeoutErr *resource.TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.LastState != stateProvisioning {
+		t.Fatalf("expected a timeout error with last state %q, got %v", stateProvisioning, err)
+	}
+}
+
+func TestWaitForStateWithBackoffCancellation(t *testing.T) {
+	refresh, calls := testProvisioningRefresh(stateProvisioning)
+	conf := &resource.StateChangeConf{
+		Pending: []string{stateProvisioning},
+		Target:  []string{stateProvisioned},
+		Refresh: refresh,
+		Timeout: 1 * time.Hour,
+	}
+	backoff := provisioningBackoff{initialInterval: 1 * time.Minute, maxInterval: 1 * time.Minute}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := waitForStateWithBackoff(ctx, conf, backoff)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected the wait to stop promptly after cancellation, took %s", elapsed)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 refresh call before cancellation, got %d", *calls)
+	}
+
+	// A context that is already cancelled stops the wait during the initial delay
+	conf.Delay = 1 * time.Minute
+	*calls = 0
+	if _, err := waitForStateWithBackoff(ctx, conf, backoff); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if *calls != 0 {
+		t.Fatalf("expected no refresh calls, got %d", *calls)
+	}
+}
+
+func TestWaitForKafkaClusterToProvisionStopsOnCanceledContext(t *testing.T) {
+	// API contexts are derived from context.Background(), so the wait must watch the caller's context instead
+	c := &Client{cloudApiKey: "key", cloudApiSecret: "secret"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := waitForKafkaClusterToProvision(ctx, c, "env-abc123", "lkc-abc123", kafkaClusterTypeDedicated)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("expected the wait to stop promptly after cancellation, took %s", elapsed)
+	}
+}
+
+func TestWaitForAccessPointToBeDeleted(t *testing.T) {
+	readyAccessPointResponse, err := os.ReadFile("../testdata/network_access_point/read_created_aws_egress_ap.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		readyReads  int
+		timeout     time.Duration
+		expectError bool
+	}{
+		{"deleted after the first poll", 1, 1 * time.Minute, false},
+		{"times out while the Access Point still exists", -1, 1500 * time.Millisecond, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reads := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				reads++
+				if tt.readyReads >= 0 && reads > tt.readyReads {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write(readyAccessPointResponse)
+			}))
+			defer server.Close()
+
+			cfg := netap.NewConfiguration()
+			cfg.Servers[0].URL = server.URL
+			c := &Client{netAccessPointClient: netap.NewAPIClient(cfg), isAcceptanceTestMode: true}
+
+			err := waitForAccessPointToBeDeleted(context.Background(), c, "env-abc123", "ap-abc123", tt.timeout)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %t, got: %v", tt.expectError, err)
+			}
+			var timeoutErr *resource.TimeoutError
+			if tt.expectError && !errors.As(err, &timeoutErr) {
+				t.Fatalf("expected a timeout error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestWaitForEnvironmentStreamGovernancePackageToBeUpdated(t *testing.T) {
+	essentialsEnvironmentResponse, err := os.ReadFile("../testdata/environment/read_created_env.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	advancedEnvironmentResponse, err := os.ReadFile("../testdata/environment/read_updated_env.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		essentialsReads int
+		timeout         time.Duration
+		expectError     bool
+	}{
+		{"updated after the first poll", 1, 1 * time.Minute, false},
+		{"times out while the package is still being updated", -1, 1500 * time.Millisecond, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reads := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				reads++
+				if tt.essentialsReads >= 0 && reads > tt.essentialsReads {
+					_, _ = w.Write(advancedEnvironmentResponse)
+					return
+				}
+				_, _ = w.Write(essentialsEnvironmentResponse)
+			}))
+			defer server.Close()
+
+			cfg := org.NewConfiguration()
+			cfg.Servers[0].URL = server.URL
+			c := &Client{orgClient: org.NewAPIClient(cfg), isAcceptanceTestMode: true}
+
+			err := waitForEnvironmentStreamGovernancePackageToBeUpdated(context.Background(), c, "env-abc123", "ADVANCED", tt.timeout)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %t, got: %v", tt.expectError, err)
+			}
+			var timeoutErr *resource.TimeoutError
+			if tt.expectError && !errors.As(err, &timeoutErr) {
+				t.Fatalf("expected a timeout error, got: %v", err)
+			}
+		})
+	}
+}